@@ -0,0 +1,173 @@
+// Package aggregate provides SQL-like aggregate functions over slices of nullable values.
+//
+// Like their SQL counterparts, every function skips null values and returns
+// a null result when the input does not contain any valid value,
+// except Count and CountAll which always return a number.
+package aggregate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	std "github.com/euskadi31/go-std"
+)
+
+// ErrOverflow is returned when the result of an aggregate does not fit in its type.
+var ErrOverflow = errors.New("aggregate: value out of range")
+
+// ErrInvalidPercentile is returned when a percentile is not between 0 and 1.
+var ErrInvalidPercentile = errors.New("aggregate: percentile value must be between 0 and 1")
+
+func avg(values []float64) std.Float {
+	if len(values) == 0 {
+		return std.Float{}
+	}
+
+	var sum float64
+
+	for _, v := range values {
+		sum += v
+	}
+
+	return std.FloatFrom(sum / float64(len(values)))
+}
+
+// percentile computes a continuous percentile like PERCENTILE_CONT,
+// interpolating linearly between adjacent values.
+func percentile(values []float64, p float64) (std.Float, error) {
+	if err := checkPercentile(p); err != nil {
+		return std.Float{}, err
+	}
+
+	if len(values) == 0 {
+		return std.Float{}, nil
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	pos := p * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+
+	if lo == hi {
+		return std.FloatFrom(sorted[lo]), nil
+	}
+
+	return std.FloatFrom(sorted[lo] + (pos-float64(lo))*(sorted[hi]-sorted[lo])), nil
+}
+
+func checkPercentile(p float64) error {
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return fmt.Errorf("%w: %v", ErrInvalidPercentile, p)
+	}
+
+	return nil
+}
+
+// exactPercentile computes a continuous percentile like percentile, over n sorted integers
+// set into x by at. It interpolates with rationals, so the result is only rounded once.
+func exactPercentile(n int, p float64, at func(i int, x *big.Int) *big.Int) (std.Float, error) {
+	if err := checkPercentile(p); err != nil {
+		return std.Float{}, err
+	}
+
+	if n == 0 {
+		return std.Float{}, nil
+	}
+
+	pos := new(big.Rat).SetFloat64(p)
+	pos.Mul(pos, big.NewRat(int64(n-1), 1))
+
+	// pos is not negative, so the truncated quotient is its floor
+	lo := new(big.Int).Quo(pos.Num(), pos.Denom())
+	frac := new(big.Rat).Sub(pos, new(big.Rat).SetInt(lo))
+
+	result := new(big.Rat).SetInt(at(int(lo.Int64()), new(big.Int)))
+
+	if frac.Sign() != 0 {
+		hi := new(big.Rat).SetInt(at(int(lo.Int64())+1, new(big.Int)))
+
+		hi.Sub(hi, result)
+		result.Add(result, hi.Mul(hi, frac))
+	}
+
+	f, _ := result.Float64()
+
+	return std.FloatFrom(f), nil
+}
+
+// stdDev computes the sample standard deviation like STDDEV_SAMP,
+// it is null when there are less than two values.
+func stdDev(values []float64) std.Float {
+	if len(values) < 2 {
+		return std.Float{}
+	}
+
+	mean := avg(values).ValueOr(0)
+
+	var sum float64
+
+	for _, v := range values {
+		sum += (v - mean) * (v - mean)
+	}
+
+	return std.FloatFrom(math.Sqrt(sum / float64(len(values)-1)))
+}
+
+// moments accumulates the count, sum and sum of squares of integers without loss,
+// so that averages and deviations are only rounded once.
+type moments struct {
+	n     int64
+	sum   big.Int
+	sumSq big.Int
+	x     big.Int
+}
+
+func (m *moments) addInt(v int64) {
+	m.add(m.x.SetInt64(v))
+}
+
+func (m *moments) addUint(v uint64) {
+	m.add(m.x.SetUint64(v))
+}
+
+func (m *moments) add(x *big.Int) {
+	m.n++
+	m.sum.Add(&m.sum, x)
+	m.sumSq.Add(&m.sumSq, x.Mul(x, x))
+}
+
+// avg returns sum / n, null if there is no value.
+func (m *moments) avg() std.Float {
+	if m.n == 0 {
+		return std.Float{}
+	}
+
+	f, _ := new(big.Rat).SetFrac(&m.sum, big.NewInt(m.n)).Float64()
+
+	return std.FloatFrom(f)
+}
+
+// stdDev returns the sample standard deviation like STDDEV_SAMP,
+// from the variance (n*sumSq - sum^2) / (n*(n-1)). It is null when there are less than two values.
+func (m *moments) stdDev() std.Float {
+	if m.n < 2 {
+		return std.Float{}
+	}
+
+	n := big.NewInt(m.n)
+
+	num := new(big.Int).Mul(n, &m.sumSq)
+	num.Sub(num, new(big.Int).Mul(&m.sum, &m.sum))
+
+	den := new(big.Int).Mul(n, big.NewInt(m.n-1))
+
+	variance, _ := new(big.Rat).SetFrac(num, den).Float64()
+
+	return std.FloatFrom(math.Sqrt(variance))
+}
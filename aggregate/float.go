@@ -0,0 +1,105 @@
+package aggregate
+
+import (
+	"math"
+
+	std "github.com/euskadi31/go-std"
+)
+
+func validFloats(values []std.Float) []float64 {
+	valid := make([]float64, 0, len(values))
+
+	for _, v := range values {
+		if v.Valid {
+			valid = append(valid, v.Data)
+		}
+	}
+
+	return valid
+}
+
+// SumFloat returns the sum of the non-null values.
+func SumFloat(values []std.Float) std.Float {
+	var sum std.Float
+
+	for _, v := range validFloats(values) {
+		sum.SetValid(sum.ValueOr(0) + v)
+	}
+
+	return sum
+}
+
+// AvgFloat returns the average of the non-null values.
+func AvgFloat(values []std.Float) std.Float {
+	return avg(validFloats(values))
+}
+
+// lessFloat reports whether a is less than b, a NaN being greater than any number like in Postgres.
+func lessFloat(a, b float64) bool {
+	return !math.IsNaN(a) && (math.IsNaN(b) || a < b)
+}
+
+// MinFloat returns the smallest non-null value.
+// A NaN is greater than any number, so it is only returned when all the non-null values are NaN.
+func MinFloat(values []std.Float) std.Float {
+	var result std.Float
+
+	for _, v := range validFloats(values) {
+		if !result.Valid || lessFloat(v, result.Data) {
+			result.SetValid(v)
+		}
+	}
+
+	return result
+}
+
+// MaxFloat returns the largest non-null value.
+// A NaN is greater than any number, so it is returned as soon as there is one.
+func MaxFloat(values []std.Float) std.Float {
+	var result std.Float
+
+	for _, v := range validFloats(values) {
+		if !result.Valid || lessFloat(result.Data, v) {
+			result.SetValid(v)
+		}
+	}
+
+	return result
+}
+
+// CountFloat returns the number of non-null values, like COUNT(column).
+func CountFloat(values []std.Float) int64 {
+	var n int64
+
+	for _, v := range values {
+		if v.Valid {
+			n++
+		}
+	}
+
+	return n
+}
+
+// CountAllFloat returns the number of values, null included, like COUNT(*).
+func CountAllFloat(values []std.Float) int64 {
+	return int64(len(values))
+}
+
+// MedianFloat returns the median of the non-null values.
+func MedianFloat(values []std.Float) std.Float {
+	m, _ := percentile(validFloats(values), 0.5)
+
+	return m
+}
+
+// PercentileFloat returns the continuous percentile p (between 0 and 1) of the non-null values,
+// like PERCENTILE_CONT(p).
+func PercentileFloat(values []std.Float, p float64) (std.Float, error) {
+	return percentile(validFloats(values), p)
+}
+
+// StdDevFloat returns the sample standard deviation of the non-null values.
+// It is null when there are less than two non-null values.
+func StdDevFloat(values []std.Float) std.Float {
+	return stdDev(validFloats(values))
+}
@@ -0,0 +1,78 @@
+package aggregate
+
+import (
+	"math"
+	"testing"
+
+	std "github.com/euskadi31/go-std"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	floatValues = []std.Float{std.FloatFrom(1.5), {}, std.FloatFrom(0.5), std.FloatFrom(2.5), {}, std.FloatFrom(3.5)}
+	nullFloats  = []std.Float{{}, {}}
+)
+
+func TestSumFloat(t *testing.T) {
+	assert.Equal(t, std.FloatFrom(8), SumFloat(floatValues))
+	assert.False(t, SumFloat(nullFloats).Valid)
+	assert.False(t, SumFloat(nil).Valid)
+}
+
+func TestAvgFloat(t *testing.T) {
+	assert.Equal(t, std.FloatFrom(2), AvgFloat(floatValues))
+	assert.False(t, AvgFloat(nullFloats).Valid)
+}
+
+func TestMinMaxFloat(t *testing.T) {
+	assert.Equal(t, std.FloatFrom(0.5), MinFloat(floatValues))
+	assert.Equal(t, std.FloatFrom(3.5), MaxFloat(floatValues))
+	assert.False(t, MinFloat(nullFloats).Valid)
+	assert.False(t, MaxFloat(nullFloats).Valid)
+}
+
+func TestMinMaxFloatNaN(t *testing.T) {
+	nan := std.FloatFrom(math.NaN())
+
+	for _, values := range [][]std.Float{
+		{nan, std.FloatFrom(1), std.FloatFrom(3)},
+		{std.FloatFrom(1), nan, std.FloatFrom(3)},
+		{std.FloatFrom(1), std.FloatFrom(3), nan},
+		{std.FloatFrom(3), {}, nan, std.FloatFrom(1)},
+	} {
+		assert.Equal(t, std.FloatFrom(1), MinFloat(values), "%v", values)
+
+		maximum := MaxFloat(values)
+		assert.True(t, maximum.Valid && math.IsNaN(maximum.Data), "%v", values)
+	}
+
+	for _, values := range [][]std.Float{{nan}, {nan, {}, nan}} {
+		minimum := MinFloat(values)
+		assert.True(t, minimum.Valid && math.IsNaN(minimum.Data), "%v", values)
+	}
+}
+
+func TestCountFloat(t *testing.T) {
+	assert.Equal(t, int64(4), CountFloat(floatValues))
+	assert.Equal(t, int64(6), CountAllFloat(floatValues))
+	assert.Equal(t, int64(0), CountFloat(nullFloats))
+}
+
+func TestMedianFloat(t *testing.T) {
+	assert.Equal(t, std.FloatFrom(2), MedianFloat(floatValues))
+	assert.False(t, MedianFloat(nullFloats).Valid)
+}
+
+func TestPercentileFloat(t *testing.T) {
+	p, err := PercentileFloat(floatValues, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, std.FloatFrom(0.5), p)
+
+	_, err = PercentileFloat(floatValues, math.NaN())
+	assert.ErrorIs(t, err, ErrInvalidPercentile)
+}
+
+func TestStdDevFloat(t *testing.T) {
+	assert.InDelta(t, 1.2909944, StdDevFloat(floatValues).Data, 1e-7)
+	assert.False(t, StdDevFloat(nullFloats).Valid)
+}
@@ -0,0 +1,128 @@
+package aggregate
+
+import (
+	"math"
+	"math/big"
+	"sort"
+
+	std "github.com/euskadi31/go-std"
+)
+
+func validInts(values []std.Int) []int64 {
+	valid := make([]int64, 0, len(values))
+
+	for _, v := range values {
+		if v.Valid {
+			valid = append(valid, v.Data)
+		}
+	}
+
+	return valid
+}
+
+func intMoments(values []std.Int) *moments {
+	m := &moments{}
+
+	for _, v := range values {
+		if v.Valid {
+			m.addInt(v.Data)
+		}
+	}
+
+	return m
+}
+
+// SumInt returns the sum of the non-null values.
+// It returns ErrOverflow if the sum does not fit in an int64.
+func SumInt(values []std.Int) (std.Int, error) {
+	var (
+		sum   int64
+		valid bool
+	)
+
+	for _, v := range validInts(values) {
+		if (v > 0 && sum > math.MaxInt64-v) || (v < 0 && sum < math.MinInt64-v) {
+			return std.Int{}, ErrOverflow
+		}
+
+		sum += v
+		valid = true
+	}
+
+	return std.NewInt(sum, valid), nil
+}
+
+// AvgInt returns the average of the non-null values.
+func AvgInt(values []std.Int) std.Float {
+	return intMoments(values).avg()
+}
+
+// MinInt returns the smallest non-null value.
+func MinInt(values []std.Int) std.Int {
+	var result std.Int
+
+	for _, v := range validInts(values) {
+		if !result.Valid || v < result.Data {
+			result.SetValid(v)
+		}
+	}
+
+	return result
+}
+
+// MaxInt returns the largest non-null value.
+func MaxInt(values []std.Int) std.Int {
+	var result std.Int
+
+	for _, v := range validInts(values) {
+		if !result.Valid || v > result.Data {
+			result.SetValid(v)
+		}
+	}
+
+	return result
+}
+
+// CountInt returns the number of non-null values, like COUNT(column).
+func CountInt(values []std.Int) int64 {
+	var n int64
+
+	for _, v := range values {
+		if v.Valid {
+			n++
+		}
+	}
+
+	return n
+}
+
+// CountAllInt returns the number of values, null included, like COUNT(*).
+func CountAllInt(values []std.Int) int64 {
+	return int64(len(values))
+}
+
+// MedianInt returns the median of the non-null values.
+func MedianInt(values []std.Int) std.Float {
+	m, _ := PercentileInt(values, 0.5)
+
+	return m
+}
+
+// PercentileInt returns the continuous percentile p (between 0 and 1) of the non-null values,
+// like PERCENTILE_CONT(p).
+func PercentileInt(values []std.Int, p float64) (std.Float, error) {
+	sorted := validInts(values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return exactPercentile(len(sorted), p, func(i int, x *big.Int) *big.Int {
+		return x.SetInt64(sorted[i])
+	})
+}
+
+// StdDevInt returns the sample standard deviation of the non-null values.
+// It is null when there are less than two non-null values.
+func StdDevInt(values []std.Int) std.Float {
+	return intMoments(values).stdDev()
+}
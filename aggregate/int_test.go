@@ -0,0 +1,99 @@
+package aggregate
+
+import (
+	"math"
+	"testing"
+
+	std "github.com/euskadi31/go-std"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	intValues = []std.Int{std.IntFrom(4), {}, std.IntFrom(1), std.IntFrom(3), {}, std.IntFrom(2)}
+	nullInts  = []std.Int{{}, {}}
+)
+
+func TestSumInt(t *testing.T) {
+	sum, err := SumInt(intValues)
+	assert.NoError(t, err)
+	assert.Equal(t, std.IntFrom(10), sum)
+
+	sum, err = SumInt(nullInts)
+	assert.NoError(t, err)
+	assert.False(t, sum.Valid)
+
+	sum, err = SumInt(nil)
+	assert.NoError(t, err)
+	assert.False(t, sum.Valid)
+
+	_, err = SumInt([]std.Int{std.IntFrom(math.MaxInt64), std.IntFrom(1)})
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = SumInt([]std.Int{std.IntFrom(math.MinInt64), std.IntFrom(-1)})
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestAvgInt(t *testing.T) {
+	assert.Equal(t, std.FloatFrom(2.5), AvgInt(intValues))
+	assert.False(t, AvgInt(nullInts).Valid)
+
+	// float64(math.MaxInt64) rounds up, the exact sum would overflow an int64
+	large := []std.Int{std.IntFrom(math.MaxInt64), std.IntFrom(math.MaxInt64 - 2)}
+	assert.Equal(t, std.FloatFrom(math.MaxInt64-1), AvgInt(large))
+	assert.Equal(t, std.FloatFrom(-1<<62), AvgInt([]std.Int{std.IntFrom(math.MinInt64), std.IntFrom(0)}))
+}
+
+func TestMinMaxInt(t *testing.T) {
+	assert.Equal(t, std.IntFrom(1), MinInt(intValues))
+	assert.Equal(t, std.IntFrom(4), MaxInt(intValues))
+	assert.False(t, MinInt(nullInts).Valid)
+	assert.False(t, MaxInt(nullInts).Valid)
+}
+
+func TestCountInt(t *testing.T) {
+	assert.Equal(t, int64(4), CountInt(intValues))
+	assert.Equal(t, int64(6), CountAllInt(intValues))
+	assert.Equal(t, int64(0), CountInt(nullInts))
+	assert.Equal(t, int64(2), CountAllInt(nullInts))
+}
+
+func TestMedianInt(t *testing.T) {
+	assert.Equal(t, std.FloatFrom(2.5), MedianInt(intValues))
+	assert.Equal(t, std.FloatFrom(3), MedianInt([]std.Int{std.IntFrom(5), std.IntFrom(1), std.IntFrom(3)}))
+	assert.False(t, MedianInt(nullInts).Valid)
+}
+
+func TestPercentileInt(t *testing.T) {
+	p, err := PercentileInt(intValues, 0.25)
+	assert.NoError(t, err)
+	assert.Equal(t, std.FloatFrom(1.75), p)
+
+	p, err = PercentileInt(intValues, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, std.FloatFrom(4), p)
+
+	p, err = PercentileInt(nullInts, 0.5)
+	assert.NoError(t, err)
+	assert.False(t, p.Valid)
+
+	_, err = PercentileInt(intValues, 1.5)
+	assert.ErrorIs(t, err, ErrInvalidPercentile)
+
+	// Interpolating the values rounded to float64 gives 1<<63, the exact median is rounded once
+	large := []std.Int{std.IntFrom(math.MaxInt64 - 1), {}, std.IntFrom(math.MaxInt64 - 1023)}
+	assert.Equal(t, std.FloatFrom(math.MaxInt64-512), MedianInt(large))
+
+	p, err = PercentileInt([]std.Int{std.IntFrom(1<<53 + 2), std.IntFrom(1<<53 + 1)}, 0.5)
+	assert.NoError(t, err)
+	assert.Equal(t, std.FloatFrom(1<<53+2), p)
+}
+
+func TestStdDevInt(t *testing.T) {
+	assert.InDelta(t, 1.2909944, StdDevInt(intValues).Data, 1e-7)
+	assert.False(t, StdDevInt([]std.Int{std.IntFrom(1)}).Valid)
+
+	// Summing in float64 loses the deviation of values close to each other
+	large := []std.Int{std.IntFrom(1<<60 + 1), std.IntFrom(1<<60 + 2), std.IntFrom(1<<60 + 3)}
+	assert.Equal(t, std.FloatFrom(1), StdDevInt(large))
+	assert.False(t, StdDevInt(nullInts).Valid)
+}
@@ -0,0 +1,128 @@
+package aggregate
+
+import (
+	"math"
+	"math/big"
+	"sort"
+
+	std "github.com/euskadi31/go-std"
+)
+
+func validUints(values []std.Uint) []uint64 {
+	valid := make([]uint64, 0, len(values))
+
+	for _, v := range values {
+		if v.Valid {
+			valid = append(valid, v.Data)
+		}
+	}
+
+	return valid
+}
+
+func uintMoments(values []std.Uint) *moments {
+	m := &moments{}
+
+	for _, v := range values {
+		if v.Valid {
+			m.addUint(v.Data)
+		}
+	}
+
+	return m
+}
+
+// SumUint returns the sum of the non-null values.
+// It returns ErrOverflow if the sum does not fit in an uint64.
+func SumUint(values []std.Uint) (std.Uint, error) {
+	var (
+		sum   uint64
+		valid bool
+	)
+
+	for _, v := range validUints(values) {
+		if sum > math.MaxUint64-v {
+			return std.Uint{}, ErrOverflow
+		}
+
+		sum += v
+		valid = true
+	}
+
+	return std.NewUint(sum, valid), nil
+}
+
+// AvgUint returns the average of the non-null values.
+func AvgUint(values []std.Uint) std.Float {
+	return uintMoments(values).avg()
+}
+
+// MinUint returns the smallest non-null value.
+func MinUint(values []std.Uint) std.Uint {
+	var result std.Uint
+
+	for _, v := range validUints(values) {
+		if !result.Valid || v < result.Data {
+			result.SetValid(v)
+		}
+	}
+
+	return result
+}
+
+// MaxUint returns the largest non-null value.
+func MaxUint(values []std.Uint) std.Uint {
+	var result std.Uint
+
+	for _, v := range validUints(values) {
+		if !result.Valid || v > result.Data {
+			result.SetValid(v)
+		}
+	}
+
+	return result
+}
+
+// CountUint returns the number of non-null values, like COUNT(column).
+func CountUint(values []std.Uint) int64 {
+	var n int64
+
+	for _, v := range values {
+		if v.Valid {
+			n++
+		}
+	}
+
+	return n
+}
+
+// CountAllUint returns the number of values, null included, like COUNT(*).
+func CountAllUint(values []std.Uint) int64 {
+	return int64(len(values))
+}
+
+// MedianUint returns the median of the non-null values.
+func MedianUint(values []std.Uint) std.Float {
+	m, _ := PercentileUint(values, 0.5)
+
+	return m
+}
+
+// PercentileUint returns the continuous percentile p (between 0 and 1) of the non-null values,
+// like PERCENTILE_CONT(p).
+func PercentileUint(values []std.Uint, p float64) (std.Float, error) {
+	sorted := validUints(values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return exactPercentile(len(sorted), p, func(i int, x *big.Int) *big.Int {
+		return x.SetUint64(sorted[i])
+	})
+}
+
+// StdDevUint returns the sample standard deviation of the non-null values.
+// It is null when there are less than two non-null values.
+func StdDevUint(values []std.Uint) std.Float {
+	return uintMoments(values).stdDev()
+}
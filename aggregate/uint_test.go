@@ -0,0 +1,74 @@
+package aggregate
+
+import (
+	"math"
+	"testing"
+
+	std "github.com/euskadi31/go-std"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	uintValues = []std.Uint{std.UintFrom(4), {}, std.UintFrom(1), std.UintFrom(3), {}, std.UintFrom(2)}
+	nullUints  = []std.Uint{{}, {}}
+)
+
+func TestSumUint(t *testing.T) {
+	sum, err := SumUint(uintValues)
+	assert.NoError(t, err)
+	assert.Equal(t, std.UintFrom(10), sum)
+
+	sum, err = SumUint(nullUints)
+	assert.NoError(t, err)
+	assert.False(t, sum.Valid)
+
+	_, err = SumUint([]std.Uint{std.UintFrom(math.MaxUint64), std.UintFrom(1)})
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestAvgUint(t *testing.T) {
+	assert.Equal(t, std.FloatFrom(2.5), AvgUint(uintValues))
+	assert.False(t, AvgUint(nullUints).Valid)
+
+	large := []std.Uint{std.UintFrom(math.MaxUint64), std.UintFrom(math.MaxUint64)}
+	assert.Equal(t, std.FloatFrom(math.MaxUint64), AvgUint(large))
+}
+
+func TestMinMaxUint(t *testing.T) {
+	assert.Equal(t, std.UintFrom(1), MinUint(uintValues))
+	assert.Equal(t, std.UintFrom(4), MaxUint(uintValues))
+	assert.False(t, MinUint(nullUints).Valid)
+	assert.False(t, MaxUint(nullUints).Valid)
+}
+
+func TestCountUint(t *testing.T) {
+	assert.Equal(t, int64(4), CountUint(uintValues))
+	assert.Equal(t, int64(6), CountAllUint(uintValues))
+	assert.Equal(t, int64(0), CountUint(nullUints))
+}
+
+func TestMedianUint(t *testing.T) {
+	assert.Equal(t, std.FloatFrom(2.5), MedianUint(uintValues))
+	assert.False(t, MedianUint(nullUints).Valid)
+}
+
+func TestPercentileUint(t *testing.T) {
+	p, err := PercentileUint(uintValues, 0.75)
+	assert.NoError(t, err)
+	assert.Equal(t, std.FloatFrom(3.25), p)
+
+	_, err = PercentileUint(uintValues, -0.1)
+	assert.ErrorIs(t, err, ErrInvalidPercentile)
+
+	// Interpolating the values rounded to float64 gives 1<<64, the exact median is rounded once
+	large := []std.Uint{std.UintFrom(math.MaxUint64 - 1), {}, std.UintFrom(math.MaxUint64 - 2047)}
+	assert.Equal(t, std.FloatFrom(math.MaxUint64-1024), MedianUint(large))
+}
+
+func TestStdDevUint(t *testing.T) {
+	assert.InDelta(t, 1.2909944, StdDevUint(uintValues).Data, 1e-7)
+	assert.False(t, StdDevUint(nullUints).Valid)
+
+	large := []std.Uint{std.UintFrom(1<<63 + 1), std.UintFrom(1<<63 + 2), std.UintFrom(1<<63 + 3)}
+	assert.Equal(t, std.FloatFrom(1), StdDevUint(large))
+}
@@ -0,0 +1,85 @@
+package std
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+)
+
+const hexDigits = "0123456789abcdef"
+
+var errTimeRange = errors.New("std: year outside of range [0,9999]")
+
+// appendJSONString appends the JSON string literal of s to b,
+// escaping it exactly like encoding/json does.
+// nolint: gocyclo
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	start := 0
+
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+
+				continue
+			}
+
+			b = append(b, s[start:i]...)
+
+			switch c {
+			case '\\', '"':
+				b = append(b, '\\', c)
+			case '\b':
+				b = append(b, '\\', 'b')
+			case '\f':
+				b = append(b, '\\', 'f')
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xF])
+			}
+
+			i++
+			start = i
+
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+
+		switch {
+		case r == utf8.RuneError && size == 1:
+			b = append(b, s[start:i]...)
+			b = append(b, `\ufffd`...)
+		case r == '\u2028' || r == '\u2029':
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hexDigits[r&0xF])
+		default:
+			i += size
+
+			continue
+		}
+
+		i += size
+		start = i
+	}
+
+	b = append(b, s[start:]...)
+
+	return append(b, '"')
+}
+
+// appendTime appends the RFC 3339 representation of t to b,
+// with the same restrictions as time.Time.MarshalText.
+func appendTime(b []byte, t time.Time) ([]byte, error) {
+	if y := t.Year(); y < 0 || y > 9999 {
+		return b, errTimeRange
+	}
+
+	return t.AppendFormat(b, time.RFC3339Nano), nil
+}
@@ -0,0 +1,40 @@
+//go:build go1.18
+
+package std
+
+import (
+	"encoding/json"
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzAppendJSONString(f *testing.F) {
+	f.Add("test")
+	f.Add("<script>& </script>")
+	f.Add("\x00\xff")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		expected, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := appendJSONString(nil, s)
+
+		if utf8.ValidString(s) && string(actual) != string(expected) {
+			t.Fatalf("appendJSONString(%q) = %s, want %s", s, actual, expected)
+		}
+
+		var decoded, want string
+
+		if err := json.Unmarshal(actual, &decoded); err != nil {
+			t.Fatalf("appendJSONString(%q) = %s: %v", s, actual, err)
+		}
+
+		_ = json.Unmarshal(expected, &want)
+
+		if decoded != want {
+			t.Fatalf("appendJSONString(%q) decodes to %q, want %q", s, decoded, want)
+		}
+	})
+}
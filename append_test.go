@@ -0,0 +1,145 @@
+package std
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonAppender interface {
+	json.Marshaler
+	encoding.TextMarshaler
+	AppendJSON(b []byte) ([]byte, error)
+	AppendText(b []byte) ([]byte, error)
+}
+
+var appenders = map[string]jsonAppender{
+	"Int":           IntFrom(-12345),
+	"NullInt":       Int{},
+	"Uint":          UintFrom(12345),
+	"NullUint":      Uint{},
+	"Float":         FloatFrom(1.2345),
+	"NullFloat":     Float{},
+	"Bool":          BoolFrom(true),
+	"NullBool":      Bool{},
+	"String":        StringFrom(`<a href="test">test</a>`),
+	"NullString":    String{},
+	"Time":          TimeFrom(timeValue),
+	"NullTime":      Time{},
+	"DateTime":      DateTimeFrom(dateTimeValue),
+	"NullDateTime":  DateTime{},
+	"Date":          DateFrom(dateValue),
+	"NullDate":      Date{},
+	"UnicodeString": StringFrom("café \u2028 \xff"),
+}
+
+func TestAppendJSONString(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"test",
+		`"quoted" \ back\slash`,
+		"\b\f\n\r\t\x00\x1f",
+		"<script>&</script>",
+		"café \u2028 \u2029 \U0001F600",
+	} {
+		expected, err := json.Marshal(s)
+		assert.NoError(t, err)
+		assert.Equal(t, string(expected), string(appendJSONString(nil, s)), s)
+	}
+}
+
+func TestAppendJSONStringInvalidUTF8(t *testing.T) {
+	// encoding/json replaces invalid bytes with U+FFFD, escaped or not depending on the Go version.
+	b := appendJSONString(nil, "invalid \xff\xfe utf-8")
+	assert.Equal(t, `"invalid \ufffd\ufffd utf-8"`, string(b))
+
+	var s string
+
+	assert.NoError(t, json.Unmarshal(b, &s))
+	assert.Equal(t, "invalid \ufffd\ufffd utf-8", s)
+}
+
+func TestAppendJSON(t *testing.T) {
+	for name, v := range appenders {
+		expected, err := v.MarshalJSON()
+		assert.NoError(t, err)
+
+		b, err := v.AppendJSON([]byte("prefix:"))
+		assert.NoError(t, err)
+		assert.Equal(t, "prefix:"+string(expected), string(b), name)
+	}
+}
+
+func TestAppendText(t *testing.T) {
+	for name, v := range appenders {
+		expected, err := v.MarshalText()
+		assert.NoError(t, err)
+
+		b, err := v.AppendText([]byte("prefix:"))
+		assert.NoError(t, err)
+		assert.Equal(t, "prefix:"+string(expected), string(b), name)
+	}
+}
+
+func TestAppendTimeRange(t *testing.T) {
+	ti := TimeFrom(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	_, err := ti.MarshalJSON()
+	assert.Error(t, err)
+
+	_, err = ti.MarshalText()
+	assert.Error(t, err)
+}
+
+func TestAppendAllocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+
+	for name, v := range appenders {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = v.AppendJSON(buf[:0])
+			_, _ = v.AppendText(buf[:0])
+		})
+
+		assert.Zero(t, allocs, name)
+	}
+}
+
+func benchmarkAppendJSON(b *testing.B, v jsonAppender) {
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		buf, _ = v.AppendJSON(buf[:0])
+	}
+}
+
+func benchmarkMarshalJSON(b *testing.B, v jsonAppender) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = v.MarshalJSON()
+	}
+}
+
+func BenchmarkIntAppendJSON(b *testing.B)      { benchmarkAppendJSON(b, IntFrom(-12345)) }
+func BenchmarkIntMarshalJSON(b *testing.B)     { benchmarkMarshalJSON(b, IntFrom(-12345)) }
+func BenchmarkUintAppendJSON(b *testing.B)     { benchmarkAppendJSON(b, UintFrom(12345)) }
+func BenchmarkUintMarshalJSON(b *testing.B)    { benchmarkMarshalJSON(b, UintFrom(12345)) }
+func BenchmarkFloatAppendJSON(b *testing.B)    { benchmarkAppendJSON(b, FloatFrom(1.2345)) }
+func BenchmarkFloatMarshalJSON(b *testing.B)   { benchmarkMarshalJSON(b, FloatFrom(1.2345)) }
+func BenchmarkBoolAppendJSON(b *testing.B)     { benchmarkAppendJSON(b, BoolFrom(true)) }
+func BenchmarkBoolMarshalJSON(b *testing.B)    { benchmarkMarshalJSON(b, BoolFrom(true)) }
+func BenchmarkStringAppendJSON(b *testing.B)   { benchmarkAppendJSON(b, StringFrom("test")) }
+func BenchmarkStringMarshalJSON(b *testing.B)  { benchmarkMarshalJSON(b, StringFrom("test")) }
+func BenchmarkTimeAppendJSON(b *testing.B)     { benchmarkAppendJSON(b, TimeFrom(timeValue)) }
+func BenchmarkTimeMarshalJSON(b *testing.B)    { benchmarkMarshalJSON(b, TimeFrom(timeValue)) }
+func BenchmarkDateTimeAppendJSON(b *testing.B) { benchmarkAppendJSON(b, DateTimeFrom(dateTimeValue)) }
+func BenchmarkDateTimeMarshalJSON(b *testing.B) {
+	benchmarkMarshalJSON(b, DateTimeFrom(dateTimeValue))
+}
+func BenchmarkDateAppendJSON(b *testing.B)  { benchmarkAppendJSON(b, DateFrom(dateValue)) }
+func BenchmarkDateMarshalJSON(b *testing.B) { benchmarkMarshalJSON(b, DateFrom(dateValue)) }
@@ -0,0 +1,620 @@
+package std
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidArray is returned when a Postgres array literal is malformed.
+var ErrInvalidArray = errors.New("std: invalid array literal")
+
+// arrayElement is an element of a Postgres array literal.
+type arrayElement struct {
+	text string
+	null bool
+}
+
+// maxArrayDims is the maximum number of dimensions of a Postgres array.
+const maxArrayDims = 6
+
+// parseArray parses a Postgres array literal like {a,"b c",NULL} or {{1,2},{3,4}}.
+// The optional dimension decoration ([1:3]={...}) is skipped.
+// The elements of multi-dimensional arrays are returned in row-major order with the dimensions,
+// which are nil for one-dimensional arrays.
+func parseArray(src []byte) ([]arrayElement, []int, error) {
+	if len(src) > 0 && src[0] == '[' {
+		i := bytes.IndexByte(src, '=')
+		if i < 0 {
+			return nil, nil, fmt.Errorf("%w: %q", ErrInvalidArray, src)
+		}
+
+		src = src[i+1:]
+	}
+
+	p := arrayParser{src: src}
+
+	p.skipSpaces()
+
+	elems, dims, err := p.parseLevel()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %q: %v", ErrInvalidArray, src, err)
+	}
+
+	p.skipSpaces()
+
+	if p.pos != len(p.src) {
+		return nil, nil, fmt.Errorf("%w: %q: unexpected data after the closing brace", ErrInvalidArray, src)
+	}
+
+	if len(dims) > maxArrayDims {
+		return nil, nil, fmt.Errorf("%w: %q: %d dimensions, at most %d are allowed", ErrInvalidArray, src, len(dims), maxArrayDims)
+	}
+
+	if len(dims) == 1 {
+		dims = nil
+	}
+
+	return elems, dims, nil
+}
+
+type arrayParser struct {
+	src []byte
+	pos int
+}
+
+func (p *arrayParser) skipSpaces() {
+	for p.pos < len(p.src) && isArraySpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// parseLevel parses a brace-enclosed level and returns its elements, nested ones included,
+// and its dimensions. The sub-arrays of a level must all have the same dimensions.
+func (p *arrayParser) parseLevel() ([]arrayElement, []int, error) {
+	if p.pos == len(p.src) || p.src[p.pos] != '{' {
+		return nil, nil, errors.New("expected an opening brace")
+	}
+
+	p.pos++
+	p.skipSpaces()
+
+	elems := []arrayElement{}
+
+	if p.pos < len(p.src) && p.src[p.pos] == '}' {
+		p.pos++
+
+		return elems, []int{0}, nil
+	}
+
+	var (
+		n       int
+		subDims []int
+	)
+
+	for {
+		p.skipSpaces()
+
+		nested := p.pos < len(p.src) && p.src[p.pos] == '{'
+
+		switch {
+		case n > 0 && nested != (subDims != nil):
+			return nil, nil, errors.New("mixed elements and sub-arrays")
+		case nested:
+			sub, dims, err := p.parseLevel()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if dims[0] == 0 {
+				return nil, nil, errors.New("empty sub-array")
+			}
+
+			if subDims != nil && !equalDims(dims, subDims) {
+				return nil, nil, errors.New("sub-arrays must have matching dimensions")
+			}
+
+			subDims = dims
+			elems = append(elems, sub...)
+		default:
+			elem, err := p.parseElement()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			elems = append(elems, elem)
+		}
+
+		n++
+
+		p.skipSpaces()
+
+		if p.pos == len(p.src) {
+			return nil, nil, errors.New("expected a closing brace")
+		}
+
+		c := p.src[p.pos]
+		p.pos++
+
+		switch c {
+		case ',':
+		case '}':
+			return elems, append([]int{n}, subDims...), nil
+		default:
+			return nil, nil, fmt.Errorf("unexpected %q", c)
+		}
+	}
+}
+
+func equalDims(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compareArrays compares two arrays of n and m elements element by element with elem,
+// then by number of elements and by dimensions, like Postgres does.
+func compareArrays(n, m int, aDims, bDims []int, elem func(k int) int) int {
+	for k := 0; k < n && k < m; k++ {
+		if c := elem(k); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case n < m:
+		return -1
+	case n > m:
+		return 1
+	}
+
+	// One-dimensional arrays have nil dimensions
+	if len(aDims) <= 1 {
+		aDims = nil
+	}
+
+	if len(bDims) <= 1 {
+		bDims = nil
+	}
+
+	for k := 0; k < len(aDims) && k < len(bDims); k++ {
+		switch {
+		case aDims[k] < bDims[k]:
+			return -1
+		case aDims[k] > bDims[k]:
+			return 1
+		}
+	}
+
+	switch {
+	case len(aDims) < len(bDims):
+		return -1
+	case len(aDims) > len(bDims):
+		return 1
+	}
+
+	return 0
+}
+
+// marshalArrayText returns the array literal of v, or a blank text if v is null.
+func marshalArrayText(v driver.Valuer) ([]byte, error) {
+	value, err := v.Value()
+	if err != nil {
+		return nil, err // nolint: wrapcheck
+	}
+
+	text, _ := value.(string)
+
+	return []byte(text), nil
+}
+
+// unmarshalArrayText scans the array literal text into s, a blank text is a null array.
+func unmarshalArrayText(text []byte, s sql.Scanner) error {
+	if len(text) == 0 {
+		return s.Scan(nil)
+	}
+
+	return s.Scan(text)
+}
+
+// checkArrayDims checks the dimensions of a multi-dimensional array of n elements, for the array type typ.
+func checkArrayDims(dims []int, n int, typ string) error {
+	if len(dims) == 0 {
+		return nil
+	}
+
+	size := 1
+
+	for _, d := range dims {
+		if d <= 0 {
+			return fmt.Errorf("std: invalid dimensions %v of std.%s", dims, typ)
+		}
+
+		size *= d
+	}
+
+	if len(dims) > maxArrayDims || size != n {
+		return fmt.Errorf("std: invalid dimensions %v of std.%s with %d elements", dims, typ, n)
+	}
+
+	return nil
+}
+
+// parseElement parses a quoted or unquoted element.
+func (p *arrayParser) parseElement() (arrayElement, error) {
+	var buf strings.Builder
+
+	if p.pos < len(p.src) && p.src[p.pos] == '"' {
+		p.pos++
+
+		for p.pos < len(p.src) {
+			c := p.src[p.pos]
+			p.pos++
+
+			switch c {
+			case '"':
+				return arrayElement{text: buf.String()}, nil
+			case '\\':
+				if p.pos == len(p.src) {
+					return arrayElement{}, errors.New("unterminated escape")
+				}
+
+				buf.WriteByte(p.src[p.pos])
+				p.pos++
+			default:
+				buf.WriteByte(c)
+			}
+		}
+
+		return arrayElement{}, errors.New("unterminated quoted element")
+	}
+
+	escaped := false
+
+	// Trailing spaces are not part of the element, unless escaped
+	end := 0
+
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+
+		switch {
+		case c == ',' || c == '}':
+			text := buf.String()[:end]
+			if text == "" {
+				return arrayElement{}, errors.New("empty element")
+			}
+
+			if !escaped && strings.EqualFold(text, "NULL") {
+				return arrayElement{null: true}, nil
+			}
+
+			return arrayElement{text: text}, nil
+		case c == '{' || c == '"':
+			return arrayElement{}, fmt.Errorf("unexpected %q", c)
+		case c == '\\':
+			p.pos++
+			if p.pos == len(p.src) {
+				return arrayElement{}, errors.New("unterminated escape")
+			}
+
+			escaped = true
+
+			buf.WriteByte(p.src[p.pos])
+			end = buf.Len()
+		default:
+			buf.WriteByte(c)
+
+			if !isArraySpace(c) {
+				end = buf.Len()
+			}
+		}
+
+		p.pos++
+	}
+
+	return arrayElement{}, errors.New("expected a closing brace")
+}
+
+func isArraySpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// appendArrayElement appends s to the array literal b, quoted if needed.
+func appendArrayElement(b []byte, s string) []byte {
+	if !arrayElementNeedsQuotes(s) {
+		return append(b, s...)
+	}
+
+	b = append(b, '"')
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b = append(b, '\\')
+		}
+
+		b = append(b, s[i])
+	}
+
+	return append(b, '"')
+}
+
+func arrayElementNeedsQuotes(s string) bool {
+	if s == "" || strings.EqualFold(s, "NULL") {
+		return true
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '{' || c == '}' || c == ',' || c == '"' || c == '\\' || isArraySpace(c):
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendArray appends the array literal of n elements with the dimensions dims to b,
+// using elem to append each element. A nil dims is a one-dimensional array.
+func appendArray(b []byte, dims []int, n int, typ string, elem func(b []byte, i int) []byte) ([]byte, error) {
+	if err := checkArrayDims(dims, n, typ); err != nil {
+		return nil, err
+	}
+
+	if len(dims) == 0 {
+		dims = []int{n}
+	}
+
+	next := 0
+
+	return appendArrayLevel(b, dims, &next, elem), nil
+}
+
+func appendArrayLevel(b []byte, dims []int, next *int, elem func(b []byte, i int) []byte) []byte {
+	b = append(b, '{')
+
+	for i := 0; i < dims[0]; i++ {
+		if i > 0 {
+			b = append(b, ',')
+		}
+
+		if len(dims) > 1 {
+			b = appendArrayLevel(b, dims[1:], next, elem)
+
+			continue
+		}
+
+		b = elem(b, *next)
+		*next++
+	}
+
+	return append(b, '}')
+}
+
+// scanArray parses the array literal value into elements and dimensions, for the array type typ.
+// It returns false if value is nil.
+func scanArray(value interface{}, typ string) ([]arrayElement, []int, bool, error) {
+	switch x := value.(type) {
+	case nil:
+		return nil, nil, false, nil
+	case []byte:
+		elems, dims, err := parseArray(x)
+
+		return elems, dims, err == nil, err
+	case string:
+		elems, dims, err := parseArray([]byte(x))
+
+		return elems, dims, err == nil, err
+	}
+
+	return nil, nil, false, fmt.Errorf("std: cannot scan type %T into std.%s: %v", value, typ, value)
+}
+
+// errArrayNull is returned when a NULL element is scanned into an array of non-nullable elements.
+func errArrayNull(typ string) error {
+	return fmt.Errorf("std: cannot scan a NULL element into std.%s, use std.Null%s", typ, typ)
+}
+
+// unmarshalJSONArray decodes the JSON array data into dest, nested arrays are flattened
+// in row-major order and their dimensions returned. It returns false if data is null.
+func unmarshalJSONArray(data []byte, typ string, dest interface{}) ([]int, bool, error) {
+	kind, err := jsonKindOf(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("json: cannot unmarshal %s into Go value of type std.%s: %w", string(data), typ, err)
+	}
+
+	switch kind {
+	case jsonNull:
+		return nil, false, nil
+	case jsonArray:
+		var dims []int
+
+		if isNestedJSONArray(data) {
+			var elems []json.RawMessage
+
+			if elems, dims, err = flattenJSONArray(data); err != nil {
+				return nil, false, fmt.Errorf("json: cannot unmarshal %s into Go value of type std.%s: %w", string(data), typ, err)
+			}
+
+			if len(dims) > maxArrayDims {
+				return nil, false, fmt.Errorf("json: cannot unmarshal %s into Go value of type std.%s: too many dimensions", string(data), typ)
+			}
+
+			data = appendJSONArray(nil, []int{len(elems)}, elems)
+		}
+
+		if err := json.Unmarshal(data, dest); err != nil {
+			return nil, false, err // nolint: wrapcheck
+		}
+
+		return dims, true, nil
+	}
+
+	return nil, false, fmt.Errorf("json: cannot unmarshal %s into Go value of type std.%s", string(data), typ)
+}
+
+// isNestedJSONArray reports whether the first element of the JSON array data is an array.
+func isNestedJSONArray(data []byte) bool {
+	data = bytes.TrimLeft(bytes.TrimSpace(data)[1:], " \t\r\n")
+
+	return len(data) > 0 && data[0] == '['
+}
+
+// flattenJSONArray returns the elements of the nested JSON array data in row-major order, and its dimensions.
+func flattenJSONArray(data []byte) ([]json.RawMessage, []int, error) {
+	var items []json.RawMessage
+
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, nil, err // nolint: wrapcheck
+	}
+
+	if len(items) == 0 || !isNestedJSONArray(data) {
+		for _, item := range items {
+			if item = bytes.TrimSpace(item); len(item) > 0 && item[0] == '[' {
+				return nil, nil, errors.New("mixed elements and sub-arrays")
+			}
+		}
+
+		return items, []int{len(items)}, nil
+	}
+
+	var (
+		elems   []json.RawMessage
+		subDims []int
+	)
+
+	for _, item := range items {
+		if item = bytes.TrimSpace(item); len(item) == 0 || item[0] != '[' {
+			return nil, nil, errors.New("mixed elements and sub-arrays")
+		}
+
+		sub, dims, err := flattenJSONArray(item)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if dims[0] == 0 {
+			return nil, nil, errors.New("empty sub-array")
+		}
+
+		if subDims != nil && !equalDims(dims, subDims) {
+			return nil, nil, errors.New("sub-arrays must have matching dimensions")
+		}
+
+		subDims = dims
+		elems = append(elems, sub...)
+	}
+
+	return elems, append([]int{len(items)}, subDims...), nil
+}
+
+// marshalJSONArray encodes the elements of data, a slice, as a JSON array nested according to dims.
+func marshalJSONArray(data interface{}, n int, dims []int, typ string) ([]byte, error) {
+	if err := checkArrayDims(dims, n, typ); err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return []byte("[]"), nil
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil || len(dims) <= 1 {
+		return b, err // nolint: wrapcheck
+	}
+
+	var elems []json.RawMessage
+
+	if err := json.Unmarshal(b, &elems); err != nil {
+		return nil, err // nolint: wrapcheck
+	}
+
+	return appendJSONArray(nil, dims, elems), nil
+}
+
+// appendJSONArray appends the JSON array of elems nested according to dims to b.
+func appendJSONArray(b []byte, dims []int, elems []json.RawMessage) []byte {
+	b = append(b, '[')
+
+	size := 0
+	if dims[0] > 0 {
+		size = len(elems) / dims[0]
+	}
+
+	for i := 0; i < dims[0]; i++ {
+		if i > 0 {
+			b = append(b, ',')
+		}
+
+		if len(dims) > 1 {
+			b = appendJSONArray(b, dims[1:], elems[i*size:(i+1)*size])
+		} else {
+			b = append(b, elems[i]...)
+		}
+	}
+
+	return append(b, ']')
+}
+
+// errArrayElement is returned when an element can not be parsed.
+func errArrayElement(text, typ string, err error) error {
+	return fmt.Errorf("std: cannot scan element %q into std.%s: %w", text, typ, err)
+}
+
+// appendArrayFloat appends f to an array literal, with the Postgres spelling of infinities.
+func appendArrayFloat(b []byte, f float64) []byte {
+	switch {
+	case math.IsInf(f, 1):
+		return append(b, "Infinity"...)
+	case math.IsInf(f, -1):
+		return append(b, "-Infinity"...)
+	}
+
+	return strconv.AppendFloat(b, f, 'g', -1, 64)
+}
+
+func appendArrayBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, 't')
+	}
+
+	return append(b, 'f')
+}
+
+// arrayTimeLayouts are the timestamp and timestamptz output formats of Postgres, and RFC 3339.
+var arrayTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07:00:00",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC3339Nano,
+}
+
+// parseArrayTime parses a time element, times without a time zone are UTC.
+func parseArrayTime(s string) (time.Time, error) {
+	var err error
+
+	for _, layout := range arrayTimeLayouts {
+		var t time.Time
+
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err // nolint: wrapcheck
+}
@@ -0,0 +1,189 @@
+package std
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseArray(t *testing.T) {
+	testCases := []struct {
+		src      string
+		expected []arrayElement
+	}{
+		{`{}`, []arrayElement{}},
+		{` { } `, []arrayElement{}},
+		{`{a}`, []arrayElement{{text: "a"}}},
+		{`{a,b}`, []arrayElement{{text: "a"}, {text: "b"}}},
+		{`{ a b , c }`, []arrayElement{{text: "a b"}, {text: "c"}}},
+		{`{NULL,null,"NULL"}`, []arrayElement{{null: true}, {null: true}, {text: "NULL"}}},
+		{`{"",""}`, []arrayElement{{text: ""}, {text: ""}}},
+		{`{"a,b","{c}","d\"e","f\\g"}`, []arrayElement{{text: "a,b"}, {text: "{c}"}, {text: `d"e`}, {text: `f\g`}}},
+		{`{a\,b,\NULL,c\ }`, []arrayElement{{text: "a,b"}, {text: "NULL"}, {text: "c "}}},
+		{`{"2012-12-21 21:21:21+00"}`, []arrayElement{{text: "2012-12-21 21:21:21+00"}}},
+		{`[1:2]={1,2}`, []arrayElement{{text: "1"}, {text: "2"}}},
+		{`{é,"ü"}`, []arrayElement{{text: "é"}, {text: "ü"}}},
+	}
+
+	for _, tc := range testCases {
+		elems, dims, err := parseArray([]byte(tc.src))
+		assert.NoError(t, err, tc.src)
+		assert.Equal(t, tc.expected, elems, tc.src)
+		assert.Nil(t, dims, tc.src)
+	}
+}
+
+func TestParseArrayDims(t *testing.T) {
+	testCases := []struct {
+		src      string
+		expected []string
+		dims     []int
+	}{
+		{`{{1,2},{3,4}}`, []string{"1", "2", "3", "4"}, []int{2, 2}},
+		{` { { 1 , 2 , 3 } , { 4 , 5 , 6 } } `, []string{"1", "2", "3", "4", "5", "6"}, []int{2, 3}},
+		{`{{a}}`, []string{"a"}, []int{1, 1}},
+		{`{{{a,b}},{{c,d}}}`, []string{"a", "b", "c", "d"}, []int{2, 1, 2}},
+		{`[1:2][1:2]={{"{a}",NULL},{c,d}}`, []string{"{a}", "", "c", "d"}, []int{2, 2}},
+	}
+
+	for _, tc := range testCases {
+		elems, dims, err := parseArray([]byte(tc.src))
+		assert.NoError(t, err, tc.src)
+		assert.Equal(t, tc.dims, dims, tc.src)
+
+		texts := make([]string, len(elems))
+		for i, e := range elems {
+			texts[i] = e.text
+		}
+
+		assert.Equal(t, tc.expected, texts, tc.src)
+	}
+}
+
+func TestParseArrayErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`a`,
+		`{`,
+		`{a`,
+		`{a,}`,
+		`{,a}`,
+		`{"a}`,
+		`{"a"b}`,
+		`{a"b"}`,
+		`{a\`,
+		`{a}b`,
+		`[1:2]{1,2}`,
+		`{{1,2},3}`,
+		`{1,{2,3}}`,
+		`{{1,2},{3}}`,
+		`{{1},{{2}}}`,
+		`{{},{}}`,
+		`{{1,2},{3,4}`,
+		`{{{{{{{1}}}}}}}`,
+	} {
+		_, _, err := parseArray([]byte(src))
+		assert.True(t, errors.Is(err, ErrInvalidArray), "%s: %v", src, err)
+	}
+}
+
+func TestAppendArrayElement(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected string
+	}{
+		{"a", `a`},
+		{"", `""`},
+		{"NULL", `"NULL"`},
+		{"null", `"null"`},
+		{"a b", `"a b"`},
+		{"a,b", `"a,b"`},
+		{"{a}", `"{a}"`},
+		{`a"b`, `"a\"b"`},
+		{`a\b`, `"a\\b"`},
+		{"é", `é`},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, string(appendArrayElement(nil, tc.value)), tc.value)
+
+		// The element is parsed back to the same value
+		literal, err := appendArray(nil, nil, 1, "Strings", func(b []byte, _ int) []byte {
+			return appendArrayElement(b, tc.value)
+		})
+		assert.NoError(t, err)
+
+		elems, _, err := parseArray(literal)
+		assert.NoError(t, err)
+		assert.Equal(t, []arrayElement{{text: tc.value}}, elems)
+	}
+}
+
+func TestScanArrayType(t *testing.T) {
+	_, _, _, err := scanArray(int64(1), "Strings")
+	assert.EqualError(t, err, "std: cannot scan type int64 into std.Strings: 1")
+}
+
+func TestAppendArrayDims(t *testing.T) {
+	elem := func(b []byte, i int) []byte {
+		return strconv.AppendInt(b, int64(i), 10)
+	}
+
+	literal, err := appendArray(nil, []int{2, 3}, 6, "Ints", elem)
+	assert.NoError(t, err)
+	assert.Equal(t, `{{0,1,2},{3,4,5}}`, string(literal))
+
+	literal, err = appendArray(nil, []int{3}, 3, "Ints", elem)
+	assert.NoError(t, err)
+	assert.Equal(t, `{0,1,2}`, string(literal))
+
+	_, err = appendArray(nil, []int{2, 2}, 3, "Ints", elem)
+	assert.EqualError(t, err, "std: invalid dimensions [2 2] of std.Ints with 3 elements")
+
+	_, err = appendArray(nil, []int{0, 2}, 0, "Ints", elem)
+	assert.EqualError(t, err, "std: invalid dimensions [0 2] of std.Ints")
+}
+
+func TestUnmarshalJSONArrayDims(t *testing.T) {
+	var d []int64
+
+	dims, valid, err := unmarshalJSONArray([]byte(` [ [1,2] , [3,4] , [5,6] ] `), "Ints", &d)
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, []int{3, 2}, dims)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6}, d)
+
+	for _, src := range []string{`[[1,2],3]`, `[1,[2]]`, `[[1,2],[3]]`, `[[],[]]`, `[[[[[[[1]]]]]]]`, `[["a"]]`} {
+		var e []int64
+
+		_, _, err := unmarshalJSONArray([]byte(src), "Ints", &e)
+		assert.Error(t, err, src)
+	}
+
+	data, err := marshalJSONArray(d, len(d), dims, "Ints")
+	assert.NoError(t, err)
+	assert.Equal(t, `[[1,2],[3,4],[5,6]]`, string(data))
+
+	_, err = marshalJSONArray(d, len(d), []int{4}, "Ints")
+	assert.Error(t, err)
+}
+
+func TestCompareArrays(t *testing.T) {
+	a := []int{1, 2, 3}
+	b := []int{1, 3}
+
+	elem := func(x, y []int) func(k int) int {
+		return func(k int) int {
+			return IntFrom(int64(x[k])).Compare(IntFrom(int64(y[k])), NullsFirst)
+		}
+	}
+
+	assert.Equal(t, -1, compareArrays(len(a), len(b), nil, nil, elem(a, b)))
+	assert.Equal(t, 1, compareArrays(len(b), len(a), nil, nil, elem(b, a)))
+	assert.Equal(t, -1, compareArrays(2, 3, nil, nil, elem(a, a)))
+	assert.Equal(t, 0, compareArrays(3, 3, nil, []int{3}, elem(a, a)))
+	assert.Equal(t, -1, compareArrays(4, 4, []int{2, 2}, []int{4, 1}, func(int) int { return 0 }))
+	assert.Equal(t, 1, compareArrays(4, 4, []int{2, 2}, nil, func(int) int { return 0 }))
+}
@@ -0,0 +1,449 @@
+package std
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// binaryVersion is the version of the binary layout, written as the first byte.
+//
+// The layout of version 1 is the version byte, a null flag byte (0 for null, 1 for valid)
+// and, only for valid values, the payload: a varint for Int, an uvarint for Uint,
+// the 8 bytes big-endian IEEE 754 representation for Float, one byte for Bool,
+// the raw bytes for String, the time.Time binary form for the time types
+// and the text form for the types encoded as text, like IP or URL.
+const binaryVersion byte = 1
+
+// ErrInvalidBinary is returned when decoding malformed binary data.
+var ErrInvalidBinary = errors.New("std: invalid binary data")
+
+func appendBinaryHeader(b []byte, valid bool) []byte {
+	if !valid {
+		return append(b, binaryVersion, 0)
+	}
+
+	return append(b, binaryVersion, 1)
+}
+
+// readBinaryHeader checks the header of data, it returns the payload and the null flag.
+func readBinaryHeader(data []byte, typ string) ([]byte, bool, error) {
+	if len(data) < 2 {
+		return nil, false, fmt.Errorf("%w: %s: data too short", ErrInvalidBinary, typ)
+	}
+
+	if data[0] != binaryVersion {
+		return nil, false, fmt.Errorf("%w: %s: unsupported version %d", ErrInvalidBinary, typ, data[0])
+	}
+
+	switch data[1] {
+	case 0:
+		if len(data) != 2 {
+			return nil, false, fmt.Errorf("%w: %s: unexpected payload for null value", ErrInvalidBinary, typ)
+		}
+
+		return nil, false, nil
+	case 1:
+		return data[2:], true, nil
+	}
+
+	return nil, false, fmt.Errorf("%w: %s: invalid null flag %d", ErrInvalidBinary, typ, data[1])
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s String) MarshalBinary() ([]byte, error) {
+	b := appendBinaryHeader(make([]byte, 0, 2+len(s.Data)), s.Valid)
+
+	if !s.Valid {
+		return b, nil
+	}
+
+	return append(b, s.Data...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *String) UnmarshalBinary(data []byte) error {
+	payload, valid, err := readBinaryHeader(data, "std.String")
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*s = String{}
+
+		return nil
+	}
+
+	s.SetValid(string(payload))
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s String) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *String) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Int) MarshalBinary() ([]byte, error) {
+	b := appendBinaryHeader(make([]byte, 0, 2+binary.MaxVarintLen64), i.Valid)
+
+	if !i.Valid {
+		return b, nil
+	}
+
+	var buf [binary.MaxVarintLen64]byte
+
+	n := binary.PutVarint(buf[:], i.Data)
+
+	return append(b, buf[:n]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Int) UnmarshalBinary(data []byte) error {
+	payload, valid, err := readBinaryHeader(data, "std.Int")
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*i = Int{}
+
+		return nil
+	}
+
+	v, n := binary.Varint(payload)
+	if n <= 0 || n != len(payload) || !canonicalVarint(payload) {
+		return fmt.Errorf("%w: std.Int: invalid varint", ErrInvalidBinary)
+	}
+
+	i.SetValid(v)
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Int) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Int) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Uint) MarshalBinary() ([]byte, error) {
+	b := appendBinaryHeader(make([]byte, 0, 2+binary.MaxVarintLen64), i.Valid)
+
+	if !i.Valid {
+		return b, nil
+	}
+
+	var buf [binary.MaxVarintLen64]byte
+
+	n := binary.PutUvarint(buf[:], i.Data)
+
+	return append(b, buf[:n]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Uint) UnmarshalBinary(data []byte) error {
+	payload, valid, err := readBinaryHeader(data, "std.Uint")
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*i = Uint{}
+
+		return nil
+	}
+
+	v, n := binary.Uvarint(payload)
+	if n <= 0 || n != len(payload) || !canonicalVarint(payload) {
+		return fmt.Errorf("%w: std.Uint: invalid uvarint", ErrInvalidBinary)
+	}
+
+	i.SetValid(v)
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Uint) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Uint) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (f Float) MarshalBinary() ([]byte, error) {
+	b := appendBinaryHeader(make([]byte, 0, 10), f.Valid)
+
+	if !f.Valid {
+		return b, nil
+	}
+
+	var buf [8]byte
+
+	binary.BigEndian.PutUint64(buf[:], math.Float64bits(f.Data))
+
+	return append(b, buf[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Float) UnmarshalBinary(data []byte) error {
+	payload, valid, err := readBinaryHeader(data, "std.Float")
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*f = Float{}
+
+		return nil
+	}
+
+	if len(payload) != 8 {
+		return fmt.Errorf("%w: std.Float: invalid length %d", ErrInvalidBinary, len(payload))
+	}
+
+	f.SetValid(math.Float64frombits(binary.BigEndian.Uint64(payload)))
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (f Float) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (f *Float) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b Bool) MarshalBinary() ([]byte, error) {
+	data := appendBinaryHeader(make([]byte, 0, 3), b.Valid)
+
+	if !b.Valid {
+		return data, nil
+	}
+
+	if b.Data {
+		return append(data, 1), nil
+	}
+
+	return append(data, 0), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Bool) UnmarshalBinary(data []byte) error {
+	payload, valid, err := readBinaryHeader(data, "std.Bool")
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*b = Bool{}
+
+		return nil
+	}
+
+	if len(payload) != 1 || payload[0] > 1 {
+		return fmt.Errorf("%w: std.Bool: invalid payload", ErrInvalidBinary)
+	}
+
+	b.SetValid(payload[0] == 1)
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b Bool) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *Bool) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return marshalBinaryTime(t.Time, t.Valid)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	payload, valid, err := readBinaryHeader(data, "std.Time")
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*t = Time{}
+
+		return nil
+	}
+
+	var v time.Time
+
+	if err := v.UnmarshalBinary(payload); err != nil {
+		return fmt.Errorf("%w: std.Time: %v", ErrInvalidBinary, err)
+	}
+
+	t.SetValid(v)
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (t *Time) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t DateTime) MarshalBinary() ([]byte, error) {
+	return marshalBinaryTime(t.Data, t.Valid)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *DateTime) UnmarshalBinary(data []byte) error {
+	payload, valid, err := readBinaryHeader(data, "std.DateTime")
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*t = DateTime{}
+
+		return nil
+	}
+
+	var v time.Time
+
+	if err := v.UnmarshalBinary(payload); err != nil {
+		return fmt.Errorf("%w: std.DateTime: %v", ErrInvalidBinary, err)
+	}
+
+	t.SetValid(v)
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (t DateTime) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (t *DateTime) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t Date) MarshalBinary() ([]byte, error) {
+	return marshalBinaryTime(t.Data, t.Valid)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *Date) UnmarshalBinary(data []byte) error {
+	payload, valid, err := readBinaryHeader(data, "std.Date")
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*t = Date{}
+
+		return nil
+	}
+
+	var v time.Time
+
+	if err := v.UnmarshalBinary(payload); err != nil {
+		return fmt.Errorf("%w: std.Date: %v", ErrInvalidBinary, err)
+	}
+
+	t.SetValid(v)
+
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (t Date) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (t *Date) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// canonicalVarint reports whether the varint b is in its shortest form,
+// so that every value has a single binary representation.
+func canonicalVarint(b []byte) bool {
+	return len(b) == 1 || b[len(b)-1] != 0
+}
+
+func marshalBinaryTime(t time.Time, valid bool) ([]byte, error) {
+	b := appendBinaryHeader(make([]byte, 0, 18), valid)
+
+	if !valid {
+		return b, nil
+	}
+
+	payload, err := t.MarshalBinary()
+	if err != nil {
+		return nil, err // nolint: wrapcheck
+	}
+
+	return append(b, payload...), nil
+}
+
+// marshalBinaryText encodes a value whose payload is its text form.
+func marshalBinaryText(valid bool, text func() ([]byte, error)) ([]byte, error) {
+	if !valid {
+		return appendBinaryHeader(nil, false), nil
+	}
+
+	payload, err := text()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(appendBinaryHeader(make([]byte, 0, 2+len(payload)), true), payload...), nil
+}
+
+// unmarshalBinaryText decodes data encoded by marshalBinaryText with the UnmarshalText method of u.
+func unmarshalBinaryText(data []byte, typ string, u encoding.TextUnmarshaler) error {
+	payload, valid, err := readBinaryHeader(data, typ)
+	if err != nil {
+		return err
+	}
+
+	if valid && len(payload) == 0 {
+		return fmt.Errorf("%w: %s: empty payload", ErrInvalidBinary, typ)
+	}
+
+	return u.UnmarshalText(payload)
+}
@@ -0,0 +1,175 @@
+//go:build go1.18
+
+package std
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+)
+
+func FuzzIntBinary(f *testing.F) {
+	f.Add(int64(12345), true)
+	f.Add(int64(math.MinInt64), true)
+	f.Add(int64(0), false)
+
+	f.Fuzz(func(t *testing.T, n int64, valid bool) {
+		v := NewInt(n, valid).Filter(func(int64) bool { return valid })
+
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded Int
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded != v {
+			t.Fatalf("round trip of %#v gives %#v", v, decoded)
+		}
+	})
+}
+
+func FuzzUintBinary(f *testing.F) {
+	f.Add(uint64(12345), true)
+	f.Add(uint64(math.MaxUint64), true)
+	f.Add(uint64(0), false)
+
+	f.Fuzz(func(t *testing.T, n uint64, valid bool) {
+		v := NewUint(n, valid).Filter(func(uint64) bool { return valid })
+
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded Uint
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded != v {
+			t.Fatalf("round trip of %#v gives %#v", v, decoded)
+		}
+	})
+}
+
+func FuzzFloatBinary(f *testing.F) {
+	f.Add(1.2345, true)
+	f.Add(math.Inf(1), true)
+	f.Add(0.0, false)
+
+	f.Fuzz(func(t *testing.T, n float64, valid bool) {
+		v := NewFloat(n, valid).Filter(func(float64) bool { return valid })
+
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded Float
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded.Valid != v.Valid || math.Float64bits(decoded.Data) != math.Float64bits(v.Data) {
+			t.Fatalf("round trip of %#v gives %#v", v, decoded)
+		}
+	})
+}
+
+func FuzzStringBinary(f *testing.F) {
+	f.Add("test", true)
+	f.Add("", true)
+	f.Add("", false)
+
+	f.Fuzz(func(t *testing.T, s string, valid bool) {
+		v := NewString(s, valid).Filter(func(string) bool { return valid })
+
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded String
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded != v {
+			t.Fatalf("round trip of %#v gives %#v", v, decoded)
+		}
+	})
+}
+
+func FuzzTimeBinary(f *testing.F) {
+	f.Add(timeValue.Unix(), int64(0), 0, true)
+	f.Add(int64(0), int64(999999999), 3600, true)
+	f.Add(int64(0), int64(0), 0, false)
+
+	f.Fuzz(func(t *testing.T, sec int64, nsec int64, offset int, valid bool) {
+		if offset%60 != 0 || offset < -12*3600 || offset > 14*3600 {
+			t.Skip()
+		}
+
+		v := NewTime(time.Unix(sec, nsec).In(time.FixedZone("", offset)), valid).Filter(func(time.Time) bool { return valid })
+
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Skip()
+		}
+
+		var decoded Time
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Equal(v) {
+			t.Fatalf("round trip of %#v gives %#v", v, decoded)
+		}
+	})
+}
+
+func FuzzUnmarshalBinary(f *testing.F) {
+	for _, v := range []interface{ MarshalBinary() ([]byte, error) }{
+		IntFrom(12345), UintFrom(12345), FloatFrom(1.2345), BoolFrom(true), StringFrom("test"), TimeFrom(timeValue), Int{},
+	} {
+		data, _ := v.MarshalBinary()
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, v := range []interface {
+			MarshalBinary() ([]byte, error)
+			UnmarshalBinary([]byte) error
+		}{
+			&Int{}, &Uint{}, &Float{}, &Bool{}, &String{}, &Time{}, &DateTime{}, &Date{},
+		} {
+			if err := v.UnmarshalBinary(data); err != nil {
+				continue
+			}
+
+			encoded, err := v.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, ok := v.(*Float); !ok && !bytes.Equal(encoded, data) && !isTimeValue(v) {
+				t.Fatalf("%T: %x re-encoded as %x", v, data, encoded)
+			}
+		}
+	})
+}
+
+// isTimeValue reports whether v is a time type, whose binary form is not canonical.
+func isTimeValue(v interface{}) bool {
+	switch v.(type) {
+	case *Time, *DateTime, *Date:
+		return true
+	}
+
+	return false
+}
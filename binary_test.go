@@ -0,0 +1,257 @@
+package std
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type gobValues struct {
+	String   String
+	Int      Int
+	Uint     Uint
+	Float    Float
+	Bool     Bool
+	Time     Time
+	DateTime DateTime
+	Date     Date
+}
+
+func TestReadBinaryHeader(t *testing.T) {
+	payload, valid, err := readBinaryHeader([]byte{binaryVersion, 1, 42}, "std.Test")
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, []byte{42}, payload)
+
+	_, valid, err = readBinaryHeader([]byte{binaryVersion, 0}, "std.Test")
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	for _, data := range [][]byte{nil, {binaryVersion}, {2, 1, 42}, {binaryVersion, 0, 42}, {binaryVersion, 2}} {
+		_, _, err = readBinaryHeader(data, "std.Test")
+		assert.ErrorIs(t, err, ErrInvalidBinary)
+	}
+}
+
+func TestIntBinary(t *testing.T) {
+	for _, v := range []Int{IntFrom(12345), IntFrom(-12345), IntFrom(math.MinInt64), {}} {
+		data, err := v.MarshalBinary()
+		assert.NoError(t, err)
+
+		var decoded Int
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, v, decoded)
+	}
+
+	data, err := IntFrom(1).MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{binaryVersion, 1, 2}, data)
+
+	var i Int
+	assert.ErrorIs(t, i.UnmarshalBinary([]byte{binaryVersion, 1}), ErrInvalidBinary)
+	assert.ErrorIs(t, i.UnmarshalBinary([]byte{binaryVersion, 1, 2, 2}), ErrInvalidBinary)
+	assert.ErrorIs(t, i.UnmarshalBinary([]byte{binaryVersion, 1, 0x82, 0}), ErrInvalidBinary)
+}
+
+func TestUintBinary(t *testing.T) {
+	for _, v := range []Uint{UintFrom(12345), UintFrom(math.MaxUint64), {}} {
+		data, err := v.MarshalBinary()
+		assert.NoError(t, err)
+
+		var decoded Uint
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, v, decoded)
+	}
+
+	var i Uint
+	assert.ErrorIs(t, i.UnmarshalBinary([]byte{binaryVersion, 1, 0x80}), ErrInvalidBinary)
+}
+
+func TestFloatBinary(t *testing.T) {
+	for _, v := range []Float{FloatFrom(1.2345), FloatFrom(math.Inf(-1)), {}} {
+		data, err := v.MarshalBinary()
+		assert.NoError(t, err)
+
+		var decoded Float
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, v, decoded)
+	}
+
+	var f Float
+	assert.ErrorIs(t, f.UnmarshalBinary([]byte{binaryVersion, 1, 0}), ErrInvalidBinary)
+}
+
+func TestBoolBinary(t *testing.T) {
+	for _, v := range []Bool{BoolFrom(true), BoolFrom(false), {}} {
+		data, err := v.MarshalBinary()
+		assert.NoError(t, err)
+
+		var decoded Bool
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, v, decoded)
+	}
+
+	var b Bool
+	assert.ErrorIs(t, b.UnmarshalBinary([]byte{binaryVersion, 1, 2}), ErrInvalidBinary)
+}
+
+func TestStringBinary(t *testing.T) {
+	for _, v := range []String{StringFrom("test"), StringFrom(""), {}} {
+		data, err := v.MarshalBinary()
+		assert.NoError(t, err)
+
+		var decoded String
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, v, decoded)
+	}
+
+	var s String
+	assert.ErrorIs(t, s.UnmarshalBinary([]byte{}), ErrInvalidBinary)
+}
+
+func TestTimeBinary(t *testing.T) {
+	for _, v := range []Time{TimeFrom(timeValue), {}} {
+		data, err := v.MarshalBinary()
+		assert.NoError(t, err)
+
+		var decoded Time
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.True(t, v.Equal(decoded))
+	}
+
+	for _, v := range []DateTime{DateTimeFrom(dateTimeValue), {}} {
+		data, err := v.MarshalBinary()
+		assert.NoError(t, err)
+
+		var decoded DateTime
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.True(t, v.Equal(decoded))
+	}
+
+	for _, v := range []Date{DateFrom(dateValue), {}} {
+		data, err := v.MarshalBinary()
+		assert.NoError(t, err)
+
+		var decoded Date
+		assert.NoError(t, decoded.UnmarshalBinary(data))
+		assert.True(t, v.Equal(decoded))
+	}
+
+	var ti Time
+	assert.ErrorIs(t, ti.UnmarshalBinary([]byte{binaryVersion, 1, 42}), ErrInvalidBinary)
+}
+
+func TestGob(t *testing.T) {
+	for _, v := range []gobValues{
+		{
+			String:   StringFrom("test"),
+			Int:      IntFrom(12345),
+			Uint:     UintFrom(12345),
+			Float:    FloatFrom(1.2345),
+			Bool:     BoolFrom(false),
+			Time:     TimeFrom(timeValue),
+			DateTime: DateTimeFrom(dateTimeValue),
+			Date:     DateFrom(dateValue),
+		},
+		{},
+	} {
+		var buf bytes.Buffer
+
+		assert.NoError(t, gob.NewEncoder(&buf).Encode(v))
+
+		var decoded gobValues
+
+		assert.NoError(t, gob.NewDecoder(&buf).Decode(&decoded))
+		assert.Equal(t, v.String, decoded.String)
+		assert.Equal(t, v.Int, decoded.Int)
+		assert.Equal(t, v.Uint, decoded.Uint)
+		assert.Equal(t, v.Float, decoded.Float)
+		assert.Equal(t, v.Bool, decoded.Bool)
+		assert.True(t, v.Time.Equal(decoded.Time))
+		assert.True(t, v.DateTime.Equal(decoded.DateTime))
+		assert.True(t, v.Date.Equal(decoded.Date))
+	}
+}
+
+// textValue is a type encoded by its text form in XML, binary, gob and YAML.
+type textValue interface {
+	encoding.BinaryMarshaler
+	xml.Marshaler
+	xml.MarshalerAttr
+	yamlMarshaler
+	fmt.Stringer
+	IsZero() bool
+}
+
+// testTextEncodings checks that v round trips through XML, binary, gob and YAML,
+// decoding into the pointers returned by newPtr.
+func testTextEncodings(t *testing.T, v textValue, newPtr func() interface{}) {
+	t.Helper()
+
+	decoded := func(p interface{}) interface{} {
+		return reflect.ValueOf(p).Elem().Interface()
+	}
+
+	// The values are compared with their Equal method, times decoded from text lose their location
+	equal := func(p interface{}) bool {
+		eq := reflect.ValueOf(v).MethodByName("Equal")
+
+		return eq.Call([]reflect.Value{reflect.ValueOf(p).Elem()})[0].Bool()
+	}
+
+	data, err := v.MarshalBinary()
+	assert.NoError(t, err)
+
+	p := newPtr()
+	assert.NoError(t, p.(encoding.BinaryUnmarshaler).UnmarshalBinary(data))
+	assert.True(t, equal(p), "binary %v: %v", v, decoded(p))
+
+	var buf bytes.Buffer
+
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(v))
+
+	p = newPtr()
+	assert.NoError(t, gob.NewDecoder(&buf).Decode(p))
+	assert.True(t, equal(p), "gob %v: %v", v, decoded(p))
+
+	var xmlBuf bytes.Buffer
+
+	assert.NoError(t, xml.NewEncoder(&xmlBuf).EncodeElement(v, xml.StartElement{Name: xml.Name{Local: "value"}}))
+
+	data = xmlBuf.Bytes()
+
+	p = newPtr()
+	assert.NoError(t, xml.Unmarshal(data, p))
+	assert.True(t, equal(p), "xml %s: %v", data, decoded(p))
+
+	attr, err := v.MarshalXMLAttr(xml.Name{Local: "value"})
+	assert.NoError(t, err)
+	assert.Equal(t, v.String(), attr.Value)
+
+	if !v.IsZero() {
+		p = newPtr()
+		assert.NoError(t, p.(xml.UnmarshalerAttr).UnmarshalXMLAttr(attr))
+		assert.True(t, equal(p), "xml attribute %v: %v", attr, decoded(p))
+	}
+
+	y, err := v.MarshalYAML()
+	assert.NoError(t, err)
+
+	p = newPtr()
+	assert.NoError(t, p.(yamlDecoder).unmarshalYAML(yamlUnmarshal(y)))
+	assert.True(t, equal(p), "yaml %v: %v", y, decoded(p))
+
+	assert.Error(t, p.(yamlDecoder).unmarshalYAML(yamlUnmarshal(12345)))
+	assert.True(t, decoded(p).(textValue).IsZero())
+
+	// A valid value with an empty payload is not the binary form of any value of these types
+	assert.Error(t, p.(encoding.BinaryUnmarshaler).UnmarshalBinary([]byte{binaryVersion, 1}))
+	assert.True(t, decoded(p).(textValue).IsZero())
+}
@@ -1,11 +1,11 @@
 package std
 
 import (
+	"bytes"
 	"database/sql/driver"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"reflect"
+	"strconv"
 )
 
 // Bool represents a bool that may be null.
@@ -24,9 +24,10 @@ func (b *Bool) Scan(value interface{}) error {
 		return nil
 	}
 
-	b.Valid = true
+	err := scanBool(&b.Data, value)
+	b.Valid = err == nil
 
-	return convertAssign(&b.Data, value)
+	return err
 }
 
 // Value implements the driver Valuer interface.
@@ -61,28 +62,27 @@ func BoolFromPtr(b *bool) Bool {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
-// 0 will not be considered a null Bool.
+// It supports boolean and null input.
 // It also supports unmarshalling a sql.NullBool.
 func (b *Bool) UnmarshalJSON(data []byte) error {
-	var (
-		err error
-		v   interface{}
-	)
-
-	if err = json.Unmarshal(data, &v); err != nil {
+	kind, err := jsonKindOf(data)
+	if err != nil {
 		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Bool: %w", string(data), err)
 	}
 
-	switch x := v.(type) {
-	case bool:
-		b.Data = x
-	case nil:
+	switch kind {
+	case jsonBool:
+		b.Data = bytes.Equal(bytes.TrimSpace(data), []byte("true"))
+	case jsonObject:
+		b.Valid, err = unmarshalNullObject(data, "Bool", "null.Bool", &b.Data)
+
+		return err
+	case jsonNull:
 		b.Valid = false
 
 		return nil
 	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Bool", reflect.TypeOf(v).Name())
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Bool", kind)
 	}
 
 	b.Valid = err == nil
@@ -119,29 +119,32 @@ func (b *Bool) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Bool is null.
 func (b Bool) MarshalJSON() ([]byte, error) {
-	if !b.Valid {
-		return []byte("null"), nil
-	}
+	return b.AppendJSON(make([]byte, 0, 5))
+}
 
-	if !b.Data {
-		return []byte("false"), nil
+// AppendJSON appends the JSON encoding of this Bool to b, null if this Bool is null.
+func (b Bool) AppendJSON(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return append(dst, nullType...), nil
 	}
 
-	return []byte("true"), nil
+	return strconv.AppendBool(dst, b.Data), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Bool is null.
 func (b Bool) MarshalText() ([]byte, error) {
-	if !b.Valid {
-		return []byte{}, nil
-	}
+	return b.AppendText(make([]byte, 0, 5))
+}
 
-	if !b.Data {
-		return []byte("false"), nil
+// AppendText implements encoding.TextAppender.
+// It appends nothing if this Bool is null.
+func (b Bool) AppendText(dst []byte) ([]byte, error) {
+	if !b.Valid {
+		return dst, nil
 	}
 
-	return []byte("true"), nil
+	return strconv.AppendBool(dst, b.Data), nil
 }
 
 // SetValid changes this Bool's value and also sets it to be non-null.
@@ -173,3 +176,119 @@ func (b Bool) String() string {
 
 	return fmt.Sprintf("%v", b.Data)
 }
+
+// Equal reports whether b and o are both null or both valid with the same value.
+func (b Bool) Equal(o Bool) bool {
+	return b.Valid == o.Valid && (!b.Valid || b.Data == o.Data)
+}
+
+// Compare returns -1 if b is less than o, 0 if they are equal and +1 if b is greater than o.
+// False is less than true, null values are ordered according to nulls.
+func (b Bool) Compare(o Bool, nulls NullOrder) int {
+	if c, ok := compareNull(b.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	switch {
+	case b.Data == o.Data:
+		return 0
+	case !b.Data:
+		return -1
+	}
+
+	return 1
+}
+
+// ValueOr returns this Bool's value, or def if this Bool is null.
+func (b Bool) ValueOr(def bool) bool {
+	if !b.Valid {
+		return def
+	}
+
+	return b.Data
+}
+
+// ValueOrZero returns this Bool's value, or the zero value of bool if this Bool is null.
+func (b Bool) ValueOrZero() bool {
+	if !b.Valid {
+		return false
+	}
+
+	return b.Data
+}
+
+// OrElse returns this Bool's value, or the result of fn if this Bool is null.
+// fn is only called when this Bool is null.
+func (b Bool) OrElse(fn func() bool) bool {
+	if !b.Valid {
+		return fn()
+	}
+
+	return b.Data
+}
+
+// Get returns this Bool's value and true, or the zero value of bool and false if this Bool is null.
+func (b Bool) Get() (bool, bool) {
+	return b.ValueOrZero(), b.Valid
+}
+
+// MustGet returns this Bool's value, it panics if this Bool is null.
+func (b Bool) MustGet() bool {
+	if !b.Valid {
+		panic("std: MustGet called on a null Bool")
+	}
+
+	return b.Data
+}
+
+// Map returns a Bool holding the result of fn applied to this Bool's value,
+// or a null Bool if this Bool is null. fn is only called when this Bool is valid.
+func (b Bool) Map(fn func(bool) bool) Bool {
+	if !b.Valid {
+		return Bool{}
+	}
+
+	return NewBool(fn(b.Data), true)
+}
+
+// FlatMap returns the result of fn applied to this Bool's value,
+// or a null Bool if this Bool is null. fn is only called when this Bool is valid.
+func (b Bool) FlatMap(fn func(bool) Bool) Bool {
+	if !b.Valid {
+		return Bool{}
+	}
+
+	return fn(b.Data)
+}
+
+// Filter returns this Bool if it is valid and its value satisfies fn,
+// otherwise it returns a null Bool.
+func (b Bool) Filter(fn func(bool) bool) Bool {
+	if !b.Valid || !fn(b.Data) {
+		return Bool{}
+	}
+
+	return b
+}
+
+// ToInt converts this Bool to an Int, 1 for true and 0 for false.
+func (b Bool) ToInt() Int {
+	if !b.Valid {
+		return Int{}
+	}
+
+	if b.Data {
+		return IntFrom(1)
+	}
+
+	return IntFrom(0)
+}
+
+// ToString converts this Bool to a String holding "true" or "false".
+func (b Bool) ToString() String {
+	if !b.Valid {
+		return String{}
+	}
+
+	return StringFrom(strconv.FormatBool(b.Data))
+}
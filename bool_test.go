@@ -189,3 +189,110 @@ func TestBoolValue(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, v)
 }
+
+func TestBoolEqual(t *testing.T) {
+	assert.True(t, BoolFrom(true).Equal(BoolFrom(true)))
+	assert.True(t, Bool{}.Equal(NewBool(true, false)))
+	assert.False(t, BoolFrom(true).Equal(BoolFrom(false)))
+	assert.False(t, BoolFrom(false).Equal(Bool{}))
+}
+
+func TestBoolCompare(t *testing.T) {
+	assert.Equal(t, -1, BoolFrom(false).Compare(BoolFrom(true), NullsFirst))
+	assert.Equal(t, 1, BoolFrom(true).Compare(BoolFrom(false), NullsFirst))
+	assert.Equal(t, 0, BoolFrom(true).Compare(BoolFrom(true), NullsFirst))
+	assert.Equal(t, -1, Bool{}.Compare(BoolFrom(false), NullsFirst))
+	assert.Equal(t, 1, Bool{}.Compare(BoolFrom(false), NullsLast))
+}
+
+func TestBoolValueOr(t *testing.T) {
+	valid := BoolFrom(true)
+	assert.Equal(t, true, valid.ValueOr(false))
+	assert.Equal(t, true, valid.ValueOrZero())
+
+	null := Bool{}
+	assert.Equal(t, false, null.ValueOr(false))
+	assert.Equal(t, false, null.ValueOrZero())
+}
+
+func TestBoolOrElse(t *testing.T) {
+	called := false
+	fallback := func() bool {
+		called = true
+
+		return false
+	}
+
+	valid := BoolFrom(true)
+	assert.Equal(t, true, valid.OrElse(fallback))
+	assert.False(t, called)
+
+	null := Bool{}
+	assert.Equal(t, false, null.OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestBoolGet(t *testing.T) {
+	v, ok := BoolFrom(true).Get()
+	assert.True(t, ok)
+	assert.Equal(t, true, v)
+
+	v, ok = Bool{}.Get()
+	assert.False(t, ok)
+	assert.Equal(t, false, v)
+}
+
+func TestBoolMustGet(t *testing.T) {
+	assert.Equal(t, true, BoolFrom(true).MustGet())
+
+	assert.Panics(t, func() {
+		Bool{}.MustGet()
+	})
+}
+
+func TestBoolMap(t *testing.T) {
+	mapped := BoolFrom(true).Map(func(v bool) bool { return !v })
+	assert.True(t, mapped.Valid)
+	assert.Equal(t, false, mapped.ValueOrZero())
+
+	called := false
+	null := Bool{}.Map(func(v bool) bool {
+		called = true
+
+		return v
+	})
+	assert.False(t, null.Valid)
+	assert.False(t, called)
+}
+
+func TestBoolFlatMap(t *testing.T) {
+	toNull := func(v bool) Bool {
+		return Bool{}
+	}
+
+	assert.False(t, BoolFrom(true).FlatMap(toNull).Valid)
+	assert.Equal(t, BoolFrom(true), BoolFrom(true).FlatMap(func(v bool) Bool {
+		return BoolFrom(v)
+	}))
+	assert.False(t, Bool{}.FlatMap(func(v bool) Bool {
+		return BoolFrom(true)
+	}).Valid)
+}
+
+func TestBoolFilter(t *testing.T) {
+	assert.Equal(t, BoolFrom(true), BoolFrom(true).Filter(func(v bool) bool { return v }))
+	assert.False(t, BoolFrom(true).Filter(func(v bool) bool { return false }).Valid)
+	assert.False(t, Bool{}.Filter(func(v bool) bool { return true }).Valid)
+}
+
+func TestBoolToInt(t *testing.T) {
+	assert.Equal(t, IntFrom(1), BoolFrom(true).ToInt())
+	assert.Equal(t, IntFrom(0), BoolFrom(false).ToInt())
+	assert.False(t, Bool{}.ToInt().Valid)
+}
+
+func TestBoolToString(t *testing.T) {
+	assert.Equal(t, StringFrom("true"), BoolFrom(true).ToString())
+	assert.Equal(t, StringFrom("false"), BoolFrom(false).ToString())
+	assert.False(t, Bool{}.ToString().Valid)
+}
@@ -0,0 +1,527 @@
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"strconv"
+)
+
+// Bools is a nullable []bool, mapping to a Postgres bool[] column.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+// The elements can not be NULL, use NullBools for arrays with NULL elements.
+type Bools struct {
+	Data  []bool
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewBools creates a new Bools.
+func NewBools(data []bool, valid bool) Bools {
+	return Bools{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// BoolsFrom creates a new Bools that will always be valid.
+func BoolsFrom(data []bool) Bools {
+	return NewBools(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal, a NULL element is an error.
+func (b *Bools) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "Bools")
+	if err == nil && valid {
+		err = b.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		b.Data, b.Dims, b.Valid = nil, nil, false
+
+		return err
+	}
+
+	b.Dims, b.Valid = dims, true
+
+	return nil
+}
+
+func (b *Bools) fromArray(elems []arrayElement) error {
+	var err error
+
+	data := make([]bool, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			return errArrayNull("Bools")
+		}
+
+		if data[k], err = strconv.ParseBool(e.text); err != nil {
+			return errArrayElement(e.text, "Bools", err)
+		}
+	}
+
+	b.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this Bools is null.
+func (b Bools) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, b.Dims, len(b.Data), "Bools", func(buf []byte, k int) []byte {
+		return appendArrayBool(buf, b.Data[k])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input.
+func (b *Bools) UnmarshalJSON(data []byte) error {
+	var d []bool
+
+	dims, valid, err := unmarshalJSONArray(data, "Bools", &d)
+	if err != nil || !valid {
+		b.Data, b.Dims, b.Valid = nil, nil, false
+
+		return err
+	}
+
+	b.Data, b.Dims, b.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Bools is null, and an empty array if it is valid without elements.
+func (b Bools) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(b.Data, len(b.Data), b.Dims, "Bools")
+}
+
+// SetValid changes this Bools's value and also sets it to be non-null.
+func (b *Bools) SetValid(v []bool) {
+	b.Data = v
+	b.Dims = nil
+	b.Valid = true
+}
+
+// IsZero returns true for null Bools, for potential future omitempty support.
+func (b Bools) IsZero() bool {
+	return !b.Valid
+}
+
+// Equal reports whether b and o are both null or both valid with the same elements and dimensions.
+func (b Bools) Equal(o Bools) bool {
+	return b.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if b is less than o, 0 if they are equal and +1 if b is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+func (b Bools) Compare(o Bools, nulls NullOrder) int {
+	if c, ok := compareNull(b.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(b.Data), len(o.Data), b.Dims, o.Dims, func(k int) int {
+		return BoolFrom(b.Data[k]).Compare(BoolFrom(o.Data[k]), nulls)
+	})
+}
+
+// ValueOr returns this Bools's elements, or def if this Bools is null.
+func (b Bools) ValueOr(def []bool) []bool {
+	if !b.Valid {
+		return def
+	}
+
+	return b.Data
+}
+
+// ValueOrZero returns this Bools's elements, or nil if this Bools is null.
+func (b Bools) ValueOrZero() []bool {
+	if !b.Valid {
+		return nil
+	}
+
+	return b.Data
+}
+
+// OrElse returns this Bools's elements, or the result of fn if this Bools is null.
+// fn is only called when this Bools is null.
+func (b Bools) OrElse(fn func() []bool) []bool {
+	if !b.Valid {
+		return fn()
+	}
+
+	return b.Data
+}
+
+// Get returns this Bools's elements and true, or nil and false if this Bools is null.
+func (b Bools) Get() ([]bool, bool) {
+	return b.ValueOrZero(), b.Valid
+}
+
+// MustGet returns this Bools's elements, it panics if this Bools is null.
+func (b Bools) MustGet() []bool {
+	if !b.Valid {
+		panic("std: MustGet called on a null Bools")
+	}
+
+	return b.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this Bools is null.
+func (b Bools) MarshalText() ([]byte, error) {
+	return marshalArrayText(b)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null Bools.
+func (b *Bools) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, b)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this Bools is null.
+func (b Bools) String() string {
+	text, _ := b.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Bools is null, the array literal otherwise.
+func (b Bools) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, b.Valid, b.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Bools, an empty element is a null Bools.
+func (b *Bools) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Bools is null.
+func (b Bools) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b.Valid, b.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *Bools) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b Bools) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(b.Valid, b.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *Bools) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.Bools", b)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b Bools) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *Bools) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Bools is null, a sequence otherwise.
+func (b Bools) MarshalYAML() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(b.Data, len(b.Data), b.Dims, "Bools")
+}
+
+// unmarshalYAML decodes this Bools with the unmarshal func of a YAML library.
+func (b *Bools) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.Bools", b)
+}
+
+// NullBools is a nullable []Bool, mapping to a Postgres bool[] column with NULL elements.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+type NullBools struct {
+	Data  []Bool
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewNullBools creates a new NullBools.
+func NewNullBools(data []Bool, valid bool) NullBools {
+	return NullBools{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullBoolsFrom creates a new NullBools that will always be valid.
+func NullBoolsFrom(data []Bool) NullBools {
+	return NewNullBools(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal.
+func (b *NullBools) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "NullBools")
+	if err == nil && valid {
+		err = b.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		b.Data, b.Dims, b.Valid = nil, nil, false
+
+		return err
+	}
+
+	b.Dims, b.Valid = dims, true
+
+	return nil
+}
+
+func (b *NullBools) fromArray(elems []arrayElement) error {
+	var err error
+
+	data := make([]Bool, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			continue
+		}
+
+		data[k].Valid = true
+
+		if data[k].Data, err = strconv.ParseBool(e.text); err != nil {
+			return errArrayElement(e.text, "NullBools", err)
+		}
+	}
+
+	b.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this NullBools is null.
+func (b NullBools) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, b.Dims, len(b.Data), "NullBools", func(buf []byte, k int) []byte {
+		if !b.Data[k].Valid {
+			return append(buf, "NULL"...)
+		}
+
+		return appendArrayBool(buf, b.Data[k].Data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input, the elements can be null.
+func (b *NullBools) UnmarshalJSON(data []byte) error {
+	var d []Bool
+
+	dims, valid, err := unmarshalJSONArray(data, "NullBools", &d)
+	if err != nil || !valid {
+		b.Data, b.Dims, b.Valid = nil, nil, false
+
+		return err
+	}
+
+	b.Data, b.Dims, b.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullBools is null, and an empty array if it is valid without elements.
+func (b NullBools) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(b.Data, len(b.Data), b.Dims, "NullBools")
+}
+
+// SetValid changes this NullBools's value and also sets it to be non-null.
+func (b *NullBools) SetValid(v []Bool) {
+	b.Data = v
+	b.Dims = nil
+	b.Valid = true
+}
+
+// IsZero returns true for null NullBools, for potential future omitempty support.
+func (b NullBools) IsZero() bool {
+	return !b.Valid
+}
+
+// Equal reports whether b and o are both null or both valid with the same elements and dimensions.
+func (b NullBools) Equal(o NullBools) bool {
+	return b.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if b is less than o, 0 if they are equal and +1 if b is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+// Null elements are ordered according to nulls too.
+func (b NullBools) Compare(o NullBools, nulls NullOrder) int {
+	if c, ok := compareNull(b.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(b.Data), len(o.Data), b.Dims, o.Dims, func(k int) int {
+		return b.Data[k].Compare(o.Data[k], nulls)
+	})
+}
+
+// ValueOr returns this NullBools's elements, or def if this NullBools is null.
+func (b NullBools) ValueOr(def []Bool) []Bool {
+	if !b.Valid {
+		return def
+	}
+
+	return b.Data
+}
+
+// ValueOrZero returns this NullBools's elements, or nil if this NullBools is null.
+func (b NullBools) ValueOrZero() []Bool {
+	if !b.Valid {
+		return nil
+	}
+
+	return b.Data
+}
+
+// OrElse returns this NullBools's elements, or the result of fn if this NullBools is null.
+// fn is only called when this NullBools is null.
+func (b NullBools) OrElse(fn func() []Bool) []Bool {
+	if !b.Valid {
+		return fn()
+	}
+
+	return b.Data
+}
+
+// Get returns this NullBools's elements and true, or nil and false if this NullBools is null.
+func (b NullBools) Get() ([]Bool, bool) {
+	return b.ValueOrZero(), b.Valid
+}
+
+// MustGet returns this NullBools's elements, it panics if this NullBools is null.
+func (b NullBools) MustGet() []Bool {
+	if !b.Valid {
+		panic("std: MustGet called on a null NullBools")
+	}
+
+	return b.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this NullBools is null.
+func (b NullBools) MarshalText() ([]byte, error) {
+	return marshalArrayText(b)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null NullBools.
+func (b *NullBools) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, b)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this NullBools is null.
+func (b NullBools) String() string {
+	text, _ := b.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this NullBools is null, the array literal otherwise.
+func (b NullBools) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, b.Valid, b.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null NullBools, an empty element is a null NullBools.
+func (b *NullBools) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, b)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this NullBools is null.
+func (b NullBools) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b.Valid, b.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *NullBools) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (b NullBools) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(b.Valid, b.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (b *NullBools) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.NullBools", b)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (b NullBools) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (b *NullBools) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this NullBools is null, a sequence otherwise.
+func (b NullBools) MarshalYAML() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(b.Data, len(b.Data), b.Dims, "NullBools")
+}
+
+// unmarshalYAML decodes this NullBools with the unmarshal func of a YAML library.
+func (b *NullBools) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.NullBools", b)
+}
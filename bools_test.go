@@ -0,0 +1,64 @@
+package std
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBools(t *testing.T) {
+	var b Bools
+
+	assert.NoError(t, b.Scan([]byte(`{t,f,true}`)))
+	assert.Equal(t, BoolsFrom([]bool{true, false, true}), b)
+
+	v, err := b.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{t,f,t}`, v)
+
+	assert.Error(t, b.Scan(`{x}`))
+	assert.False(t, b.Valid)
+
+	assert.NoError(t, json.Unmarshal([]byte(`[true,false]`), &b))
+
+	data, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.Equal(t, `[true,false]`, string(data))
+}
+
+func TestNullBools(t *testing.T) {
+	var b NullBools
+
+	assert.NoError(t, b.Scan(`{t,NULL}`))
+	assert.Equal(t, []Bool{BoolFrom(true), {}}, b.Data)
+
+	v, err := b.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{t,NULL}`, v)
+
+	data, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.Equal(t, `[true,null]`, string(data))
+}
+
+func TestBoolsAccessors(t *testing.T) {
+	a := BoolsFrom([]bool{false, true})
+
+	assert.Equal(t, -1, a.Compare(BoolsFrom([]bool{true}), NullsFirst))
+	assert.True(t, a.Equal(BoolsFrom([]bool{false, true})))
+	assert.Equal(t, `{f,t}`, a.String())
+
+	n := NullBoolsFrom([]Bool{{}, BoolFrom(true)})
+	assert.Equal(t, -1, n.Compare(NullBoolsFrom([]Bool{BoolFrom(false)}), NullsFirst))
+}
+
+func TestBoolsEncodings(t *testing.T) {
+	for _, v := range []Bools{BoolsFrom([]bool{true, false}), {}} {
+		testTextEncodings(t, v, func() interface{} { return &Bools{} })
+	}
+
+	for _, v := range []NullBools{NullBoolsFrom([]Bool{BoolFrom(true), {}}), {}} {
+		testTextEncodings(t, v, func() interface{} { return &NullBools{} })
+	}
+}
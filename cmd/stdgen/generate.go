@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// Config describes the nullable type to generate.
+type Config struct {
+	// Type is the name of the nullable type.
+	Type string
+	// Data is the underlying type.
+	Data string
+	// Import is the import path of the package declaring Data, if any.
+	Import string
+	// Strategy is the encoding strategy: numeric, string or text.
+	Strategy string
+	// Kind is the numeric kind of Data: int, uint or float.
+	Kind string
+	// Package is the package name of the generated files.
+	Package string
+	// Sample is a valid value used by the tests.
+	Sample string
+	// Output is the output file.
+	Output string
+	// Args are the command line arguments, reported in the generated files.
+	Args []string
+}
+
+const (
+	strategyNumeric = "numeric"
+	strategyString  = "string"
+	strategyText    = "text"
+
+	kindInt   = "int"
+	kindUint  = "uint"
+	kindFloat = "float"
+)
+
+var builtinKinds = map[string]string{
+	"int": kindInt, "int8": kindInt, "int16": kindInt, "int32": kindInt, "int64": kindInt,
+	"uint": kindUint, "uint8": kindUint, "uint16": kindUint, "uint32": kindUint, "uint64": kindUint,
+	"byte": kindUint, "rune": kindInt,
+	"float32": kindFloat, "float64": kindFloat,
+}
+
+// builtinBits are the sizes of the builtin numeric types.
+var builtinBits = map[string]int{
+	"int8": 8, "int16": 16, "int32": 32, "int64": 64,
+	"uint8": 8, "uint16": 16, "uint32": 32, "uint64": 64,
+	"byte": 8, "rune": 32,
+	"float32": 32, "float64": 64,
+}
+
+// typeImports are the standard packages the type template may use.
+var typeImports = []string{
+	"database/sql",
+	"database/sql/driver",
+	"encoding/json",
+	"errors",
+	"fmt",
+	"math",
+	"strconv",
+}
+
+// testImports are the standard packages the test template may use.
+var testImports = []string{
+	"encoding/json",
+	"testing",
+}
+
+// templateData is the data of the templates.
+type templateData struct {
+	Config
+	// Receiver is the receiver name of the methods.
+	Receiver string
+	// Bits is the size of the underlying numeric type, 0 if unknown.
+	Bits int
+	// Imports are the standard packages used by the generated file.
+	Imports []string
+}
+
+// Generate returns the source of the nullable type described by cfg, and of its tests.
+func Generate(cfg Config) ([]byte, []byte, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	data := templateData{
+		Config:   cfg,
+		Receiver: string(unicode.ToLower([]rune(cfg.Type)[0])),
+		Bits:     builtinBits[cfg.Data],
+	}
+
+	code, err := execute(typeTemplate, data, typeImports)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating %s: %w", cfg.Type, err)
+	}
+
+	test, err := execute(testTemplate, data, testImports)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generating %s tests: %w", cfg.Type, err)
+	}
+
+	return code, test, nil
+}
+
+func (cfg *Config) validate() error {
+	switch {
+	case cfg.Type == "":
+		return errors.New("-type is required")
+	case !token.IsIdentifier(cfg.Type) || !token.IsExported(cfg.Type):
+		return fmt.Errorf("-type %q is not an exported identifier", cfg.Type)
+	case cfg.Data == "":
+		return errors.New("-data is required")
+	case cfg.Package == "":
+		return errors.New("-package is required outside of go:generate")
+	}
+
+	if _, err := parser.ParseExpr(cfg.Data); err != nil {
+		return fmt.Errorf("-data %q is not a type: %w", cfg.Data, err)
+	}
+
+	switch cfg.Strategy {
+	case strategyNumeric:
+		if cfg.Kind == "" {
+			cfg.Kind = builtinKinds[cfg.Data]
+		}
+
+		switch cfg.Kind {
+		case kindInt, kindUint, kindFloat:
+		case "":
+			return fmt.Errorf("-kind is required for the non builtin type %s", cfg.Data)
+		default:
+			return fmt.Errorf("unknown -kind %q, want int, uint or float", cfg.Kind)
+		}
+
+		if cfg.Sample == "" {
+			cfg.Sample = cfg.Data + "(42)"
+		}
+	case strategyString:
+		if cfg.Sample == "" {
+			cfg.Sample = cfg.Data + `("test")`
+		}
+	case strategyText:
+		if cfg.Sample == "" {
+			return errors.New("-sample is required for the text strategy")
+		}
+	case "":
+		return errors.New("-strategy is required")
+	default:
+		return fmt.Errorf("unknown -strategy %q, want numeric, string or text", cfg.Strategy)
+	}
+
+	return nil
+}
+
+var funcs = template.FuncMap{
+	"quote": strconv.Quote,
+	"args": func(args []string) string {
+		return strings.Join(args, " ")
+	},
+}
+
+var (
+	typeTemplate = template.Must(template.New("type").Funcs(funcs).Parse(typeSource))
+	testTemplate = template.Must(template.New("test").Funcs(funcs).Parse(testSource))
+)
+
+// execute runs tpl and returns the formatted source.
+// The template is run a first time with all the imports, to find the ones it uses.
+func execute(tpl *template.Template, data templateData, imports []string) ([]byte, error) {
+	data.Imports = imports
+
+	src, err := executeTemplate(tpl, data)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	used := usedPackages(file)
+
+	data.Imports = nil
+
+	for _, imp := range imports {
+		if used[path.Base(imp)] {
+			data.Imports = append(data.Imports, imp)
+		}
+	}
+
+	if src, err = executeTemplate(tpl, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(src)
+}
+
+func executeTemplate(tpl *template.Template, data templateData) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := tpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// usedPackages returns the names of the packages whose members are used by file.
+func usedPackages(file *ast.File) map[string]bool {
+	used := make(map[string]bool)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+
+		return true
+	})
+
+	return used
+}
+
+// snakeCase converts an identifier to snake case: NullHTTPStatus becomes null_http_status.
+func snakeCase(s string) string {
+	var b strings.Builder
+
+	runes := []rune(s)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,54 @@
+// Package example holds nullable types generated by stdgen, for each encoding strategy.
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate go run github.com/euskadi31/go-std/cmd/stdgen -type NullStatus -data Status -strategy numeric -kind int
+//go:generate go run github.com/euskadi31/go-std/cmd/stdgen -type NullPort -data uint16 -strategy numeric -sample 8080
+//go:generate go run github.com/euskadi31/go-std/cmd/stdgen -type NullRatio -data float32 -strategy numeric -sample 0.5
+//go:generate go run github.com/euskadi31/go-std/cmd/stdgen -type NullCode -data Code -strategy string
+//go:generate go run github.com/euskadi31/go-std/cmd/stdgen -type NullLevel -data Level -strategy text -sample warning
+
+// Status is an integer status.
+type Status int
+
+// Code is a string code.
+type Code string
+
+// Level is a log level, encoded by its name.
+type Level int
+
+// The log levels.
+const (
+	Debug Level = iota
+	Info
+	Warning
+	Error
+)
+
+var levels = []string{"debug", "info", "warning", "error"}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l Level) MarshalText() ([]byte, error) {
+	if l < 0 || int(l) >= len(levels) {
+		return nil, fmt.Errorf("example: invalid level %d", int(l))
+	}
+
+	return []byte(levels[l]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *Level) UnmarshalText(text []byte) error {
+	for i, name := range levels {
+		if strings.EqualFold(name, string(text)) {
+			*l = Level(i)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("example: unknown level %q", text)
+}
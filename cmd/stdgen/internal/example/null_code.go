@@ -0,0 +1,163 @@
+// Code generated by "stdgen -type NullCode -data Code -strategy string"; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// NullCode is a nullable Code.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type NullCode struct {
+	Data  Code
+	Valid bool // Valid is true if Data is not NULL
+}
+
+// NewNullCode creates a new NullCode.
+func NewNullCode(data Code, valid bool) NullCode {
+	return NullCode{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullCodeFrom creates a new NullCode that will always be valid.
+func NullCodeFrom(data Code) NullCode {
+	return NewNullCode(data, true)
+}
+
+// NullCodeFromPtr creates a new NullCode that will be null if data is nil.
+func NullCodeFromPtr(data *Code) NullCode {
+	if data == nil {
+		var zero Code
+
+		return NewNullCode(zero, false)
+	}
+
+	return NewNullCode(*data, true)
+}
+
+// parseNullCode parses the text form of a Code.
+func parseNullCode(str string) (Code, error) {
+	return Code(str), nil
+}
+
+// Scan implements the Scanner interface.
+func (n *NullCode) Scan(value interface{}) error {
+	if value == nil {
+		var zero Code
+
+		n.Data, n.Valid = zero, false
+
+		return nil
+	}
+
+	// sql.NullString converts any driver.Value to its text form, as database/sql does.
+	var str sql.NullString
+
+	err := str.Scan(value)
+	if err == nil {
+		var parsed Code
+
+		if parsed, err = parseNullCode(str.String); err == nil {
+			n.Data = parsed
+		} else {
+			err = fmt.Errorf("converting driver.Value type %T (%q) to a Code: %w", value, str.String, err)
+		}
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (n NullCode) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return string(n.Data), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (n *NullCode) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Valid = false
+
+		return nil
+	}
+
+	var parsed Code
+
+	err := json.Unmarshal(data, &parsed)
+	if err == nil {
+		n.Data = parsed
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullCode is null.
+func (n NullCode) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(string(n.Data))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullCode is null.
+func (n NullCode) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return []byte(n.Data), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullCode if the input is blank.
+func (n *NullCode) UnmarshalText(text []byte) error {
+	n.Data = Code(text)
+	n.Valid = len(text) != 0
+
+	return nil
+}
+
+// SetValid changes this NullCode's value and also sets it to be non-null.
+func (n *NullCode) SetValid(data Code) {
+	n.Data = data
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullCode's value, or a nil pointer if this NullCode is null.
+func (n NullCode) Ptr() *Code {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.Data
+}
+
+// IsZero returns true for null NullCodes, for potential future omitempty support.
+// A non-null NullCode with a zero value will not be considered zero.
+func (n NullCode) IsZero() bool {
+	return !n.Valid
+}
+
+// String implements fmt.Stringer interface.
+func (n NullCode) String() string {
+	text, _ := n.MarshalText()
+
+	return string(text)
+}
@@ -0,0 +1,109 @@
+// Code generated by "stdgen -type NullCode -data Code -strategy string"; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleNullCode(t *testing.T) Code {
+	t.Helper()
+
+	return Code("test")
+}
+
+func TestNullCodeSuite(t *testing.T) {
+	stdtest.RunNullableSuite(t, stdtest.Factory{
+		New:   func() stdtest.Nullable { return &NullCode{} },
+		Value: sampleNullCode(t),
+	})
+}
+
+func TestNullCodeFrom(t *testing.T) {
+	sample := sampleNullCode(t)
+
+	assert.Equal(t, NullCode{Data: sample, Valid: true}, NullCodeFrom(sample))
+	assert.Equal(t, NullCode{Data: sample, Valid: true}, NullCodeFromPtr(&sample))
+	assert.False(t, NullCodeFromPtr(nil).Valid)
+	assert.False(t, NewNullCode(sample, false).Valid)
+}
+
+func TestNullCodeJSON(t *testing.T) {
+	v := NullCodeFrom(sampleNullCode(t))
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	var decoded NullCode
+
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, v, decoded)
+
+	data, err = json.Marshal(NullCode{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), &decoded))
+	assert.False(t, decoded.Valid)
+
+	assert.Error(t, json.Unmarshal([]byte("{}"), &decoded))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullCodeText(t *testing.T) {
+	v := NullCodeFrom(sampleNullCode(t))
+
+	text, err := v.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, string(text), v.String())
+
+	var decoded NullCode
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, v, decoded)
+
+	text, err = NullCode{}.MarshalText()
+	assert.NoError(t, err)
+	assert.Empty(t, text)
+	assert.Empty(t, NullCode{}.String())
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullCodeScanValue(t *testing.T) {
+	v := NullCodeFrom(sampleNullCode(t))
+
+	value, err := v.Value()
+	assert.NoError(t, err)
+
+	var scanned NullCode
+
+	assert.NoError(t, scanned.Scan(value))
+	assert.Equal(t, v, scanned)
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.False(t, scanned.Valid)
+
+	value, err = NullCode{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestNullCodePtr(t *testing.T) {
+	sample := sampleNullCode(t)
+
+	assert.Equal(t, &sample, NullCodeFrom(sample).Ptr())
+	assert.Nil(t, NullCode{}.Ptr())
+	assert.False(t, NullCodeFrom(sample).IsZero())
+	assert.True(t, NullCode{}.IsZero())
+
+	var v NullCode
+
+	v.SetValid(sample)
+	assert.Equal(t, NullCodeFrom(sample), v)
+}
@@ -0,0 +1,191 @@
+// Code generated by "stdgen -type NullLevel -data Level -strategy text -sample warning"; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// NullLevel is a nullable Level.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type NullLevel struct {
+	Data  Level
+	Valid bool // Valid is true if Data is not NULL
+}
+
+// NewNullLevel creates a new NullLevel.
+func NewNullLevel(data Level, valid bool) NullLevel {
+	return NullLevel{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullLevelFrom creates a new NullLevel that will always be valid.
+func NullLevelFrom(data Level) NullLevel {
+	return NewNullLevel(data, true)
+}
+
+// NullLevelFromPtr creates a new NullLevel that will be null if data is nil.
+func NullLevelFromPtr(data *Level) NullLevel {
+	if data == nil {
+		var zero Level
+
+		return NewNullLevel(zero, false)
+	}
+
+	return NewNullLevel(*data, true)
+}
+
+// parseNullLevel parses the text form of a Level.
+func parseNullLevel(str string) (Level, error) {
+	var parsed Level
+
+	err := parsed.UnmarshalText([]byte(str))
+
+	return parsed, err
+}
+
+// Scan implements the Scanner interface.
+func (n *NullLevel) Scan(value interface{}) error {
+	if value == nil {
+		var zero Level
+
+		n.Data, n.Valid = zero, false
+
+		return nil
+	}
+
+	// sql.NullString converts any driver.Value to its text form, as database/sql does.
+	var str sql.NullString
+
+	err := str.Scan(value)
+	if err == nil {
+		var parsed Level
+
+		if parsed, err = parseNullLevel(str.String); err == nil {
+			n.Data = parsed
+		} else {
+			err = fmt.Errorf("converting driver.Value type %T (%q) to a Level: %w", value, str.String, err)
+		}
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (n NullLevel) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	text, err := n.Data.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (n *NullLevel) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Valid = false
+
+		return nil
+	}
+
+	var str string
+
+	err := json.Unmarshal(data, &str)
+	if err == nil {
+		var parsed Level
+
+		if parsed, err = parseNullLevel(str); err == nil {
+			n.Data = parsed
+		}
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullLevel is null.
+func (n NullLevel) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	text, err := n.Data.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullLevel is null.
+func (n NullLevel) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return n.Data.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullLevel if the input is blank or "null".
+func (n *NullLevel) UnmarshalText(text []byte) error {
+	if len(text) == 0 || string(text) == "null" {
+		n.Valid = false
+
+		return nil
+	}
+
+	parsed, err := parseNullLevel(string(text))
+	if err == nil {
+		n.Data = parsed
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// SetValid changes this NullLevel's value and also sets it to be non-null.
+func (n *NullLevel) SetValid(data Level) {
+	n.Data = data
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullLevel's value, or a nil pointer if this NullLevel is null.
+func (n NullLevel) Ptr() *Level {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.Data
+}
+
+// IsZero returns true for null NullLevels, for potential future omitempty support.
+// A non-null NullLevel with a zero value will not be considered zero.
+func (n NullLevel) IsZero() bool {
+	return !n.Valid
+}
+
+// String implements fmt.Stringer interface.
+func (n NullLevel) String() string {
+	text, _ := n.MarshalText()
+
+	return string(text)
+}
@@ -0,0 +1,118 @@
+// Code generated by "stdgen -type NullLevel -data Level -strategy text -sample warning"; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleNullLevel(t *testing.T) Level {
+	t.Helper()
+
+	var sample Level
+
+	if err := sample.UnmarshalText([]byte("warning")); err != nil {
+		t.Fatal(err)
+	}
+
+	return sample
+}
+
+func TestNullLevelSuite(t *testing.T) {
+	stdtest.RunNullableSuite(t, stdtest.Factory{
+		New:   func() stdtest.Nullable { return &NullLevel{} },
+		Value: sampleNullLevel(t),
+		Scan: []stdtest.ScanCase{
+			{Src: "warning", Want: "warning"},
+		},
+	})
+}
+
+func TestNullLevelFrom(t *testing.T) {
+	sample := sampleNullLevel(t)
+
+	assert.Equal(t, NullLevel{Data: sample, Valid: true}, NullLevelFrom(sample))
+	assert.Equal(t, NullLevel{Data: sample, Valid: true}, NullLevelFromPtr(&sample))
+	assert.False(t, NullLevelFromPtr(nil).Valid)
+	assert.False(t, NewNullLevel(sample, false).Valid)
+}
+
+func TestNullLevelJSON(t *testing.T) {
+	v := NullLevelFrom(sampleNullLevel(t))
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	var decoded NullLevel
+
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, v, decoded)
+
+	data, err = json.Marshal(NullLevel{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), &decoded))
+	assert.False(t, decoded.Valid)
+
+	assert.Error(t, json.Unmarshal([]byte("{}"), &decoded))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullLevelText(t *testing.T) {
+	v := NullLevelFrom(sampleNullLevel(t))
+
+	text, err := v.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, string(text), v.String())
+
+	var decoded NullLevel
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, v, decoded)
+
+	text, err = NullLevel{}.MarshalText()
+	assert.NoError(t, err)
+	assert.Empty(t, text)
+	assert.Empty(t, NullLevel{}.String())
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullLevelScanValue(t *testing.T) {
+	v := NullLevelFrom(sampleNullLevel(t))
+
+	value, err := v.Value()
+	assert.NoError(t, err)
+
+	var scanned NullLevel
+
+	assert.NoError(t, scanned.Scan(value))
+	assert.Equal(t, v, scanned)
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.False(t, scanned.Valid)
+
+	value, err = NullLevel{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestNullLevelPtr(t *testing.T) {
+	sample := sampleNullLevel(t)
+
+	assert.Equal(t, &sample, NullLevelFrom(sample).Ptr())
+	assert.Nil(t, NullLevel{}.Ptr())
+	assert.False(t, NullLevelFrom(sample).IsZero())
+	assert.True(t, NullLevel{}.IsZero())
+
+	var v NullLevel
+
+	v.SetValid(sample)
+	assert.Equal(t, NullLevelFrom(sample), v)
+}
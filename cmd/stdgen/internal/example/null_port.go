@@ -0,0 +1,179 @@
+// Code generated by "stdgen -type NullPort -data uint16 -strategy numeric -sample 8080"; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// NullPort is a nullable uint16.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type NullPort struct {
+	Data  uint16
+	Valid bool // Valid is true if Data is not NULL
+}
+
+// NewNullPort creates a new NullPort.
+func NewNullPort(data uint16, valid bool) NullPort {
+	return NullPort{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullPortFrom creates a new NullPort that will always be valid.
+func NullPortFrom(data uint16) NullPort {
+	return NewNullPort(data, true)
+}
+
+// NullPortFromPtr creates a new NullPort that will be null if data is nil.
+func NullPortFromPtr(data *uint16) NullPort {
+	if data == nil {
+		var zero uint16
+
+		return NewNullPort(zero, false)
+	}
+
+	return NewNullPort(*data, true)
+}
+
+// parseNullPort parses the text form of a uint16.
+func parseNullPort(str string) (uint16, error) {
+	parsed, err := strconv.ParseUint(str, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint16(parsed), nil
+}
+
+// Scan implements the Scanner interface.
+func (n *NullPort) Scan(value interface{}) error {
+	if value == nil {
+		var zero uint16
+
+		n.Data, n.Valid = zero, false
+
+		return nil
+	}
+
+	// sql.NullString converts any driver.Value to its text form, as database/sql does.
+	var str sql.NullString
+
+	err := str.Scan(value)
+	if err == nil {
+		var parsed uint16
+
+		if parsed, err = parseNullPort(str.String); err == nil {
+			n.Data = parsed
+		} else {
+			err = fmt.Errorf("converting driver.Value type %T (%q) to a uint16: %w", value, str.String, err)
+		}
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (n NullPort) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return int64(n.Data), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+func (n *NullPort) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Valid = false
+
+		return nil
+	}
+
+	var parsed uint16
+
+	err := json.Unmarshal(data, &parsed)
+	if err == nil {
+		n.Data = parsed
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullPort is null.
+func (n NullPort) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return strconv.AppendUint(nil, uint64(n.Data), 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullPort is null.
+func (n NullPort) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return strconv.AppendUint(nil, uint64(n.Data), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullPort if the input is blank or "null".
+func (n *NullPort) UnmarshalText(text []byte) error {
+	if len(text) == 0 || string(text) == "null" {
+		n.Valid = false
+
+		return nil
+	}
+
+	parsed, err := parseNullPort(string(text))
+	if err == nil {
+		n.Data = parsed
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// SetValid changes this NullPort's value and also sets it to be non-null.
+func (n *NullPort) SetValid(data uint16) {
+	n.Data = data
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullPort's value, or a nil pointer if this NullPort is null.
+func (n NullPort) Ptr() *uint16 {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.Data
+}
+
+// IsZero returns true for null NullPorts, for potential future omitempty support.
+// A non-null NullPort with a zero value will not be considered zero.
+func (n NullPort) IsZero() bool {
+	return !n.Valid
+}
+
+// String implements fmt.Stringer interface.
+func (n NullPort) String() string {
+	text, _ := n.MarshalText()
+
+	return string(text)
+}
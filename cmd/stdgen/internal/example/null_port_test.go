@@ -0,0 +1,109 @@
+// Code generated by "stdgen -type NullPort -data uint16 -strategy numeric -sample 8080"; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleNullPort(t *testing.T) uint16 {
+	t.Helper()
+
+	return 8080
+}
+
+func TestNullPortSuite(t *testing.T) {
+	stdtest.RunNullableSuite(t, stdtest.Factory{
+		New:   func() stdtest.Nullable { return &NullPort{} },
+		Value: sampleNullPort(t),
+	})
+}
+
+func TestNullPortFrom(t *testing.T) {
+	sample := sampleNullPort(t)
+
+	assert.Equal(t, NullPort{Data: sample, Valid: true}, NullPortFrom(sample))
+	assert.Equal(t, NullPort{Data: sample, Valid: true}, NullPortFromPtr(&sample))
+	assert.False(t, NullPortFromPtr(nil).Valid)
+	assert.False(t, NewNullPort(sample, false).Valid)
+}
+
+func TestNullPortJSON(t *testing.T) {
+	v := NullPortFrom(sampleNullPort(t))
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	var decoded NullPort
+
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, v, decoded)
+
+	data, err = json.Marshal(NullPort{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), &decoded))
+	assert.False(t, decoded.Valid)
+
+	assert.Error(t, json.Unmarshal([]byte("{}"), &decoded))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullPortText(t *testing.T) {
+	v := NullPortFrom(sampleNullPort(t))
+
+	text, err := v.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, string(text), v.String())
+
+	var decoded NullPort
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, v, decoded)
+
+	text, err = NullPort{}.MarshalText()
+	assert.NoError(t, err)
+	assert.Empty(t, text)
+	assert.Empty(t, NullPort{}.String())
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullPortScanValue(t *testing.T) {
+	v := NullPortFrom(sampleNullPort(t))
+
+	value, err := v.Value()
+	assert.NoError(t, err)
+
+	var scanned NullPort
+
+	assert.NoError(t, scanned.Scan(value))
+	assert.Equal(t, v, scanned)
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.False(t, scanned.Valid)
+
+	value, err = NullPort{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestNullPortPtr(t *testing.T) {
+	sample := sampleNullPort(t)
+
+	assert.Equal(t, &sample, NullPortFrom(sample).Ptr())
+	assert.Nil(t, NullPort{}.Ptr())
+	assert.False(t, NullPortFrom(sample).IsZero())
+	assert.True(t, NullPort{}.IsZero())
+
+	var v NullPort
+
+	v.SetValid(sample)
+	assert.Equal(t, NullPortFrom(sample), v)
+}
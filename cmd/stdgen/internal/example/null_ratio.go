@@ -0,0 +1,179 @@
+// Code generated by "stdgen -type NullRatio -data float32 -strategy numeric -sample 0.5"; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// NullRatio is a nullable float32.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type NullRatio struct {
+	Data  float32
+	Valid bool // Valid is true if Data is not NULL
+}
+
+// NewNullRatio creates a new NullRatio.
+func NewNullRatio(data float32, valid bool) NullRatio {
+	return NullRatio{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullRatioFrom creates a new NullRatio that will always be valid.
+func NullRatioFrom(data float32) NullRatio {
+	return NewNullRatio(data, true)
+}
+
+// NullRatioFromPtr creates a new NullRatio that will be null if data is nil.
+func NullRatioFromPtr(data *float32) NullRatio {
+	if data == nil {
+		var zero float32
+
+		return NewNullRatio(zero, false)
+	}
+
+	return NewNullRatio(*data, true)
+}
+
+// parseNullRatio parses the text form of a float32.
+func parseNullRatio(str string) (float32, error) {
+	parsed, err := strconv.ParseFloat(str, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return float32(parsed), nil
+}
+
+// Scan implements the Scanner interface.
+func (n *NullRatio) Scan(value interface{}) error {
+	if value == nil {
+		var zero float32
+
+		n.Data, n.Valid = zero, false
+
+		return nil
+	}
+
+	// sql.NullString converts any driver.Value to its text form, as database/sql does.
+	var str sql.NullString
+
+	err := str.Scan(value)
+	if err == nil {
+		var parsed float32
+
+		if parsed, err = parseNullRatio(str.String); err == nil {
+			n.Data = parsed
+		} else {
+			err = fmt.Errorf("converting driver.Value type %T (%q) to a float32: %w", value, str.String, err)
+		}
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (n NullRatio) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return float64(n.Data), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+func (n *NullRatio) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Valid = false
+
+		return nil
+	}
+
+	var parsed float32
+
+	err := json.Unmarshal(data, &parsed)
+	if err == nil {
+		n.Data = parsed
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullRatio is null.
+func (n NullRatio) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(float64(n.Data))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullRatio is null.
+func (n NullRatio) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return strconv.AppendFloat(nil, float64(n.Data), 'g', -1, 64), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullRatio if the input is blank or "null".
+func (n *NullRatio) UnmarshalText(text []byte) error {
+	if len(text) == 0 || string(text) == "null" {
+		n.Valid = false
+
+		return nil
+	}
+
+	parsed, err := parseNullRatio(string(text))
+	if err == nil {
+		n.Data = parsed
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// SetValid changes this NullRatio's value and also sets it to be non-null.
+func (n *NullRatio) SetValid(data float32) {
+	n.Data = data
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullRatio's value, or a nil pointer if this NullRatio is null.
+func (n NullRatio) Ptr() *float32 {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.Data
+}
+
+// IsZero returns true for null NullRatios, for potential future omitempty support.
+// A non-null NullRatio with a zero value will not be considered zero.
+func (n NullRatio) IsZero() bool {
+	return !n.Valid
+}
+
+// String implements fmt.Stringer interface.
+func (n NullRatio) String() string {
+	text, _ := n.MarshalText()
+
+	return string(text)
+}
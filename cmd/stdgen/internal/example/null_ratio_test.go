@@ -0,0 +1,109 @@
+// Code generated by "stdgen -type NullRatio -data float32 -strategy numeric -sample 0.5"; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleNullRatio(t *testing.T) float32 {
+	t.Helper()
+
+	return 0.5
+}
+
+func TestNullRatioSuite(t *testing.T) {
+	stdtest.RunNullableSuite(t, stdtest.Factory{
+		New:   func() stdtest.Nullable { return &NullRatio{} },
+		Value: sampleNullRatio(t),
+	})
+}
+
+func TestNullRatioFrom(t *testing.T) {
+	sample := sampleNullRatio(t)
+
+	assert.Equal(t, NullRatio{Data: sample, Valid: true}, NullRatioFrom(sample))
+	assert.Equal(t, NullRatio{Data: sample, Valid: true}, NullRatioFromPtr(&sample))
+	assert.False(t, NullRatioFromPtr(nil).Valid)
+	assert.False(t, NewNullRatio(sample, false).Valid)
+}
+
+func TestNullRatioJSON(t *testing.T) {
+	v := NullRatioFrom(sampleNullRatio(t))
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	var decoded NullRatio
+
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, v, decoded)
+
+	data, err = json.Marshal(NullRatio{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), &decoded))
+	assert.False(t, decoded.Valid)
+
+	assert.Error(t, json.Unmarshal([]byte("{}"), &decoded))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullRatioText(t *testing.T) {
+	v := NullRatioFrom(sampleNullRatio(t))
+
+	text, err := v.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, string(text), v.String())
+
+	var decoded NullRatio
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, v, decoded)
+
+	text, err = NullRatio{}.MarshalText()
+	assert.NoError(t, err)
+	assert.Empty(t, text)
+	assert.Empty(t, NullRatio{}.String())
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullRatioScanValue(t *testing.T) {
+	v := NullRatioFrom(sampleNullRatio(t))
+
+	value, err := v.Value()
+	assert.NoError(t, err)
+
+	var scanned NullRatio
+
+	assert.NoError(t, scanned.Scan(value))
+	assert.Equal(t, v, scanned)
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.False(t, scanned.Valid)
+
+	value, err = NullRatio{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestNullRatioPtr(t *testing.T) {
+	sample := sampleNullRatio(t)
+
+	assert.Equal(t, &sample, NullRatioFrom(sample).Ptr())
+	assert.Nil(t, NullRatio{}.Ptr())
+	assert.False(t, NullRatioFrom(sample).IsZero())
+	assert.True(t, NullRatio{}.IsZero())
+
+	var v NullRatio
+
+	v.SetValid(sample)
+	assert.Equal(t, NullRatioFrom(sample), v)
+}
@@ -0,0 +1,183 @@
+// Code generated by "stdgen -type NullStatus -data Status -strategy numeric -kind int"; DO NOT EDIT.
+
+package example
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// NullStatus is a nullable Status.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type NullStatus struct {
+	Data  Status
+	Valid bool // Valid is true if Data is not NULL
+}
+
+// NewNullStatus creates a new NullStatus.
+func NewNullStatus(data Status, valid bool) NullStatus {
+	return NullStatus{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullStatusFrom creates a new NullStatus that will always be valid.
+func NullStatusFrom(data Status) NullStatus {
+	return NewNullStatus(data, true)
+}
+
+// NullStatusFromPtr creates a new NullStatus that will be null if data is nil.
+func NullStatusFromPtr(data *Status) NullStatus {
+	if data == nil {
+		var zero Status
+
+		return NewNullStatus(zero, false)
+	}
+
+	return NewNullStatus(*data, true)
+}
+
+// parseNullStatus parses the text form of a Status.
+func parseNullStatus(str string) (Status, error) {
+	parsed, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if int64(Status(parsed)) != parsed {
+		return 0, &strconv.NumError{Func: "ParseInt", Num: str, Err: strconv.ErrRange}
+	}
+
+	return Status(parsed), nil
+}
+
+// Scan implements the Scanner interface.
+func (n *NullStatus) Scan(value interface{}) error {
+	if value == nil {
+		var zero Status
+
+		n.Data, n.Valid = zero, false
+
+		return nil
+	}
+
+	// sql.NullString converts any driver.Value to its text form, as database/sql does.
+	var str sql.NullString
+
+	err := str.Scan(value)
+	if err == nil {
+		var parsed Status
+
+		if parsed, err = parseNullStatus(str.String); err == nil {
+			n.Data = parsed
+		} else {
+			err = fmt.Errorf("converting driver.Value type %T (%q) to a Status: %w", value, str.String, err)
+		}
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (n NullStatus) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return int64(n.Data), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+func (n *NullStatus) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Valid = false
+
+		return nil
+	}
+
+	var parsed Status
+
+	err := json.Unmarshal(data, &parsed)
+	if err == nil {
+		n.Data = parsed
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullStatus is null.
+func (n NullStatus) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return strconv.AppendInt(nil, int64(n.Data), 10), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this NullStatus is null.
+func (n NullStatus) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+
+	return strconv.AppendInt(nil, int64(n.Data), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null NullStatus if the input is blank or "null".
+func (n *NullStatus) UnmarshalText(text []byte) error {
+	if len(text) == 0 || string(text) == "null" {
+		n.Valid = false
+
+		return nil
+	}
+
+	parsed, err := parseNullStatus(string(text))
+	if err == nil {
+		n.Data = parsed
+	}
+
+	n.Valid = err == nil
+
+	return err
+}
+
+// SetValid changes this NullStatus's value and also sets it to be non-null.
+func (n *NullStatus) SetValid(data Status) {
+	n.Data = data
+	n.Valid = true
+}
+
+// Ptr returns a pointer to this NullStatus's value, or a nil pointer if this NullStatus is null.
+func (n NullStatus) Ptr() *Status {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.Data
+}
+
+// IsZero returns true for null NullStatuss, for potential future omitempty support.
+// A non-null NullStatus with a zero value will not be considered zero.
+func (n NullStatus) IsZero() bool {
+	return !n.Valid
+}
+
+// String implements fmt.Stringer interface.
+func (n NullStatus) String() string {
+	text, _ := n.MarshalText()
+
+	return string(text)
+}
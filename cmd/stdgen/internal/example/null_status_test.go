@@ -0,0 +1,109 @@
+// Code generated by "stdgen -type NullStatus -data Status -strategy numeric -kind int"; DO NOT EDIT.
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleNullStatus(t *testing.T) Status {
+	t.Helper()
+
+	return Status(42)
+}
+
+func TestNullStatusSuite(t *testing.T) {
+	stdtest.RunNullableSuite(t, stdtest.Factory{
+		New:   func() stdtest.Nullable { return &NullStatus{} },
+		Value: sampleNullStatus(t),
+	})
+}
+
+func TestNullStatusFrom(t *testing.T) {
+	sample := sampleNullStatus(t)
+
+	assert.Equal(t, NullStatus{Data: sample, Valid: true}, NullStatusFrom(sample))
+	assert.Equal(t, NullStatus{Data: sample, Valid: true}, NullStatusFromPtr(&sample))
+	assert.False(t, NullStatusFromPtr(nil).Valid)
+	assert.False(t, NewNullStatus(sample, false).Valid)
+}
+
+func TestNullStatusJSON(t *testing.T) {
+	v := NullStatusFrom(sampleNullStatus(t))
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	var decoded NullStatus
+
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, v, decoded)
+
+	data, err = json.Marshal(NullStatus{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), &decoded))
+	assert.False(t, decoded.Valid)
+
+	assert.Error(t, json.Unmarshal([]byte("{}"), &decoded))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullStatusText(t *testing.T) {
+	v := NullStatusFrom(sampleNullStatus(t))
+
+	text, err := v.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, string(text), v.String())
+
+	var decoded NullStatus
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, v, decoded)
+
+	text, err = NullStatus{}.MarshalText()
+	assert.NoError(t, err)
+	assert.Empty(t, text)
+	assert.Empty(t, NullStatus{}.String())
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.False(t, decoded.Valid)
+}
+
+func TestNullStatusScanValue(t *testing.T) {
+	v := NullStatusFrom(sampleNullStatus(t))
+
+	value, err := v.Value()
+	assert.NoError(t, err)
+
+	var scanned NullStatus
+
+	assert.NoError(t, scanned.Scan(value))
+	assert.Equal(t, v, scanned)
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.False(t, scanned.Valid)
+
+	value, err = NullStatus{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestNullStatusPtr(t *testing.T) {
+	sample := sampleNullStatus(t)
+
+	assert.Equal(t, &sample, NullStatusFrom(sample).Ptr())
+	assert.Nil(t, NullStatus{}.Ptr())
+	assert.False(t, NullStatusFrom(sample).IsZero())
+	assert.True(t, NullStatus{}.IsZero())
+
+	var v NullStatus
+
+	v.SetValid(sample)
+	assert.Equal(t, NullStatusFrom(sample), v)
+}
@@ -0,0 +1,82 @@
+// Command stdgen generates nullable types following the std pattern,
+// for underlying types the library does not provide.
+//
+// Usage:
+//
+//	stdgen -type NullStatus -data Status -strategy numeric
+//
+// It is meant to be used with go:generate:
+//
+//	//go:generate go run github.com/euskadi31/go-std/cmd/stdgen -type NullStatus -data Status -strategy numeric
+//
+// The strategy selects how the underlying value is encoded:
+//
+//   - numeric: an integer or float type, stored and encoded as a number
+//   - string: a string type, stored and encoded as a string
+//   - text: a type implementing encoding.TextMarshaler and encoding.TextUnmarshaler,
+//     stored and encoded as its text form
+//
+// The type is written to a file named after it (null_status.go for NullStatus),
+// and its tests, using testify and stdtest.RunNullableSuite, to the matching _test.go file.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+func main() {
+	cfg, err := parseFlags(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "stdgen:", err)
+		os.Exit(2)
+	}
+
+	if err := run(cfg); err != nil {
+		fmt.Fprintln(os.Stderr, "stdgen:", err)
+		os.Exit(1)
+	}
+}
+
+func parseFlags(args []string) (Config, error) {
+	var cfg Config
+
+	fs := flag.NewFlagSet("stdgen", flag.ContinueOnError)
+	fs.StringVar(&cfg.Type, "type", "", "name of the nullable type to generate (required)")
+	fs.StringVar(&cfg.Data, "data", "", "underlying type, e.g. int32, Status or uuid.UUID (required)")
+	fs.StringVar(&cfg.Import, "import", "", "import path of the package declaring the underlying type")
+	fs.StringVar(&cfg.Strategy, "strategy", "", "encoding strategy: numeric, string or text (required)")
+	fs.StringVar(&cfg.Kind, "kind", "", "numeric kind of the underlying type: int, uint or float (default: inferred from builtin types)")
+	fs.StringVar(&cfg.Package, "package", os.Getenv("GOPACKAGE"), "package name of the generated files (default: $GOPACKAGE)")
+	fs.StringVar(&cfg.Sample, "sample", "", "valid value used by the tests: a Go expression, or the text form for the text strategy")
+	fs.StringVar(&cfg.Output, "output", "", "output file (default: the snake case type name with .go)")
+
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+
+	cfg.Args = args
+
+	return cfg, nil
+}
+
+func run(cfg Config) error {
+	code, test, err := Generate(cfg)
+	if err != nil {
+		return err
+	}
+
+	output := cfg.Output
+	if output == "" {
+		output = snakeCase(cfg.Type) + ".go"
+	}
+
+	if err := ioutil.WriteFile(output, code, 0644); err != nil { // nolint: gosec
+		return err
+	}
+
+	return ioutil.WriteFile(strings.TrimSuffix(output, ".go")+"_test.go", test, 0644) // nolint: gosec
+}
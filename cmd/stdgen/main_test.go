@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const generatePrefix = "//go:generate go run github.com/euskadi31/go-std/cmd/stdgen "
+
+// TestGenerateExample checks the files of the example package are up to date.
+func TestGenerateExample(t *testing.T) {
+	dir := filepath.Join("internal", "example")
+
+	f, err := os.Open(filepath.Join(dir, "example.go"))
+	assert.NoError(t, err)
+
+	defer f.Close()
+
+	count := 0
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, generatePrefix) {
+			continue
+		}
+
+		count++
+
+		cfg, err := parseFlags(strings.Fields(strings.TrimPrefix(line, generatePrefix)))
+		assert.NoError(t, err)
+
+		cfg.Package = "example"
+
+		code, test, err := Generate(cfg)
+		if !assert.NoError(t, err, line) {
+			continue
+		}
+
+		name := filepath.Join(dir, snakeCase(cfg.Type))
+
+		expected, err := ioutil.ReadFile(name + ".go")
+		assert.NoError(t, err)
+		assert.Equal(t, string(expected), string(code), "%s.go is out of date, run go generate", name)
+
+		expected, err = ioutil.ReadFile(name + "_test.go")
+		assert.NoError(t, err)
+		assert.Equal(t, string(expected), string(test), "%s_test.go is out of date, run go generate", name)
+	}
+
+	assert.NoError(t, scanner.Err())
+	assert.Equal(t, 5, count)
+}
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stdgen")
+	assert.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	output := filepath.Join(dir, "id.go")
+
+	err = run(Config{Type: "ID", Data: "int64", Strategy: "numeric", Package: "model", Output: output})
+	assert.NoError(t, err)
+
+	code, err := ioutil.ReadFile(output)
+	assert.NoError(t, err)
+	assert.Contains(t, string(code), "type ID struct {")
+
+	test, err := ioutil.ReadFile(filepath.Join(dir, "id_test.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(test), "func TestIDSuite(t *testing.T) {")
+}
+
+func TestGenerateImport(t *testing.T) {
+	code, test, err := Generate(Config{
+		Type:     "NullUUID",
+		Data:     "uuid.UUID",
+		Import:   "github.com/google/uuid",
+		Strategy: "text",
+		Package:  "model",
+		Sample:   "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, string(code), "\t\"github.com/google/uuid\"\n")
+	assert.Contains(t, string(code), "func (n *NullUUID) Scan(value interface{}) error {")
+	assert.Contains(t, string(test), "\t\"github.com/google/uuid\"\n")
+	assert.Contains(t, string(test), `sample.UnmarshalText([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))`)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	valid := Config{Type: "NullStatus", Data: "Status", Strategy: "numeric", Kind: "int", Package: "model"}
+
+	for _, c := range []struct {
+		change func(cfg *Config)
+		err    string
+	}{
+		{func(cfg *Config) { cfg.Type = "" }, "-type is required"},
+		{func(cfg *Config) { cfg.Type = "nullStatus" }, `-type "nullStatus" is not an exported identifier`},
+		{func(cfg *Config) { cfg.Data = "" }, "-data is required"},
+		{func(cfg *Config) { cfg.Data = "[" }, `-data "[" is not a type`},
+		{func(cfg *Config) { cfg.Package = "" }, "-package is required outside of go:generate"},
+		{func(cfg *Config) { cfg.Strategy = "" }, "-strategy is required"},
+		{func(cfg *Config) { cfg.Strategy = "binary" }, `unknown -strategy "binary", want numeric, string or text`},
+		{func(cfg *Config) { cfg.Kind = "" }, "-kind is required for the non builtin type Status"},
+		{func(cfg *Config) { cfg.Kind = "complex" }, `unknown -kind "complex", want int, uint or float`},
+		{func(cfg *Config) { cfg.Strategy = "text" }, "-sample is required for the text strategy"},
+	} {
+		cfg := valid
+		c.change(&cfg)
+
+		_, _, err := Generate(cfg)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), c.err)
+		}
+	}
+
+	_, err := parseFlags([]string{"-unknown"})
+	assert.Error(t, err)
+}
+
+func TestSnakeCase(t *testing.T) {
+	assert.Equal(t, "null_status", snakeCase("NullStatus"))
+	assert.Equal(t, "null_http_status", snakeCase("NullHTTPStatus"))
+	assert.Equal(t, "id", snakeCase("ID"))
+	assert.Equal(t, "null_uuid", snakeCase("NullUUID"))
+}
@@ -0,0 +1,418 @@
+package main
+
+// typeSource is the template of the nullable type.
+const typeSource = `// Code generated by "stdgen {{args .Args}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{quote .}}
+{{- end}}
+{{- if .Import}}
+
+	{{quote .Import}}
+{{- end}}
+)
+{{$r := .Receiver}}{{$t := .Type}}
+// {{.Type}} is a nullable {{.Data}}.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type {{.Type}} struct {
+	Data  {{.Data}}
+	Valid bool // Valid is true if Data is not NULL
+}
+
+// New{{.Type}} creates a new {{.Type}}.
+func New{{.Type}}(data {{.Data}}, valid bool) {{.Type}} {
+	return {{.Type}}{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// {{.Type}}From creates a new {{.Type}} that will always be valid.
+func {{.Type}}From(data {{.Data}}) {{.Type}} {
+	return New{{.Type}}(data, true)
+}
+
+// {{.Type}}FromPtr creates a new {{.Type}} that will be null if data is nil.
+func {{.Type}}FromPtr(data *{{.Data}}) {{.Type}} {
+	if data == nil {
+		var zero {{.Data}}
+
+		return New{{.Type}}(zero, false)
+	}
+
+	return New{{.Type}}(*data, true)
+}
+
+// parse{{.Type}} parses the text form of a {{.Data}}.
+func parse{{.Type}}(str string) ({{.Data}}, error) {
+{{- if eq .Strategy "numeric"}}
+{{- if eq .Kind "int"}}
+	parsed, err := strconv.ParseInt(str, 10, {{or .Bits 64}})
+	if err != nil {
+		return 0, err
+	}
+{{- if not .Bits}}
+
+	if int64({{.Data}}(parsed)) != parsed {
+		return 0, &strconv.NumError{Func: "ParseInt", Num: str, Err: strconv.ErrRange}
+	}
+{{- end}}
+{{- else if eq .Kind "uint"}}
+	parsed, err := strconv.ParseUint(str, 10, {{or .Bits 64}})
+	if err != nil {
+		return 0, err
+	}
+{{- if not .Bits}}
+
+	if uint64({{.Data}}(parsed)) != parsed {
+		return 0, &strconv.NumError{Func: "ParseUint", Num: str, Err: strconv.ErrRange}
+	}
+{{- end}}
+{{- else}}
+	parsed, err := strconv.ParseFloat(str, {{or .Bits 64}})
+	if err != nil {
+		return 0, err
+	}
+{{- end}}
+
+	return {{.Data}}(parsed), nil
+{{- else if eq .Strategy "string"}}
+	return {{.Data}}(str), nil
+{{- else}}
+	var parsed {{.Data}}
+
+	err := parsed.UnmarshalText([]byte(str))
+
+	return parsed, err
+{{- end}}
+}
+
+// Scan implements the Scanner interface.
+func ({{$r}} *{{.Type}}) Scan(value interface{}) error {
+	if value == nil {
+		var zero {{.Data}}
+
+		{{$r}}.Data, {{$r}}.Valid = zero, false
+
+		return nil
+	}
+
+	// sql.NullString converts any driver.Value to its text form, as database/sql does.
+	var str sql.NullString
+
+	err := str.Scan(value)
+	if err == nil {
+		var parsed {{.Data}}
+
+		if parsed, err = parse{{.Type}}(str.String); err == nil {
+			{{$r}}.Data = parsed
+		} else {
+			err = fmt.Errorf("converting driver.Value type %T (%q) to a {{.Data}}: %w", value, str.String, err)
+		}
+	}
+
+	{{$r}}.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func ({{$r}} {{.Type}}) Value() (driver.Value, error) {
+	if !{{$r}}.Valid {
+		return nil, nil
+	}
+{{if eq .Strategy "numeric"}}
+{{- if eq .Kind "int"}}
+	return int64({{$r}}.Data), nil
+{{- else if eq .Kind "uint"}}
+{{- if or (not .Bits) (eq .Bits 64)}}
+	if uint64({{$r}}.Data) > math.MaxInt64 {
+		return nil, errors.New("uint64 values with high bit set are not supported")
+	}
+
+{{end}}
+	return int64({{$r}}.Data), nil
+{{- else}}
+	return float64({{$r}}.Data), nil
+{{- end}}
+{{- else if eq .Strategy "string"}}
+	return string({{$r}}.Data), nil
+{{- else}}
+	text, err := {{$r}}.Data.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+{{- end}}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports {{if eq .Strategy "numeric"}}number{{else}}string{{end}} and null input.
+func ({{$r}} *{{.Type}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		{{$r}}.Valid = false
+
+		return nil
+	}
+{{if eq .Strategy "text"}}
+	var str string
+
+	err := json.Unmarshal(data, &str)
+	if err == nil {
+		var parsed {{.Data}}
+
+		if parsed, err = parse{{.Type}}(str); err == nil {
+			{{$r}}.Data = parsed
+		}
+	}
+{{- else}}
+	var parsed {{.Data}}
+
+	err := json.Unmarshal(data, &parsed)
+	if err == nil {
+		{{$r}}.Data = parsed
+	}
+{{- end}}
+
+	{{$r}}.Valid = err == nil
+
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this {{.Type}} is null.
+func ({{$r}} {{.Type}}) MarshalJSON() ([]byte, error) {
+	if !{{$r}}.Valid {
+		return []byte("null"), nil
+	}
+{{if eq .Strategy "numeric"}}
+{{- if eq .Kind "int"}}
+	return strconv.AppendInt(nil, int64({{$r}}.Data), 10), nil
+{{- else if eq .Kind "uint"}}
+	return strconv.AppendUint(nil, uint64({{$r}}.Data), 10), nil
+{{- else}}
+	return json.Marshal(float64({{$r}}.Data))
+{{- end}}
+{{- else if eq .Strategy "string"}}
+	return json.Marshal(string({{$r}}.Data))
+{{- else}}
+	text, err := {{$r}}.Data.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+{{- end}}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this {{.Type}} is null.
+func ({{$r}} {{.Type}}) MarshalText() ([]byte, error) {
+	if !{{$r}}.Valid {
+		return []byte{}, nil
+	}
+{{if eq .Strategy "numeric"}}
+{{- if eq .Kind "int"}}
+	return strconv.AppendInt(nil, int64({{$r}}.Data), 10), nil
+{{- else if eq .Kind "uint"}}
+	return strconv.AppendUint(nil, uint64({{$r}}.Data), 10), nil
+{{- else}}
+	return strconv.AppendFloat(nil, float64({{$r}}.Data), 'g', -1, 64), nil
+{{- end}}
+{{- else if eq .Strategy "string"}}
+	return []byte({{$r}}.Data), nil
+{{- else}}
+	return {{$r}}.Data.MarshalText()
+{{- end}}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null {{.Type}} if the input is blank{{if ne .Strategy "string"}} or "null"{{end}}.
+func ({{$r}} *{{.Type}}) UnmarshalText(text []byte) error {
+{{- if eq .Strategy "string"}}
+	{{$r}}.Data = {{.Data}}(text)
+	{{$r}}.Valid = len(text) != 0
+
+	return nil
+{{- else}}
+	if len(text) == 0 || string(text) == "null" {
+		{{$r}}.Valid = false
+
+		return nil
+	}
+
+	parsed, err := parse{{.Type}}(string(text))
+	if err == nil {
+		{{$r}}.Data = parsed
+	}
+
+	{{$r}}.Valid = err == nil
+
+	return err
+{{- end}}
+}
+
+// SetValid changes this {{.Type}}'s value and also sets it to be non-null.
+func ({{$r}} *{{.Type}}) SetValid(data {{.Data}}) {
+	{{$r}}.Data = data
+	{{$r}}.Valid = true
+}
+
+// Ptr returns a pointer to this {{.Type}}'s value, or a nil pointer if this {{.Type}} is null.
+func ({{$r}} {{.Type}}) Ptr() *{{.Data}} {
+	if !{{$r}}.Valid {
+		return nil
+	}
+
+	return &{{$r}}.Data
+}
+
+// IsZero returns true for null {{.Type}}s, for potential future omitempty support.
+// A non-null {{.Type}} with a zero value will not be considered zero.
+func ({{$r}} {{.Type}}) IsZero() bool {
+	return !{{$r}}.Valid
+}
+
+// String implements fmt.Stringer interface.
+func ({{$r}} {{.Type}}) String() string {
+	text, _ := {{$r}}.MarshalText()
+
+	return string(text)
+}
+`
+
+// testSource is the template of the tests of the nullable type.
+const testSource = `// Code generated by "stdgen {{args .Args}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{quote .}}
+{{- end}}
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+{{- if .Import}}
+
+	{{quote .Import}}
+{{- end}}
+)
+
+func sample{{.Type}}(t *testing.T) {{.Data}} {
+	t.Helper()
+{{if eq .Strategy "text"}}
+	var sample {{.Data}}
+
+	if err := sample.UnmarshalText([]byte({{quote .Sample}})); err != nil {
+		t.Fatal(err)
+	}
+
+	return sample
+{{- else}}
+	return {{.Sample}}
+{{- end}}
+}
+
+func Test{{.Type}}Suite(t *testing.T) {
+	stdtest.RunNullableSuite(t, stdtest.Factory{
+		New:   func() stdtest.Nullable { return &{{.Type}}{} },
+		Value: sample{{.Type}}(t),
+{{- if eq .Strategy "text"}}
+		Scan: []stdtest.ScanCase{
+			{Src: {{quote .Sample}}, Want: {{quote .Sample}}},
+		},
+{{- end}}
+	})
+}
+
+func Test{{.Type}}From(t *testing.T) {
+	sample := sample{{.Type}}(t)
+
+	assert.Equal(t, {{.Type}}{Data: sample, Valid: true}, {{.Type}}From(sample))
+	assert.Equal(t, {{.Type}}{Data: sample, Valid: true}, {{.Type}}FromPtr(&sample))
+	assert.False(t, {{.Type}}FromPtr(nil).Valid)
+	assert.False(t, New{{.Type}}(sample, false).Valid)
+}
+
+func Test{{.Type}}JSON(t *testing.T) {
+	v := {{.Type}}From(sample{{.Type}}(t))
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	var decoded {{.Type}}
+
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, v, decoded)
+
+	data, err = json.Marshal({{.Type}}{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), &decoded))
+	assert.False(t, decoded.Valid)
+
+	assert.Error(t, json.Unmarshal([]byte("{}"), &decoded))
+	assert.False(t, decoded.Valid)
+}
+
+func Test{{.Type}}Text(t *testing.T) {
+	v := {{.Type}}From(sample{{.Type}}(t))
+
+	text, err := v.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, string(text), v.String())
+
+	var decoded {{.Type}}
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, v, decoded)
+
+	text, err = {{.Type}}{}.MarshalText()
+	assert.NoError(t, err)
+	assert.Empty(t, text)
+	assert.Empty(t, {{.Type}}{}.String())
+
+	assert.NoError(t, decoded.UnmarshalText(text))
+	assert.False(t, decoded.Valid)
+}
+
+func Test{{.Type}}ScanValue(t *testing.T) {
+	v := {{.Type}}From(sample{{.Type}}(t))
+
+	value, err := v.Value()
+	assert.NoError(t, err)
+
+	var scanned {{.Type}}
+
+	assert.NoError(t, scanned.Scan(value))
+	assert.Equal(t, v, scanned)
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.False(t, scanned.Valid)
+
+	value, err = {{.Type}}{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func Test{{.Type}}Ptr(t *testing.T) {
+	sample := sample{{.Type}}(t)
+
+	assert.Equal(t, &sample, {{.Type}}From(sample).Ptr())
+	assert.Nil(t, {{.Type}}{}.Ptr())
+	assert.False(t, {{.Type}}From(sample).IsZero())
+	assert.True(t, {{.Type}}{}.IsZero())
+
+	var v {{.Type}}
+
+	v.SetValid(sample)
+	assert.Equal(t, {{.Type}}From(sample), v)
+}
+`
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines around the changes.
+const diffContext = 3
+
+// op is a line of a diff: ' ' unchanged, '-' removed or '+' added.
+type op struct {
+	kind byte
+	line string
+}
+
+// unifiedDiff returns the unified diff between a and b, empty if they are equal.
+func unifiedDiff(name string, a, b []byte) []byte {
+	if bytes.Equal(a, b) {
+		return nil
+	}
+
+	ops := diffLines(splitLines(a), splitLines(b))
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", name, name)
+
+	for start := 0; start < len(ops); {
+		// Find the next change
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+
+		if start == len(ops) {
+			break
+		}
+
+		// Extend the hunk until diffContext*2 unchanged lines separate two changes
+		end := start
+
+		for i := start; i < len(ops); i++ {
+			if ops[i].kind != ' ' {
+				end = i + 1
+			} else if i-end >= 2*diffContext {
+				break
+			}
+		}
+
+		first, last := start-diffContext, end+diffContext
+		if first < 0 {
+			first = 0
+		}
+
+		if last > len(ops) {
+			last = len(ops)
+		}
+
+		writeHunk(&buf, ops, first, last)
+
+		start = end
+	}
+
+	return buf.Bytes()
+}
+
+func writeHunk(buf *bytes.Buffer, ops []op, first, last int) {
+	// Line numbers of the hunk in a and b
+	aStart, bStart := 1, 1
+
+	for _, o := range ops[:first] {
+		if o.kind != '+' {
+			aStart++
+		}
+
+		if o.kind != '-' {
+			bStart++
+		}
+	}
+
+	aLen, bLen := 0, 0
+
+	for _, o := range ops[first:last] {
+		if o.kind != '+' {
+			aLen++
+		}
+
+		if o.kind != '-' {
+			bLen++
+		}
+	}
+
+	// An empty range starts at the line before it
+	if aLen == 0 {
+		aStart--
+	}
+
+	if bLen == 0 {
+		bStart--
+	}
+
+	fmt.Fprintf(buf, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+
+	for _, o := range ops[first:last] {
+		buf.WriteByte(o.kind)
+		buf.WriteString(o.line)
+		buf.WriteByte('\n')
+	}
+}
+
+func splitLines(b []byte) []string {
+	s := strings.TrimSuffix(string(b), "\n")
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
+
+// diffLines returns the operations turning a into b, from their longest common subsequence.
+func diffLines(a, b []string) []op {
+	// Common prefix and suffix are trimmed to keep the table small
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	// lcs[i][j] is the length of the longest common subsequence of ma[i:] and mb[j:]
+	lcs := make([][]int, len(ma)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(mb)+1)
+	}
+
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			switch {
+			case ma[i] == mb[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+
+	for _, line := range a[:prefix] {
+		ops = append(ops, op{' ', line})
+	}
+
+	i, j := 0, 0
+
+	for i < len(ma) && j < len(mb) {
+		switch {
+		case ma[i] == mb[j]:
+			ops = append(ops, op{' ', ma[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, op{'-', ma[i]})
+			i++
+		default:
+			ops = append(ops, op{'+', mb[j]})
+			j++
+		}
+	}
+
+	for ; i < len(ma); i++ {
+		ops = append(ops, op{'-', ma[i]})
+	}
+
+	for ; j < len(mb); j++ {
+		ops = append(ops, op{'+', mb[j]})
+	}
+
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, op{' ', line})
+	}
+
+	return ops
+}
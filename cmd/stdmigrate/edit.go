@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"sort"
+)
+
+// edit replaces the source between the offsets start and end by text.
+type edit struct {
+	start, end int
+	text       string
+}
+
+// editor collects the edits of a file.
+// Nodes are edited bottom-up: an edit replaces the edits it contains,
+// and text returns the source of a node with the edits it contains applied.
+type editor struct {
+	fset  *token.FileSet
+	src   []byte
+	edits []edit
+}
+
+func newEditor(fset *token.FileSet, src []byte) *editor {
+	return &editor{
+		fset: fset,
+		src:  src,
+	}
+}
+
+func (e *editor) offset(pos token.Pos) int {
+	return e.fset.Position(pos).Offset
+}
+
+// replace replaces the source of node by text.
+func (e *editor) replace(node ast.Node, text string) {
+	e.replaceRange(node.Pos(), node.End(), text)
+}
+
+// replaceRange replaces the source between pos and end by text.
+func (e *editor) replaceRange(pos, end token.Pos, text string) {
+	start, stop := e.offset(pos), e.offset(end)
+
+	edits := e.edits[:0]
+
+	for _, ed := range e.edits {
+		if ed.start < start || ed.end > stop {
+			edits = append(edits, ed)
+		}
+	}
+
+	e.edits = append(edits, edit{start: start, end: stop, text: text})
+}
+
+// text returns the source of node, with the edits it contains applied.
+func (e *editor) text(node ast.Node) string {
+	return string(e.apply(e.offset(node.Pos()), e.offset(node.End())))
+}
+
+// bytes returns the edited source.
+func (e *editor) bytes() []byte {
+	return e.apply(0, len(e.src))
+}
+
+func (e *editor) apply(start, end int) []byte {
+	var contained []edit
+
+	for _, ed := range e.edits {
+		if ed.start >= start && ed.end <= end {
+			contained = append(contained, ed)
+		}
+	}
+
+	sort.Slice(contained, func(i, j int) bool {
+		return contained[i].start < contained[j].start
+	})
+
+	var buf bytes.Buffer
+
+	pos := start
+
+	for _, ed := range contained {
+		buf.Write(e.src[pos:ed.start])
+		buf.WriteString(ed.text)
+		pos = ed.end
+	}
+
+	buf.Write(e.src[pos:end])
+
+	return buf.Bytes()
+}
@@ -0,0 +1,188 @@
+// Command stdmigrate rewrites Go code from database/sql null types and pointer fields to std types.
+//
+// Usage:
+//
+//	stdmigrate [-dry-run] [packages]
+//
+// Packages are directories, a trailing /... includes their sub-directories.
+// The default is the current directory.
+//
+// The rewrites are:
+//
+//   - sql.NullString, sql.NullInt64, sql.NullFloat64, sql.NullBool and sql.NullTime
+//     become std.String, std.Int, std.Float, std.Bool and std.Time
+//   - their value fields (.String, .Int64, .Float64, .Bool) become .Data
+//   - sql.NullString{String: s, Valid: true} becomes std.StringFrom(s),
+//     and sql.NullString{} becomes std.String{}
+//   - struct fields of type *string, *int64, *uint64, *float64, *bool and *time.Time
+//     become std.String, std.Int, std.Uint, std.Float, std.Bool and std.Time, and their uses follow:
+//     *x.F becomes x.F.Data, x.F == nil becomes !x.F.Valid, x.F = &v becomes x.F = std.StringFrom(v),
+//     x.F = p becomes x.F = std.StringFromPtr(p) and other reads become x.F.Ptr()
+//
+// The std import is added and the database/sql and time imports are removed when they are no longer used.
+// Only the code of the migrated package is rewritten: the uses of its pointer fields in other packages,
+// external tests included, must be migrated by hand.
+//
+// With -dry-run, the files are not written and a unified diff of the changes is printed instead.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	fs := flag.NewFlagSet("stdmigrate", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "print a diff of the changes instead of writing the files")
+
+	_ = fs.Parse(os.Args[1:])
+
+	if err := run(os.Stdout, fs.Args(), *dryRun); err != nil {
+		fmt.Fprintln(os.Stderr, "stdmigrate:", err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, patterns []string, dryRun bool) error {
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	dirs, err := expandPatterns(patterns)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		if err := migrateDir(w, dir, dryRun); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expandPatterns returns the directories matched by patterns.
+func expandPatterns(patterns []string) ([]string, error) {
+	var dirs []string
+
+	for _, pattern := range patterns {
+		if !strings.HasSuffix(pattern, "/...") {
+			dirs = append(dirs, pattern)
+
+			continue
+		}
+
+		root := strings.TrimSuffix(pattern, "/...")
+
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !info.IsDir() {
+				return nil
+			}
+
+			name := info.Name()
+			if path != root && (name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+
+			dirs = append(dirs, path)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dirs, nil
+}
+
+// migrateDir migrates the packages of dir.
+func migrateDir(w io.Writer, dir string, dryRun bool) error {
+	fset := token.NewFileSet()
+
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(names)
+
+	// The files of a directory are grouped by package, external tests apart
+	packages := make(map[string][]*ast.File)
+	sources := make(map[*ast.File][]byte)
+	paths := make(map[*ast.File]string)
+
+	var order []string
+
+	for _, name := range names {
+		src, err := ioutil.ReadFile(name)
+		if err != nil {
+			return err
+		}
+
+		file, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+
+		pkg := file.Name.Name
+		if _, ok := packages[pkg]; !ok {
+			order = append(order, pkg)
+		}
+
+		packages[pkg] = append(packages[pkg], file)
+		sources[file] = src
+		paths[file] = name
+	}
+
+	for _, pkg := range order {
+		m := newMigration(fset, packages[pkg])
+
+		for _, file := range packages[pkg] {
+			out, err := m.rewrite(file, sources[file])
+			if err != nil {
+				return fmt.Errorf("%s: %w", paths[file], err)
+			}
+
+			if out == nil {
+				continue
+			}
+
+			if dryRun {
+				if _, err := w.Write(unifiedDiff(paths[file], sources[file], out)); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if err := ioutil.WriteFile(paths[file], out, 0644); err != nil { // nolint: gosec
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// newImporter returns an importer type checking the imported packages from source,
+// so the migration does not depend on compiled export data.
+func newImporter(fset *token.FileSet) types.Importer {
+	return importer.ForCompiler(fset, "source", nil)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// migrateInput copies the input file of a test case to a temporary package directory.
+func migrateInput(t *testing.T, name string) string {
+	t.Helper()
+
+	src, err := ioutil.ReadFile(filepath.Join("testdata", name+".input"))
+	assert.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "stdmigrate")
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".go"), src, 0644))
+
+	return dir
+}
+
+func TestRun(t *testing.T) {
+	for _, name := range []string{"user", "single", "noimport"} {
+		t.Run(name, func(t *testing.T) {
+			dir := migrateInput(t, name)
+
+			var out bytes.Buffer
+
+			assert.NoError(t, run(&out, []string{dir}, false))
+			assert.Empty(t, out.String())
+
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", name+".golden"))
+			assert.NoError(t, err)
+
+			actual, err := ioutil.ReadFile(filepath.Join(dir, name+".go"))
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestRunUnchanged(t *testing.T) {
+	dir := migrateInput(t, "plain")
+
+	var out bytes.Buffer
+
+	assert.NoError(t, run(&out, []string{dir}, true))
+	assert.Empty(t, out.String())
+
+	assert.NoError(t, run(&out, []string{dir}, false))
+
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", "plain.input"))
+	assert.NoError(t, err)
+
+	actual, err := ioutil.ReadFile(filepath.Join(dir, "plain.go"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+}
+
+func TestRunDryRun(t *testing.T) {
+	dir := migrateInput(t, "single")
+
+	var out bytes.Buffer
+
+	assert.NoError(t, run(&out, []string{filepath.Dir(dir) + "/" + filepath.Base(dir) + "/..."}, true))
+
+	name := filepath.Join(dir, "single.go")
+
+	assert.True(t, strings.HasPrefix(out.String(), "--- "+name+"\n+++ "+name+"\n@@ -1,10 +1,10 @@\n"), out.String())
+	assert.Contains(t, out.String(), "\n-import \"database/sql\"\n+import std \"github.com/euskadi31/go-std\"\n")
+	assert.Contains(t, out.String(), "\n-\tEmail sql.NullString\n+\tEmail std.String\n")
+	assert.Contains(t, out.String(), "\n-\treturn a.Email.String\n+\treturn a.Email.Data\n")
+
+	// The files are left unchanged
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", "single.input"))
+	assert.NoError(t, err)
+
+	actual, err := ioutil.ReadFile(name)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := []byte("a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\nn\n")
+	b := []byte("a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\nn\no\n")
+
+	assert.Equal(t, "--- f.go\n+++ f.go\n"+
+		"@@ -1,5 +1,5 @@\n a\n-b\n+B\n c\n d\n e\n"+
+		"@@ -12,3 +12,4 @@\n l\n m\n n\n+o\n", string(unifiedDiff("f.go", a, b)))
+
+	assert.Empty(t, unifiedDiff("f.go", a, a))
+
+	assert.Equal(t, "--- f.go\n+++ f.go\n@@ -0,0 +1,1 @@\n+a\n", string(unifiedDiff("f.go", nil, []byte("a\n"))))
+	assert.Equal(t, "--- f.go\n+++ f.go\n@@ -1,2 +1,1 @@\n a\n-b\n", string(unifiedDiff("f.go", []byte("a\nb\n"), []byte("a\n"))))
+}
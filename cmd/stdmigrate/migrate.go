@@ -0,0 +1,542 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strconv"
+)
+
+// stdPath is the import path of the std package.
+const stdPath = "github.com/euskadi31/go-std"
+
+// nullType describes the std type replacing a database/sql null type or a pointer.
+type nullType struct {
+	// Std is the name of the std type.
+	Std string
+	// Field is the value field of the database/sql type.
+	Field string
+	// Data is the value field of the std type.
+	Data string
+}
+
+// sqlTypes are the database/sql null types, by name.
+var sqlTypes = map[string]nullType{
+	"NullString":  {Std: "String", Field: "String", Data: "Data"},
+	"NullInt64":   {Std: "Int", Field: "Int64", Data: "Data"},
+	"NullFloat64": {Std: "Float", Field: "Float64", Data: "Data"},
+	"NullBool":    {Std: "Bool", Field: "Bool", Data: "Data"},
+	"NullTime":    {Std: "Time", Field: "Time", Data: "Time"},
+}
+
+// pointerTypes are the std types replacing pointer fields, by pointed type.
+var pointerTypes = map[string]nullType{
+	"string":    {Std: "String", Data: "Data"},
+	"int64":     {Std: "Int", Data: "Data"},
+	"uint64":    {Std: "Uint", Data: "Data"},
+	"float64":   {Std: "Float", Data: "Data"},
+	"bool":      {Std: "Bool", Data: "Data"},
+	"time.Time": {Std: "Time", Data: "Time"},
+}
+
+// migration rewrites the files of a package.
+type migration struct {
+	fset  *token.FileSet
+	info  *types.Info
+	files []*ast.File
+	// fields are the pointer fields turned into std types.
+	fields map[*types.Var]nullType
+}
+
+// newMigration type checks the files of a package.
+// Type errors are ignored: what could not be type checked is left unchanged.
+func newMigration(fset *token.FileSet, files []*ast.File) *migration {
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+	}
+
+	cfg := types.Config{
+		Importer: newImporter(fset),
+		Error:    func(error) {},
+	}
+
+	if len(files) > 0 {
+		_, _ = cfg.Check(files[0].Name.Name, fset, files, info)
+	}
+
+	m := &migration{
+		fset:   fset,
+		info:   info,
+		files:  files,
+		fields: make(map[*types.Var]nullType),
+	}
+
+	for _, file := range files {
+		m.collectFields(file)
+	}
+
+	return m
+}
+
+// collectFields records the pointer fields of the struct types of file which are turned into std types.
+func (m *migration) collectFields(file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		st, ok := n.(*ast.StructType)
+		if !ok {
+			return true
+		}
+
+		for _, field := range st.Fields.List {
+			star, ok := field.Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+
+			nt, ok := m.pointerType(star)
+			if !ok {
+				continue
+			}
+
+			for _, name := range field.Names {
+				if v, ok := m.info.Defs[name].(*types.Var); ok {
+					m.fields[v] = nt
+				}
+			}
+		}
+
+		return true
+	})
+}
+
+// pointerType returns the std type replacing the pointer type star.
+func (m *migration) pointerType(star *ast.StarExpr) (nullType, bool) {
+	t := m.info.TypeOf(star.X)
+	if t == nil {
+		return nullType{}, false
+	}
+
+	nt, ok := pointerTypes[types.TypeString(t, nil)]
+
+	return nt, ok
+}
+
+// sqlType returns the std type replacing t, if it is a database/sql null type.
+func sqlType(t types.Type) (nullType, bool) {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != "database/sql" {
+		return nullType{}, false
+	}
+
+	nt, ok := sqlTypes[named.Obj().Name()]
+
+	return nt, ok
+}
+
+// rewrite returns the migrated source of file, or nil if it is unchanged.
+func (m *migration) rewrite(file *ast.File, src []byte) ([]byte, error) {
+	e := newEditor(m.fset, src)
+
+	var stack []ast.Node
+
+	// Nodes are rewritten after their children
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n != nil {
+			stack = append(stack, n)
+
+			return true
+		}
+
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		m.rewriteNode(e, node, stack)
+
+		return true
+	})
+
+	if len(e.edits) == 0 {
+		return nil, nil
+	}
+
+	out, err := fixImports(e.bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// rewriteNode rewrites node, whose ancestors are stack.
+// nolint: gocyclo
+func (m *migration) rewriteNode(e *editor, node ast.Node, stack []ast.Node) {
+	var parent ast.Node
+	if len(stack) > 0 {
+		parent = stack[len(stack)-1]
+	}
+
+	switch n := node.(type) {
+	case *ast.SelectorExpr:
+		// sql.NullString -> std.String
+		if tn, ok := m.info.Uses[n.Sel].(*types.TypeName); ok {
+			if nt, ok := sqlType(tn.Type()); ok {
+				e.replace(n, "std."+nt.Std)
+			}
+
+			return
+		}
+
+		selection, ok := m.info.Selections[n]
+		if !ok || selection.Kind() != types.FieldVal {
+			return
+		}
+
+		// ns.String -> ns.Data
+		if nt, ok := sqlType(selection.Recv()); ok && n.Sel.Name == nt.Field && nt.Field != nt.Data {
+			e.replace(n.Sel, nt.Data)
+
+			return
+		}
+
+		if v, ok := selection.Obj().(*types.Var); ok {
+			if nt, ok := m.fields[v]; ok {
+				m.rewritePointerUse(e, n, nt, parent)
+			}
+		}
+	case *ast.StarExpr:
+		// Name *string -> Name std.String
+		if field, ok := parent.(*ast.Field); ok && field.Type == n && len(field.Names) > 0 {
+			if v, ok := m.info.Defs[field.Names[0]].(*types.Var); ok {
+				if nt, ok := m.fields[v]; ok {
+					e.replace(n, "std."+nt.Std)
+				}
+			}
+
+			return
+		}
+
+		// *u.Name -> u.Name.Data
+		if nt, ok := m.pointerField(n.X); ok {
+			e.replace(n, e.text(n.X)+"."+nt.Data)
+		}
+	case *ast.BinaryExpr:
+		// u.Name == nil -> !u.Name.Valid
+		if n.Op != token.EQL && n.Op != token.NEQ {
+			return
+		}
+
+		x, y := n.X, n.Y
+		if m.isNil(x) {
+			x, y = y, x
+		}
+
+		if _, ok := m.pointerField(x); ok && m.isNil(y) {
+			not := ""
+			if n.Op == token.EQL {
+				not = "!"
+			}
+
+			e.replace(n, not+e.text(x)+".Valid")
+		}
+	case *ast.AssignStmt:
+		// u.Name = &s -> u.Name = std.StringFrom(s)
+		if len(n.Lhs) != len(n.Rhs) {
+			return
+		}
+
+		for i, lhs := range n.Lhs {
+			if nt, ok := m.pointerField(lhs); ok {
+				e.replace(n.Rhs[i], m.pointerValue(e, n.Rhs[i], nt))
+			}
+		}
+	case *ast.KeyValueExpr:
+		// Name: &s -> Name: std.StringFrom(s)
+		if key, ok := n.Key.(*ast.Ident); ok {
+			if v, ok := m.info.Uses[key].(*types.Var); ok {
+				if nt, ok := m.fields[v]; ok {
+					e.replace(n.Value, m.pointerValue(e, n.Value, nt))
+				}
+			}
+		}
+	case *ast.CompositeLit:
+		m.rewriteCompositeLit(e, n)
+	}
+}
+
+// rewritePointerUse rewrites the read of the pointer field sel, which is not handled by its parent.
+func (m *migration) rewritePointerUse(e *editor, sel *ast.SelectorExpr, nt nullType, parent ast.Node) {
+	switch p := parent.(type) {
+	case *ast.StarExpr:
+		return
+	case *ast.UnaryExpr:
+		// &u.Name is still a sql.Scanner
+		if p.Op == token.AND {
+			return
+		}
+	case *ast.BinaryExpr:
+		if m.isNil(p.X) || m.isNil(p.Y) {
+			return
+		}
+	case *ast.AssignStmt:
+		for _, lhs := range p.Lhs {
+			if lhs == sel {
+				return
+			}
+		}
+	case *ast.SelectorExpr:
+		// u.CreatedAt.Year() -> u.CreatedAt.Time.Year()
+		if p.X == sel {
+			e.replace(sel, e.text(sel)+"."+nt.Data)
+
+			return
+		}
+	}
+
+	// The field is used as a pointer
+	e.replace(sel, e.text(sel)+".Ptr()")
+}
+
+// pointerField returns the std type replacing the pointer field selected by x.
+func (m *migration) pointerField(x ast.Expr) (nullType, bool) {
+	sel, ok := unparen(x).(*ast.SelectorExpr)
+	if !ok {
+		return nullType{}, false
+	}
+
+	selection, ok := m.info.Selections[sel]
+	if !ok {
+		return nullType{}, false
+	}
+
+	v, ok := selection.Obj().(*types.Var)
+	if !ok {
+		return nullType{}, false
+	}
+
+	nt, ok := m.fields[v]
+
+	return nt, ok
+}
+
+// pointerValue returns the std value replacing the pointer value x.
+func (m *migration) pointerValue(e *editor, x ast.Expr, nt nullType) string {
+	if m.isNil(x) {
+		return "std." + nt.Std + "{}"
+	}
+
+	if u, ok := unparen(x).(*ast.UnaryExpr); ok && u.Op == token.AND {
+		return "std." + nt.Std + "From(" + e.text(u.X) + ")"
+	}
+
+	if _, ok := m.pointerField(x); ok {
+		return e.text(x)
+	}
+
+	return "std." + nt.Std + "FromPtr(" + e.text(x) + ")"
+}
+
+// rewriteCompositeLit rewrites the composite literals of database/sql null types:
+// sql.NullString{String: s, Valid: true} becomes std.StringFrom(s),
+// and the keys of the other literals are renamed.
+func (m *migration) rewriteCompositeLit(e *editor, lit *ast.CompositeLit) {
+	nt, ok := sqlType(m.info.TypeOf(lit))
+	if !ok || lit.Type == nil {
+		return
+	}
+
+	var value, valid ast.Expr
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			// Positional fields are in the same order
+			return
+		}
+
+		key, _ := kv.Key.(*ast.Ident)
+
+		switch {
+		case key == nil:
+			return
+		case key.Name == "Valid":
+			valid = kv.Value
+		case key.Name == nt.Field:
+			value = kv.Value
+
+			if nt.Field != nt.Data {
+				e.replace(key, nt.Data)
+			}
+		}
+	}
+
+	switch {
+	case value != nil && m.isTrue(valid):
+		e.replace(lit, "std."+nt.Std+"From("+e.text(value)+")")
+	case value == nil && (valid == nil || m.isFalse(valid)):
+		e.replace(lit, "std."+nt.Std+"{}")
+	}
+}
+
+// unparen returns x without its enclosing parentheses.
+func unparen(x ast.Expr) ast.Expr {
+	for {
+		p, ok := x.(*ast.ParenExpr)
+		if !ok {
+			return x
+		}
+
+		x = p.X
+	}
+}
+
+func (m *migration) isNil(x ast.Expr) bool {
+	id, ok := unparen(x).(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	_, ok = m.info.Uses[id].(*types.Nil)
+
+	return ok
+}
+
+func (m *migration) isTrue(x ast.Expr) bool {
+	return m.isConst(x, "true")
+}
+
+func (m *migration) isFalse(x ast.Expr) bool {
+	return m.isConst(x, "false")
+}
+
+func (m *migration) isConst(x ast.Expr, name string) bool {
+	if x == nil {
+		return false
+	}
+
+	id, ok := unparen(x).(*ast.Ident)
+	if !ok || id.Name != name {
+		return false
+	}
+
+	c, ok := m.info.Uses[id].(*types.Const)
+
+	return ok && c.Pkg() == nil
+}
+
+// fixImports adds the std import when it is used, removes the imports no longer used, and formats src.
+func fixImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing migrated source: %w", err)
+	}
+
+	e := newEditor(fset, src)
+	used := usedPackages(file)
+	removed := make(map[*ast.ImportSpec]bool)
+	imported := false
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			imp := spec.(*ast.ImportSpec) // nolint: forcetypeassert
+
+			path, _ := strconv.Unquote(imp.Path.Value)
+			if path == stdPath {
+				imported = true
+			}
+
+			// Only the imports the migration may leave unused are removed
+			if (path != "database/sql" && path != "time") || imp.Name != nil || used[importName(path)] {
+				continue
+			}
+
+			removed[imp] = true
+
+			if gen.Lparen.IsValid() {
+				e.replaceRange(lineStart(fset, src, imp.Pos()), imp.End(), "")
+			} else {
+				e.replace(gen, "")
+			}
+		}
+	}
+
+	if used["std"] && !imported {
+		addImport(e, file, removed)
+	}
+
+	return format.Source(e.bytes())
+}
+
+// addImport adds the std import to file, replacing the single import of a declaration if it is removed.
+func addImport(e *editor, file *ast.File, removed map[*ast.ImportSpec]bool) {
+	const spec = `std "` + stdPath + `"`
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+
+		switch {
+		case gen.Lparen.IsValid():
+			e.replaceRange(gen.Rparen, gen.Rparen, "\n\t"+spec+"\n")
+		case removed[gen.Specs[0].(*ast.ImportSpec)]: // nolint: forcetypeassert
+			e.replace(gen, "import "+spec)
+		default:
+			e.replace(gen, "import (\n\t"+e.text(gen.Specs[0])+"\n\n\t"+spec+"\n)")
+		}
+
+		return
+	}
+
+	e.replaceRange(file.Name.End(), file.Name.End(), "\n\nimport "+spec)
+}
+
+// lineStart returns the position of the start of the line of pos.
+func lineStart(fset *token.FileSet, src []byte, pos token.Pos) token.Pos {
+	offset := fset.Position(pos).Offset
+	start := bytes.LastIndexByte(src[:offset], '\n') + 1
+
+	return pos - token.Pos(offset-start)
+}
+
+func importName(path string) string {
+	if path == "database/sql" {
+		return "sql"
+	}
+
+	return path
+}
+
+// usedPackages returns the names of the packages whose members are used by file.
+func usedPackages(file *ast.File) map[string]bool {
+	used := make(map[string]bool)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil {
+				used[id.Name] = true
+			}
+		}
+
+		return true
+	})
+
+	return used
+}
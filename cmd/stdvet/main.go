@@ -0,0 +1,22 @@
+// Command stdvet reports reads of the value of std nullable types not guarded by a Valid or IsZero check.
+//
+// It can be run on its own:
+//
+//	stdvet ./...
+//	stdvet -fix ./...
+//
+// or as a vet tool:
+//
+//	go vet -vettool=$(which stdvet) ./...
+//
+// It lives in its own module so that the std package does not depend on golang.org/x/tools.
+package main
+
+import (
+	"github.com/euskadi31/go-std/cmd/stdvet/nullcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(nullcheck.Analyzer)
+}
@@ -0,0 +1,421 @@
+// Package nullcheck defines an Analyzer reporting reads of the value of std nullable types
+// not guarded by a Valid or IsZero check.
+package nullcheck
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const doc = `report unchecked reads of the value of std nullable types
+
+The value of a null std.Int, std.String, std.Time... is meaningless.
+Reading x.Data (x.Time for std.Time) is reported unless it is guarded
+by a check of x.Valid or x.IsZero(), such as:
+
+	if x.Valid { use(x.Data) }
+	if !x.Valid { return }; use(x.Data)
+	x.Valid && x.Data > 0
+
+The suggested fix replaces the read with x.ValueOr(zero), or x.ValueOrZero(),
+when the type has these methods.`
+
+// stdPath is the import path of the std package.
+const stdPath = "github.com/euskadi31/go-std"
+
+// Analyzer reports unchecked reads of the value of std nullable types.
+var Analyzer = &analysis.Analyzer{
+	Name:     "nullcheck",
+	Doc:      doc,
+	URL:      "https://pkg.go.dev/github.com/euskadi31/go-std/cmd/stdvet/nullcheck",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// terminators are the names of the functions and methods ending the control flow, besides panic.
+var terminators = map[string]bool{
+	"Exit": true, "Fatal": true, "Fatalf": true, "Fatalln": true, "FailNow": true,
+	"Panic": true, "Panicf": true, "Panicln": true, "Skip": true, "Skipf": true, "SkipNow": true,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Path() == stdPath {
+		return nil, nil
+	}
+
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector) // nolint: forcetypeassert
+
+	ins.WithStack([]ast.Node{(*ast.SelectorExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		sel := n.(*ast.SelectorExpr) // nolint: forcetypeassert
+
+		field, ok := nullableField(pass.TypesInfo, sel)
+		if !ok || isWrite(sel, stack) || isGuarded(sel.X, stack) {
+			return true
+		}
+
+		x := render(pass.Fset, sel.X)
+
+		diag := analysis.Diagnostic{
+			Pos:     sel.Pos(),
+			End:     sel.End(),
+			Message: fmt.Sprintf("%s.%s read without checking %s.Valid", x, sel.Sel.Name, x),
+		}
+
+		if fix, ok := suggestFix(pass, sel, field, x, stack); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+		}
+
+		pass.Report(diag)
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// nullableField returns the value field selected by sel, if it is the Data or Time field of a std nullable type.
+func nullableField(info *types.Info, sel *ast.SelectorExpr) (*types.Var, bool) {
+	selection, ok := info.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal || len(selection.Index()) != 1 {
+		return nil, false
+	}
+
+	field, ok := selection.Obj().(*types.Var)
+	if !ok || (field.Name() != "Data" && field.Name() != "Time") {
+		return nil, false
+	}
+
+	recv := selection.Recv()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+
+	named, ok := recv.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != stdPath {
+		return nil, false
+	}
+
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return nil, false
+	}
+
+	for i := 0; i < st.NumFields(); i++ {
+		if f := st.Field(i); f.Name() == "Valid" {
+			return field, true
+		}
+	}
+
+	return nil, false
+}
+
+// isWrite reports whether sel, or an expression selecting, indexing or dereferencing it
+// such as x.Data.Field, x.Data[i] or *x.Data, is assigned, incremented or has its address taken.
+func isWrite(sel *ast.SelectorExpr, stack []ast.Node) bool {
+	var child ast.Node = sel
+
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch parent := stack[i].(type) {
+		case *ast.SelectorExpr:
+			if parent.X != child {
+				return false
+			}
+		case *ast.IndexExpr:
+			if parent.X != child {
+				return false
+			}
+		case *ast.StarExpr, *ast.ParenExpr:
+		case *ast.AssignStmt:
+			for _, lhs := range parent.Lhs {
+				if lhs == child {
+					return true
+				}
+			}
+
+			return false
+		case *ast.IncDecStmt:
+			return true
+		case *ast.UnaryExpr:
+			return parent.Op == token.AND
+		default:
+			return false
+		}
+
+		child = stack[i]
+	}
+
+	return false
+}
+
+// isGuarded reports whether the read of the value of x at the top of stack only happens when x is valid.
+func isGuarded(x ast.Expr, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		child := stack[i+1]
+
+		switch node := stack[i].(type) {
+		case *ast.FuncDecl:
+			return false
+		case *ast.IfStmt:
+			if child == node.Body && trueImpliesValid(node.Cond, x) {
+				return true
+			}
+
+			if child == node.Else && falseImpliesValid(node.Cond, x) {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if child == node.Y && node.Op == token.LAND && trueImpliesValid(node.X, x) {
+				return true
+			}
+
+			if child == node.Y && node.Op == token.LOR && falseImpliesValid(node.X, x) {
+				return true
+			}
+		case *ast.BlockStmt:
+			if guardedBy(node.List, child, x) {
+				return true
+			}
+		case *ast.CaseClause:
+			if guardedBy(node.Body, child, x) {
+				return true
+			}
+
+			if isTaglessCase(stack, i) && len(node.List) == 1 && trueImpliesValid(node.List[0], x) {
+				return true
+			}
+		case *ast.CommClause:
+			if guardedBy(node.Body, child, x) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isTaglessCase reports whether the case clause at stack[i] belongs to a switch without tag.
+func isTaglessCase(stack []ast.Node, i int) bool {
+	if i < 2 {
+		return false
+	}
+
+	sw, ok := stack[i-2].(*ast.SwitchStmt)
+
+	return ok && sw.Tag == nil
+}
+
+// guardedBy reports whether a statement of list before child makes sure x is valid,
+// such as: if !x.Valid { return }.
+func guardedBy(list []ast.Stmt, child ast.Node, x ast.Expr) bool {
+	for _, stmt := range list {
+		if stmt == child {
+			return false
+		}
+
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if !ok || ifStmt.Else != nil || !falseImpliesValid(ifStmt.Cond, x) || len(ifStmt.Body.List) == 0 {
+			continue
+		}
+
+		last := ifStmt.Body.List[len(ifStmt.Body.List)-1]
+		if terminates(last) || validates(last, x) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// terminates reports whether stmt ends the control flow of its block.
+func terminates(stmt ast.Stmt) bool {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+
+		switch fn := call.Fun.(type) {
+		case *ast.Ident:
+			return fn.Name == "panic"
+		case *ast.SelectorExpr:
+			return terminators[fn.Sel.Name]
+		}
+	}
+
+	return false
+}
+
+// validates reports whether stmt makes x valid: x.SetValid(v), x = v or x.Valid = true.
+func validates(stmt ast.Stmt, x ast.Expr) bool {
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		if call, ok := s.X.(*ast.CallExpr); ok {
+			if fn, ok := call.Fun.(*ast.SelectorExpr); ok {
+				return fn.Sel.Name == "SetValid" && sameExpr(fn.X, x)
+			}
+		}
+	case *ast.AssignStmt:
+		for _, lhs := range s.Lhs {
+			if sameExpr(lhs, x) || isValidField(lhs, x) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// trueImpliesValid reports whether x is valid when cond is true.
+func trueImpliesValid(cond ast.Expr, x ast.Expr) bool {
+	switch e := ast.Unparen(cond).(type) {
+	case *ast.SelectorExpr:
+		return isValidField(e, x)
+	case *ast.UnaryExpr:
+		return e.Op == token.NOT && falseImpliesValid(e.X, x)
+	case *ast.BinaryExpr:
+		return e.Op == token.LAND && (trueImpliesValid(e.X, x) || trueImpliesValid(e.Y, x))
+	}
+
+	return false
+}
+
+// falseImpliesValid reports whether x is valid when cond is false.
+func falseImpliesValid(cond ast.Expr, x ast.Expr) bool {
+	switch e := ast.Unparen(cond).(type) {
+	case *ast.CallExpr:
+		fn, ok := e.Fun.(*ast.SelectorExpr)
+
+		return ok && len(e.Args) == 0 && fn.Sel.Name == "IsZero" && sameExpr(fn.X, x)
+	case *ast.UnaryExpr:
+		return e.Op == token.NOT && trueImpliesValid(e.X, x)
+	case *ast.BinaryExpr:
+		return e.Op == token.LOR && (falseImpliesValid(e.X, x) || falseImpliesValid(e.Y, x))
+	}
+
+	return false
+}
+
+// isValidField reports whether e is x.Valid.
+func isValidField(e ast.Expr, x ast.Expr) bool {
+	sel, ok := ast.Unparen(e).(*ast.SelectorExpr)
+
+	return ok && sel.Sel.Name == "Valid" && sameExpr(sel.X, x)
+}
+
+// sameExpr reports whether a and b are the same expression, ignoring parentheses and dereferences.
+func sameExpr(a, b ast.Expr) bool {
+	return types.ExprString(strip(a)) == types.ExprString(strip(b))
+}
+
+func strip(e ast.Expr) ast.Expr {
+	for {
+		switch x := e.(type) {
+		case *ast.ParenExpr:
+			e = x.X
+		case *ast.StarExpr:
+			e = x.X
+		default:
+			return e
+		}
+	}
+}
+
+// suggestFix replaces the read of the field with ValueOr(zero) when the zero value can be written,
+// and with ValueOrZero() otherwise. It returns false when the type has neither method.
+func suggestFix(pass *analysis.Pass, sel *ast.SelectorExpr, field *types.Var, x string, stack []ast.Node) (analysis.SuggestedFix, bool) {
+	var replacement, message string
+
+	methods := types.NewMethodSet(pass.TypesInfo.Selections[sel].Recv())
+
+	if zero, ok := zeroValue(field.Type(), fileOf(stack)); ok && methods.Lookup(field.Pkg(), "ValueOr") != nil {
+		replacement, message = x+".ValueOr("+zero+")", "Use ValueOr"
+	} else if methods.Lookup(field.Pkg(), "ValueOrZero") != nil {
+		replacement, message = x+".ValueOrZero()", "Use ValueOrZero"
+	} else {
+		return analysis.SuggestedFix{}, false
+	}
+
+	return analysis.SuggestedFix{
+		Message: message,
+		TextEdits: []analysis.TextEdit{{
+			Pos:     sel.Pos(),
+			End:     sel.End(),
+			NewText: []byte(replacement),
+		}},
+	}, true
+}
+
+// zeroValue returns the literal of the zero value of t, as written in file.
+func zeroValue(t types.Type, file *ast.File) (string, bool) {
+	switch u := t.(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsString != 0:
+			return `""`, true
+		case u.Info()&types.IsBoolean != 0:
+			return "false", true
+		case u.Info()&types.IsNumeric != 0:
+			return "0", true
+		}
+	case *types.Named:
+		obj := u.Obj()
+		if obj.Pkg() == nil || obj.Pkg().Path() != "time" || obj.Name() != "Time" || file == nil {
+			return "", false
+		}
+
+		for _, imp := range file.Imports {
+			if path, _ := strconv.Unquote(imp.Path.Value); path != "time" {
+				continue
+			}
+
+			name := "time"
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+
+			if name == "_" || name == "." {
+				return "", false
+			}
+
+			return name + ".Time{}", true
+		}
+	}
+
+	return "", false
+}
+
+func fileOf(stack []ast.Node) *ast.File {
+	if len(stack) == 0 {
+		return nil
+	}
+
+	file, _ := stack[0].(*ast.File)
+
+	return file
+}
+
+func render(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+
+	if err := format.Node(&buf, fset, e); err != nil {
+		return types.ExprString(e)
+	}
+
+	return buf.String()
+}
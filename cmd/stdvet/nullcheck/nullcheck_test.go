@@ -0,0 +1,12 @@
+package nullcheck_test
+
+import (
+	"testing"
+
+	"github.com/euskadi31/go-std/cmd/stdvet/nullcheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), nullcheck.Analyzer, "a")
+}
@@ -0,0 +1,130 @@
+package a
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	std "github.com/euskadi31/go-std"
+)
+
+type User struct {
+	Name      std.String
+	Age       std.Int
+	Admin     std.Bool
+	CreatedAt std.Time
+}
+
+func unchecked(u User, i std.Int, p *std.Int) {
+	fmt.Println(u.Name.Data)             // want `u.Name.Data read without checking u.Name.Valid`
+	fmt.Println(i.Data + 1)              // want `i.Data read without checking i.Valid`
+	fmt.Println(p.Data)                  // want `p.Data read without checking p.Valid`
+	fmt.Println(u.Admin.Data)            // want `u.Admin.Data read without checking u.Admin.Valid`
+	fmt.Println(u.CreatedAt.Time.Year()) // want `u.CreatedAt.Time read without checking u.CreatedAt.Valid`
+
+	if u.Age.Valid {
+		fmt.Println(i.Data) // want `i.Data read without checking i.Valid`
+	}
+
+	if !i.Valid {
+		fmt.Println(i.Data) // want `i.Data read without checking i.Valid`
+	}
+
+	if i.Valid || i.Data > 0 { // want `i.Data read without checking i.Valid`
+		return
+	}
+}
+
+func guarded(u User, i std.Int, p *std.Int) {
+	if u.Name.Valid {
+		fmt.Println(u.Name.Data)
+	}
+
+	if !i.IsZero() && i.Data > 0 {
+		fmt.Println(i.Data)
+	}
+
+	if i.IsZero() || i.Data > 0 {
+		return
+	}
+
+	if !u.CreatedAt.Valid {
+		fmt.Println("null")
+	} else {
+		fmt.Println(u.CreatedAt.Time)
+	}
+
+	if (*p).Valid {
+		fmt.Println(p.Data)
+	}
+
+	switch {
+	case u.Age.Valid:
+		fmt.Println(u.Age.Data)
+	}
+
+	ok := u.Admin.Valid && u.Admin.Data
+	fmt.Println(ok)
+}
+
+func early(u User, i std.Int) {
+	if !u.Name.Valid {
+		return
+	}
+
+	fmt.Println(u.Name.Data)
+
+	for {
+		if i.IsZero() || i.Data < 0 {
+			break
+		}
+
+		fmt.Println(i.Data)
+	}
+}
+
+func fatal(i std.Int) {
+	if !i.Valid {
+		log.Fatal("null")
+	}
+
+	fmt.Println(i.Data)
+}
+
+func defaulted(i std.Int) {
+	if !i.Valid {
+		i.SetValid(42)
+	}
+
+	fmt.Println(i.Data)
+}
+
+func writes(i *std.Int, t *std.Time, s std.Strings, u std.URL, dest []interface{}) {
+	i.Data = 1
+	i.Data++
+	t.Time = time.Now()
+	dest = append(dest, &i.Data)
+	s.Data[0] = "a"
+	s.Data[0] += "b"
+	(s.Data)[1] = "c"
+	u.Data.Path = "/"
+	(*u.Data).RawQuery = "q"
+	dest = append(dest, &u.Data.Host, &s.Data[0])
+	fmt.Println(dest)
+}
+
+func withoutFix(s std.Strings, u std.URL) {
+	fmt.Println(s.Data[0])      // want `s.Data read without checking s.Valid`
+	fmt.Println(u.Data.Host)    // want `u.Data read without checking u.Valid`
+	s.Data[len(s.Data)-1] = "a" // want `s.Data read without checking s.Valid`
+}
+
+func closure(i std.Int) func() int64 {
+	if !i.Valid {
+		return nil
+	}
+
+	return func() int64 {
+		return i.Data
+	}
+}
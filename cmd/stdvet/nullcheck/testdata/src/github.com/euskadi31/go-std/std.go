@@ -0,0 +1,53 @@
+// Package std is a stub of the std package, with the fields and methods used by the analyzer tests.
+package std
+
+import (
+	"net/url"
+	"time"
+)
+
+type Int struct {
+	Data  int64
+	Valid bool
+}
+
+func (i Int) IsZero() bool                 { return !i.Valid }
+func (i Int) ValueOr(def int64) int64      { return def }
+func (i Int) ValueOrZero() int64           { return 0 }
+func (i *Int) SetValid(n int64)            { i.Data, i.Valid = n, true }
+func (i Int) Compare(o Int, nulls int) int { return 0 }
+
+type String struct {
+	Data  string
+	Valid bool
+}
+
+func (s String) IsZero() bool              { return !s.Valid }
+func (s String) ValueOr(def string) string { return def }
+func (s String) ValueOrZero() string       { return "" }
+
+type Bool struct {
+	Data  bool
+	Valid bool
+}
+
+func (b Bool) ValueOr(def bool) bool { return def }
+
+type Time struct {
+	Time  time.Time
+	Valid bool
+}
+
+func (t Time) IsZero() bool                    { return !t.Valid }
+func (t Time) ValueOr(def time.Time) time.Time { return def }
+func (t Time) ValueOrZero() time.Time          { return time.Time{} }
+
+type Strings struct {
+	Data  []string
+	Valid bool
+}
+
+type URL struct {
+	Data  *url.URL
+	Valid bool
+}
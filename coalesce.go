@@ -0,0 +1,165 @@
+package std
+
+import (
+	"time"
+)
+
+// CoalesceBool returns the first non-null Bool, or a null Bool if all values are null.
+func CoalesceBool(values ...Bool) Bool {
+	for _, v := range values {
+		if v.Valid {
+			return v
+		}
+	}
+
+	return Bool{}
+}
+
+// NullIfBool returns a null Bool if v is equal to sentinel, otherwise it returns v.
+func NullIfBool(v Bool, sentinel bool) Bool {
+	if v.Valid && v.Data == sentinel {
+		return Bool{}
+	}
+
+	return v
+}
+
+// CoalesceInt returns the first non-null Int, or a null Int if all values are null.
+func CoalesceInt(values ...Int) Int {
+	for _, v := range values {
+		if v.Valid {
+			return v
+		}
+	}
+
+	return Int{}
+}
+
+// NullIfInt returns a null Int if v is equal to sentinel, otherwise it returns v.
+func NullIfInt(v Int, sentinel int64) Int {
+	if v.Valid && v.Data == sentinel {
+		return Int{}
+	}
+
+	return v
+}
+
+// CoalesceUint returns the first non-null Uint, or a null Uint if all values are null.
+func CoalesceUint(values ...Uint) Uint {
+	for _, v := range values {
+		if v.Valid {
+			return v
+		}
+	}
+
+	return Uint{}
+}
+
+// NullIfUint returns a null Uint if v is equal to sentinel, otherwise it returns v.
+func NullIfUint(v Uint, sentinel uint64) Uint {
+	if v.Valid && v.Data == sentinel {
+		return Uint{}
+	}
+
+	return v
+}
+
+// CoalesceFloat returns the first non-null Float, or a null Float if all values are null.
+func CoalesceFloat(values ...Float) Float {
+	for _, v := range values {
+		if v.Valid {
+			return v
+		}
+	}
+
+	return Float{}
+}
+
+// NullIfFloat returns a null Float if v is equal to sentinel, otherwise it returns v.
+func NullIfFloat(v Float, sentinel float64) Float {
+	if v.Valid && v.Data == sentinel {
+		return Float{}
+	}
+
+	return v
+}
+
+// CoalesceString returns the first non-null String, or a null String if all values are null.
+func CoalesceString(values ...String) String {
+	for _, v := range values {
+		if v.Valid {
+			return v
+		}
+	}
+
+	return String{}
+}
+
+// NullIfString returns a null String if v is equal to sentinel, otherwise it returns v.
+func NullIfString(v String, sentinel string) String {
+	if v.Valid && v.Data == sentinel {
+		return String{}
+	}
+
+	return v
+}
+
+// CoalesceTime returns the first non-null Time, or a null Time if all values are null.
+func CoalesceTime(values ...Time) Time {
+	for _, v := range values {
+		if v.Valid {
+			return v
+		}
+	}
+
+	return Time{}
+}
+
+// NullIfTime returns a null Time if v is equal to sentinel, otherwise it returns v.
+func NullIfTime(v Time, sentinel time.Time) Time {
+	if v.Valid && v.Time.Equal(sentinel) {
+		return Time{}
+	}
+
+	return v
+}
+
+// CoalesceDateTime returns the first non-null DateTime, or a null DateTime if all values are null.
+func CoalesceDateTime(values ...DateTime) DateTime {
+	for _, v := range values {
+		if v.Valid {
+			return v
+		}
+	}
+
+	return DateTime{}
+}
+
+// NullIfDateTime returns a null DateTime if v is equal to sentinel, otherwise it returns v.
+func NullIfDateTime(v DateTime, sentinel time.Time) DateTime {
+	if v.Valid && v.Data.Equal(sentinel) {
+		return DateTime{}
+	}
+
+	return v
+}
+
+// CoalesceDate returns the first non-null Date, or a null Date if all values are null.
+func CoalesceDate(values ...Date) Date {
+	for _, v := range values {
+		if v.Valid {
+			return v
+		}
+	}
+
+	return Date{}
+}
+
+// NullIfDate returns a null Date if v is equal to sentinel, otherwise it returns v.
+func NullIfDate(v Date, sentinel time.Time) Date {
+	if v.Valid && v.Data.Equal(sentinel) {
+		return Date{}
+	}
+
+	return v
+}
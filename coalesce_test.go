@@ -0,0 +1,92 @@
+package std
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalesceBool(t *testing.T) {
+	assert.Equal(t, BoolFrom(false), CoalesceBool(Bool{}, BoolFrom(false), BoolFrom(true)))
+	assert.False(t, CoalesceBool(Bool{}, Bool{}).Valid)
+	assert.False(t, CoalesceBool().Valid)
+}
+
+func TestNullIfBool(t *testing.T) {
+	assert.False(t, NullIfBool(BoolFrom(false), false).Valid)
+	assert.Equal(t, BoolFrom(true), NullIfBool(BoolFrom(true), false))
+	assert.False(t, NullIfBool(Bool{}, false).Valid)
+}
+
+func TestCoalesceInt(t *testing.T) {
+	assert.Equal(t, IntFrom(0), CoalesceInt(Int{}, IntFrom(0), IntFrom(1)))
+	assert.False(t, CoalesceInt(Int{}, Int{}).Valid)
+}
+
+func TestNullIfInt(t *testing.T) {
+	assert.False(t, NullIfInt(IntFrom(-1), -1).Valid)
+	assert.Equal(t, IntFrom(12345), NullIfInt(IntFrom(12345), -1))
+}
+
+func TestCoalesceUint(t *testing.T) {
+	assert.Equal(t, UintFrom(1), CoalesceUint(Uint{}, UintFrom(1)))
+	assert.False(t, CoalesceUint(Uint{}).Valid)
+}
+
+func TestNullIfUint(t *testing.T) {
+	assert.False(t, NullIfUint(UintFrom(0), 0).Valid)
+	assert.Equal(t, UintFrom(12345), NullIfUint(UintFrom(12345), 0))
+}
+
+func TestCoalesceFloat(t *testing.T) {
+	assert.Equal(t, FloatFrom(1.5), CoalesceFloat(Float{}, FloatFrom(1.5)))
+	assert.False(t, CoalesceFloat(Float{}).Valid)
+}
+
+func TestNullIfFloat(t *testing.T) {
+	assert.False(t, NullIfFloat(FloatFrom(0), 0).Valid)
+	assert.Equal(t, FloatFrom(1.2345), NullIfFloat(FloatFrom(1.2345), 0))
+}
+
+func TestCoalesceString(t *testing.T) {
+	assert.Equal(t, StringFrom(""), CoalesceString(String{}, StringFrom(""), StringFrom("test")))
+	assert.False(t, CoalesceString(String{}).Valid)
+}
+
+func TestNullIfString(t *testing.T) {
+	assert.False(t, NullIfString(StringFrom(""), "").Valid)
+	assert.Equal(t, StringFrom("test"), NullIfString(StringFrom("test"), ""))
+}
+
+func TestCoalesceTime(t *testing.T) {
+	assert.Equal(t, TimeFrom(timeValue), CoalesceTime(Time{}, TimeFrom(timeValue)))
+	assert.False(t, CoalesceTime(Time{}).Valid)
+}
+
+func TestNullIfTime(t *testing.T) {
+	paris := time.FixedZone("Europe/Paris", 3600)
+
+	assert.False(t, NullIfTime(TimeFrom(timeValue.In(paris)), timeValue).Valid)
+	assert.Equal(t, TimeFrom(timeValue), NullIfTime(TimeFrom(timeValue), time.Time{}))
+}
+
+func TestCoalesceDateTime(t *testing.T) {
+	assert.Equal(t, DateTimeFrom(dateTimeValue), CoalesceDateTime(DateTime{}, DateTimeFrom(dateTimeValue)))
+	assert.False(t, CoalesceDateTime(DateTime{}).Valid)
+}
+
+func TestNullIfDateTime(t *testing.T) {
+	assert.False(t, NullIfDateTime(DateTimeFrom(dateTimeValue), dateTimeValue).Valid)
+	assert.Equal(t, DateTimeFrom(dateTimeValue), NullIfDateTime(DateTimeFrom(dateTimeValue), time.Time{}))
+}
+
+func TestCoalesceDate(t *testing.T) {
+	assert.Equal(t, DateFrom(dateValue), CoalesceDate(Date{}, DateFrom(dateValue)))
+	assert.False(t, CoalesceDate(Date{}).Valid)
+}
+
+func TestNullIfDate(t *testing.T) {
+	assert.False(t, NullIfDate(DateFrom(dateValue), dateValue).Valid)
+	assert.Equal(t, DateFrom(dateValue), NullIfDate(DateFrom(dateValue), time.Time{}))
+}
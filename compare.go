@@ -0,0 +1,163 @@
+package std
+
+import (
+	"sort"
+	"time"
+)
+
+// NullOrder defines where null values are placed by Compare.
+type NullOrder int
+
+const (
+	// NullsFirst orders null values before any valid value, like NULLS FIRST.
+	NullsFirst NullOrder = iota
+
+	// NullsLast orders null values after any valid value, like NULLS LAST.
+	NullsLast
+)
+
+// compareNull compares the validity of two values.
+// It returns false if both values are valid and must be compared by value.
+func compareNull(aValid, bValid bool, nulls NullOrder) (int, bool) {
+	switch {
+	case aValid && bValid:
+		return 0, false
+	case !aValid && !bValid:
+		return 0, true
+	case !aValid:
+		if nulls == NullsLast {
+			return 1, true
+		}
+
+		return -1, true
+	default:
+		if nulls == NullsLast {
+			return -1, true
+		}
+
+		return 1, true
+	}
+}
+
+// CompareBoolFunc returns a comparison function for Bool, suitable for slices.SortFunc.
+func CompareBoolFunc(nulls NullOrder) func(a, b Bool) int {
+	return func(a, b Bool) int {
+		return a.Compare(b, nulls)
+	}
+}
+
+// SortBools sorts a slice of Bool in increasing order.
+func SortBools(values []Bool, nulls NullOrder) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Compare(values[j], nulls) < 0
+	})
+}
+
+// CompareFloatFunc returns a comparison function for Float, suitable for slices.SortFunc.
+func CompareFloatFunc(nulls NullOrder) func(a, b Float) int {
+	return func(a, b Float) int {
+		return a.Compare(b, nulls)
+	}
+}
+
+// SortFloats sorts a slice of Float in increasing order.
+func SortFloats(values []Float, nulls NullOrder) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Compare(values[j], nulls) < 0
+	})
+}
+
+// CompareIntFunc returns a comparison function for Int, suitable for slices.SortFunc.
+func CompareIntFunc(nulls NullOrder) func(a, b Int) int {
+	return func(a, b Int) int {
+		return a.Compare(b, nulls)
+	}
+}
+
+// SortInts sorts a slice of Int in increasing order.
+func SortInts(values []Int, nulls NullOrder) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Compare(values[j], nulls) < 0
+	})
+}
+
+// CompareUintFunc returns a comparison function for Uint, suitable for slices.SortFunc.
+func CompareUintFunc(nulls NullOrder) func(a, b Uint) int {
+	return func(a, b Uint) int {
+		return a.Compare(b, nulls)
+	}
+}
+
+// SortUints sorts a slice of Uint in increasing order.
+func SortUints(values []Uint, nulls NullOrder) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Compare(values[j], nulls) < 0
+	})
+}
+
+// CompareStringFunc returns a comparison function for String, suitable for slices.SortFunc.
+func CompareStringFunc(nulls NullOrder) func(a, b String) int {
+	return func(a, b String) int {
+		return a.Compare(b, nulls)
+	}
+}
+
+// SortStrings sorts a slice of String in increasing order.
+func SortStrings(values []String, nulls NullOrder) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Compare(values[j], nulls) < 0
+	})
+}
+
+// CompareTimeFunc returns a comparison function for Time, suitable for slices.SortFunc.
+func CompareTimeFunc(nulls NullOrder) func(a, b Time) int {
+	return func(a, b Time) int {
+		return a.Compare(b, nulls)
+	}
+}
+
+// SortTimes sorts a slice of Time in increasing order.
+func SortTimes(values []Time, nulls NullOrder) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Compare(values[j], nulls) < 0
+	})
+}
+
+// CompareDateTimeFunc returns a comparison function for DateTime, suitable for slices.SortFunc.
+func CompareDateTimeFunc(nulls NullOrder) func(a, b DateTime) int {
+	return func(a, b DateTime) int {
+		return a.Compare(b, nulls)
+	}
+}
+
+// SortDateTimes sorts a slice of DateTime in increasing order.
+func SortDateTimes(values []DateTime, nulls NullOrder) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Compare(values[j], nulls) < 0
+	})
+}
+
+// CompareDateFunc returns a comparison function for Date, suitable for slices.SortFunc.
+func CompareDateFunc(nulls NullOrder) func(a, b Date) int {
+	return func(a, b Date) int {
+		return a.Compare(b, nulls)
+	}
+}
+
+// SortDates sorts a slice of Date in increasing order.
+func SortDates(values []Date, nulls NullOrder) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Compare(values[j], nulls) < 0
+	})
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,120 @@
+package std
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareNull(t *testing.T) {
+	c, ok := compareNull(true, true, NullsFirst)
+	assert.False(t, ok)
+	assert.Equal(t, 0, c)
+
+	c, ok = compareNull(false, false, NullsLast)
+	assert.True(t, ok)
+	assert.Equal(t, 0, c)
+
+	c, ok = compareNull(false, true, NullsFirst)
+	assert.True(t, ok)
+	assert.Equal(t, -1, c)
+
+	c, ok = compareNull(false, true, NullsLast)
+	assert.True(t, ok)
+	assert.Equal(t, 1, c)
+
+	c, ok = compareNull(true, false, NullsFirst)
+	assert.True(t, ok)
+	assert.Equal(t, 1, c)
+
+	c, ok = compareNull(true, false, NullsLast)
+	assert.True(t, ok)
+	assert.Equal(t, -1, c)
+}
+
+func TestSortInts(t *testing.T) {
+	values := []Int{IntFrom(3), {}, IntFrom(1), IntFrom(2)}
+
+	SortInts(values, NullsFirst)
+	assert.Equal(t, []Int{{}, IntFrom(1), IntFrom(2), IntFrom(3)}, values)
+
+	SortInts(values, NullsLast)
+	assert.Equal(t, []Int{IntFrom(1), IntFrom(2), IntFrom(3), {}}, values)
+
+	cmp := CompareIntFunc(NullsLast)
+	assert.Equal(t, -1, cmp(IntFrom(1), Int{}))
+}
+
+func TestSortUints(t *testing.T) {
+	values := []Uint{UintFrom(3), {}, UintFrom(1)}
+
+	SortUints(values, NullsLast)
+	assert.Equal(t, []Uint{UintFrom(1), UintFrom(3), {}}, values)
+
+	cmp := CompareUintFunc(NullsFirst)
+	assert.Equal(t, 1, cmp(UintFrom(1), Uint{}))
+}
+
+func TestSortFloats(t *testing.T) {
+	values := []Float{FloatFrom(3.5), {}, FloatFrom(-1)}
+
+	SortFloats(values, NullsFirst)
+	assert.Equal(t, []Float{{}, FloatFrom(-1), FloatFrom(3.5)}, values)
+
+	cmp := CompareFloatFunc(NullsFirst)
+	assert.Equal(t, 1, cmp(FloatFrom(2), FloatFrom(1)))
+}
+
+func TestSortBools(t *testing.T) {
+	values := []Bool{BoolFrom(true), {}, BoolFrom(false)}
+
+	SortBools(values, NullsLast)
+	assert.Equal(t, []Bool{BoolFrom(false), BoolFrom(true), {}}, values)
+
+	cmp := CompareBoolFunc(NullsLast)
+	assert.Equal(t, 0, cmp(Bool{}, Bool{}))
+}
+
+func TestSortStrings(t *testing.T) {
+	values := []String{StringFrom("b"), {}, StringFrom("a")}
+
+	SortStrings(values, NullsFirst)
+	assert.Equal(t, []String{{}, StringFrom("a"), StringFrom("b")}, values)
+
+	cmp := CompareStringFunc(NullsFirst)
+	assert.Equal(t, -1, cmp(StringFrom("a"), StringFrom("b")))
+}
+
+func TestSortTimes(t *testing.T) {
+	before := timeValue.Add(-time.Hour)
+	values := []Time{TimeFrom(timeValue), {}, TimeFrom(before)}
+
+	SortTimes(values, NullsLast)
+	assert.Equal(t, []Time{TimeFrom(before), TimeFrom(timeValue), {}}, values)
+
+	cmp := CompareTimeFunc(NullsLast)
+	assert.Equal(t, 0, cmp(TimeFrom(timeValue), TimeFrom(timeValue.In(time.FixedZone("", 3600)))))
+}
+
+func TestSortDateTimes(t *testing.T) {
+	before := dateTimeValue.Add(-time.Hour)
+	values := []DateTime{DateTimeFrom(dateTimeValue), {}, DateTimeFrom(before)}
+
+	SortDateTimes(values, NullsFirst)
+	assert.Equal(t, []DateTime{{}, DateTimeFrom(before), DateTimeFrom(dateTimeValue)}, values)
+
+	cmp := CompareDateTimeFunc(NullsFirst)
+	assert.Equal(t, 1, cmp(DateTimeFrom(dateTimeValue), DateTimeFrom(before)))
+}
+
+func TestSortDates(t *testing.T) {
+	before := dateValue.AddDate(0, 0, -1)
+	values := []Date{DateFrom(dateValue), {}, DateFrom(before)}
+
+	SortDates(values, NullsLast)
+	assert.Equal(t, []Date{DateFrom(before), DateFrom(dateValue), {}}, values)
+
+	cmp := CompareDateFunc(NullsLast)
+	assert.Equal(t, -1, cmp(DateFrom(before), DateFrom(dateValue)))
+}
@@ -0,0 +1,32 @@
+package std
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrLossyConversion is returned when a value cannot be converted to another type without loss.
+var ErrLossyConversion = errors.New("std: lossy conversion")
+
+func lossyErr(v interface{}, to string) error {
+	return fmt.Errorf("%w: cannot convert %v to %s", ErrLossyConversion, v, to)
+}
+
+// floatToInt converts f to an int64, it fails if f is not a whole number in the int64 range.
+func floatToInt(f float64) (int64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, lossyErr(f, "int64")
+	}
+
+	return int64(f), nil
+}
+
+// floatToUint converts f to an uint64, it fails if f is not a whole number in the uint64 range.
+func floatToUint(f float64) (uint64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+		return 0, lossyErr(f, "uint64")
+	}
+
+	return uint64(f), nil
+}
@@ -11,11 +11,13 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 )
 
 var errNilPtr = errors.New("destination pointer is nil") // embedded in descriptive error
 
 // convertAssign copies to dest the value in src, converting it if possible.
+// It follows the conversion matrix of database/sql.
 // An error is returned if the copy would result in loss of information.
 // dest should be a pointer type.
 // nolint: gocyclo
@@ -32,13 +34,38 @@ func convertAssign(dest, src interface{}) error {
 		if d, ok := dest.(*string); ok {
 			*d = string(s)
 
+			return nil
+		}
+	case time.Time:
+		switch d := dest.(type) {
+		case *time.Time:
+			*d = s
+
+			return nil
+		case *string:
+			*d = s.Format(time.RFC3339Nano)
+
 			return nil
 		}
 	}
 
 	var sv reflect.Value
 
-	if d, ok := dest.(*bool); ok {
+	switch d := dest.(type) {
+	case *string:
+		sv = reflect.ValueOf(src)
+
+		// nolint: exhaustive
+		switch sv.Kind() {
+		case reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			*d = asString(src)
+
+			return nil
+		}
+	case *bool:
 		bv, err := driver.Bool.ConvertValue(src)
 		if err == nil {
 			*d = bv.(bool) // nolint: forcetypeassert
@@ -70,39 +70,58 @@ func DateFromPtr(t *time.Time) Date {
 
 // MarshalText implement the json.Marshaler interface.
 func (t Date) MarshalText() ([]byte, error) {
+	return t.AppendText(make([]byte, 0, len(dateFormat)))
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if this Date is null.
+func (t Date) AppendText(b []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte{}, nil
+		return b, nil
 	}
 
-	return []byte(t.Data.Format(dateFormat)), nil
+	return t.Data.AppendFormat(b, dateFormat), nil
 }
 
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this time is null.
 func (t Date) MarshalJSON() ([]byte, error) {
-	if !t.Valid {
-		return nullType, nil
-	}
-
-	b, _ := t.MarshalText()
+	return t.AppendJSON(make([]byte, 0, len(dateFormat)+2))
+}
 
-	if len(b) == 0 {
-		return nullType, nil
+// AppendJSON appends the JSON encoding of this Date to b, null if this Date is null.
+func (t Date) AppendJSON(b []byte) ([]byte, error) {
+	if !t.Valid {
+		return append(b, nullType...), nil
 	}
 
-	dt := []byte{}
-	dt = append(dt, 0x22) // 0x22 => "
-	dt = append(dt, b...)
-	dt = append(dt, 0x22) // 0x22 => "
+	b = append(b, '"')
+	b = t.Data.AppendFormat(b, dateFormat)
 
-	return dt, nil
+	return append(b, '"'), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string, object (e.g. pq.NullTime and friends)
+// It supports string, object (e.g. sql.NullTime and friends)
 // and null input.
 func (t *Date) UnmarshalJSON(b []byte) error {
-	if b[0] == '"' && b[len(b)-1] == '"' {
+	if len(b) > 0 && b[0] == '{' {
+		var (
+			err   error
+			value time.Time
+		)
+
+		t.Data = time.Time{}
+		t.Valid, err = unmarshalNullObject(b, "Time", "std.Date", &value)
+
+		if t.Valid {
+			t.Data = value
+		}
+
+		return err
+	}
+
+	if len(b) > 1 && b[0] == '"' && b[len(b)-1] == '"' {
 		b = b[1 : len(b)-1]
 	}
 
@@ -162,3 +181,111 @@ func (t Date) String() string {
 
 	return t.Data.Format(dateFormat)
 }
+
+// Equal reports whether t and o are both null or both valid with the same time instant.
+func (t Date) Equal(o Date) bool {
+	return t.Valid == o.Valid && (!t.Valid || t.Data.Equal(o.Data))
+}
+
+// Compare returns -1 if t is before o, 0 if they are the same time instant and +1 if t is after o.
+// Null values are ordered according to nulls.
+func (t Date) Compare(o Date, nulls NullOrder) int {
+	if c, ok := compareNull(t.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareTime(t.Data, o.Data)
+}
+
+// ValueOr returns this Date's value, or def if this Date is null.
+func (t Date) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+
+	return t.Data
+}
+
+// ValueOrZero returns this Date's value, or the zero value of time.Time if this Date is null.
+func (t Date) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Data
+}
+
+// OrElse returns this Date's value, or the result of fn if this Date is null.
+// fn is only called when this Date is null.
+func (t Date) OrElse(fn func() time.Time) time.Time {
+	if !t.Valid {
+		return fn()
+	}
+
+	return t.Data
+}
+
+// Get returns this Date's value and true, or the zero value of time.Time and false if this Date is null.
+func (t Date) Get() (time.Time, bool) {
+	return t.ValueOrZero(), t.Valid
+}
+
+// MustGet returns this Date's value, it panics if this Date is null.
+func (t Date) MustGet() time.Time {
+	if !t.Valid {
+		panic("std: MustGet called on a null Date")
+	}
+
+	return t.Data
+}
+
+// Map returns a Date holding the result of fn applied to this Date's value,
+// or a null Date if this Date is null. fn is only called when this Date is valid.
+func (t Date) Map(fn func(time.Time) time.Time) Date {
+	if !t.Valid {
+		return Date{}
+	}
+
+	return NewDate(fn(t.Data), true)
+}
+
+// FlatMap returns the result of fn applied to this Date's value,
+// or a null Date if this Date is null. fn is only called when this Date is valid.
+func (t Date) FlatMap(fn func(time.Time) Date) Date {
+	if !t.Valid {
+		return Date{}
+	}
+
+	return fn(t.Data)
+}
+
+// Filter returns this Date if it is valid and its value satisfies fn,
+// otherwise it returns a null Date.
+func (t Date) Filter(fn func(time.Time) bool) Date {
+	if !t.Valid || !fn(t.Data) {
+		return Date{}
+	}
+
+	return t
+}
+
+// ToTime converts this Date to a Time holding the same instant.
+func (t Date) ToTime() Time {
+	return NewTime(t.Data, t.Valid)
+}
+
+// ToDateTime converts this Date to a DateTime at midnight of the same day in loc.
+func (t Date) ToDateTime(loc *time.Location) DateTime {
+	if !t.Valid {
+		return DateTime{}
+	}
+
+	return NewDateTime(truncateDay(t.Data, loc), true)
+}
+
+// truncateDay returns midnight of the day of t, in loc.
+func truncateDay(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
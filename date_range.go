@@ -0,0 +1,345 @@
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+var dateRangeKind = &rangeKind{
+	name:    "DateRange",
+	compare: compareRangeTimes,
+	next: func(v interface{}) interface{} {
+		return v.(time.Time).AddDate(0, 0, 1) // nolint: forcetypeassert
+	},
+	parse: func(s string) (interface{}, error) {
+		if isRangeInfinity(s) {
+			return nil, nil
+		}
+
+		return time.Parse(dateFormat, s)
+	},
+	format: func(b []byte, v interface{}) []byte {
+		return v.(time.Time).AppendFormat(b, dateFormat) // nolint: forcetypeassert
+	},
+}
+
+// dateOnly returns the date of t, at midnight UTC.
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// compareRangeTimes compares the time.Time values a and b.
+func compareRangeTimes(a, b interface{}) int {
+	x, y := a.(time.Time), b.(time.Time) // nolint: forcetypeassert
+
+	switch {
+	case x.Before(y):
+		return -1
+	case x.After(y):
+		return 1
+	}
+
+	return 0
+}
+
+// isRangeInfinity reports whether s is the infinity or -infinity bound of a date or timestamp range.
+func isRangeInfinity(s string) bool {
+	return strings.EqualFold(s, "infinity") || strings.EqualFold(s, "-infinity")
+}
+
+// DateRange is a nullable range of dates, mapping to a Postgres daterange column.
+// A null bound is infinite, and Empty is true for the empty range.
+// The ranges are canonical, like Postgres does for discrete types:
+// a finite lower bound is inclusive and a finite upper bound exclusive.
+// It scans from and writes the range literal syntax ([1,5), (,5], empty),
+// and marshals to a JSON object ({"lower":..,"upper":..,"lower_inc":..,"upper_inc":..} or {"empty":true}) or null.
+type DateRange struct {
+	Lower    Date
+	Upper    Date
+	LowerInc bool
+	UpperInc bool
+	Empty    bool
+	Valid    bool
+}
+
+// NewDateRange creates a new valid DateRange, with the bounds made canonical.
+func NewDateRange(lower, upper Date, lowerInc, upperInc bool) DateRange {
+	return DateRange{Lower: lower, Upper: upper, LowerInc: lowerInc, UpperInc: upperInc, Valid: true}.canonical()
+}
+
+// EmptyDateRange creates a new valid empty DateRange.
+func EmptyDateRange() DateRange {
+	return DateRange{Empty: true, Valid: true}
+}
+
+// ParseDateRange parses a range literal like [1,5) into a valid DateRange.
+func ParseDateRange(s string) (DateRange, error) {
+	r, err := dateRangeKind.parseRange([]byte(s))
+	if err != nil {
+		return DateRange{}, err
+	}
+
+	return dateRangeFromSpan(r), nil
+}
+
+func dateRangeFromSpan(s span) DateRange {
+	r := DateRange{LowerInc: s.lowerInc, UpperInc: s.upperInc, Empty: s.empty, Valid: true}
+
+	if v := s.lower; v != nil {
+		r.Lower = NewDate(v.(time.Time), true)
+	}
+
+	if v := s.upper; v != nil {
+		r.Upper = NewDate(v.(time.Time), true)
+	}
+
+	return r
+}
+
+func (r DateRange) span() span {
+	s := span{lowerInc: r.LowerInc, upperInc: r.UpperInc, empty: r.Empty}
+
+	if r.Lower.Valid {
+		s.lower = dateOnly(r.Lower.Data)
+	}
+
+	if r.Upper.Valid {
+		s.upper = dateOnly(r.Upper.Data)
+	}
+
+	return s
+}
+
+func (r DateRange) canonical() DateRange {
+	return dateRangeFromSpan(dateRangeKind.canonical(r.span()))
+}
+
+// Scan implements the Scanner interface.
+func (r *DateRange) Scan(value interface{}) error {
+	s, valid, err := dateRangeKind.scanRange(value)
+	if err != nil || !valid {
+		*r = DateRange{}
+
+		return err
+	}
+
+	*r = dateRangeFromSpan(s)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the range literal, or nil if this DateRange is null.
+func (r DateRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+
+	return string(dateRangeKind.appendRange(nil, r.span())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports object and null input, missing or null bounds are infinite.
+func (r *DateRange) UnmarshalJSON(data []byte) error {
+	obj, valid, err := dateRangeKind.unmarshalRangeJSON(data)
+	if err != nil || !valid {
+		*r = DateRange{}
+
+		return err
+	}
+
+	if obj.Empty {
+		*r = EmptyDateRange()
+
+		return nil
+	}
+
+	var lower, upper Date
+
+	if err := unmarshalRangeBound(obj.Lower, &lower); err != nil {
+		*r = DateRange{}
+
+		return err
+	}
+
+	if err := unmarshalRangeBound(obj.Upper, &upper); err != nil {
+		*r = DateRange{}
+
+		return err
+	}
+
+	*r = NewDateRange(lower, upper, obj.LowerInc, obj.UpperInc)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this DateRange is null.
+func (r DateRange) MarshalJSON() ([]byte, error) {
+	return r.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this DateRange to b, null if this DateRange is null.
+func (r DateRange) AppendJSON(b []byte) ([]byte, error) {
+	if !r.Valid {
+		return append(b, nullType...), nil
+	}
+
+	lower, err := r.Lower.MarshalJSON()
+	if err != nil {
+		return b, err
+	}
+
+	upper, err := r.Upper.MarshalJSON()
+	if err != nil {
+		return b, err
+	}
+
+	return appendRangeJSON(b, r.Empty, lower, upper, r.LowerInc, r.UpperInc), nil
+}
+
+// IsZero returns true for null DateRange, for potential future omitempty support.
+func (r DateRange) IsZero() bool {
+	return !r.Valid
+}
+
+// String implements fmt.Stringer interface.
+// It returns the range literal, or a blank string if this DateRange is null.
+func (r DateRange) String() string {
+	if !r.Valid {
+		return ""
+	}
+
+	return string(dateRangeKind.appendRange(nil, r.span()))
+}
+
+// Equal reports whether r and o are both null or both valid with the same values.
+func (r DateRange) Equal(o DateRange) bool {
+	return r.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if r is less than o, 0 if they are equal and +1 if r is greater than o,
+// like Postgres does: the empty range first, then by lower bound and by upper bound.
+// Null values are ordered according to nulls.
+func (r DateRange) Compare(o DateRange, nulls NullOrder) int {
+	if c, ok := compareNull(r.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return dateRangeKind.compareRanges(r.span(), o.span())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the range literal, or a blank string when this DateRange is null.
+func (r DateRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses a range literal, a blank string is a null DateRange.
+func (r *DateRange) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = DateRange{}
+
+		return nil
+	}
+
+	return r.Scan(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this DateRange is null, the range literal otherwise.
+func (r DateRange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, r.Valid, r.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null DateRange, an empty element is a null DateRange.
+func (r *DateRange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, r)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this DateRange is null.
+func (r DateRange) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, r.Valid, r.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (r *DateRange) UnmarshalXMLAttr(attr xml.Attr) error {
+	return r.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r DateRange) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(r.Valid, r.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *DateRange) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.DateRange", r)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (r DateRange) GobEncode() ([]byte, error) {
+	return r.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (r *DateRange) GobDecode(data []byte) error {
+	return r.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this DateRange is null, the range literal otherwise.
+func (r DateRange) MarshalYAML() (interface{}, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+
+	return r.String(), nil
+}
+
+// unmarshalYAML decodes this DateRange with the unmarshal func of a YAML library.
+func (r *DateRange) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, "std.DateRange", r)
+}
+
+// Contains reports whether v is in this DateRange, it is false if this DateRange is null.
+func (r DateRange) Contains(v time.Time) bool {
+	return r.Valid && dateRangeKind.contains(r.span(), dateOnly(v))
+}
+
+// Overlaps reports whether r and o have values in common, like the && operator.
+// It is false if one of them is null.
+func (r DateRange) Overlaps(o DateRange) bool {
+	return r.Valid && o.Valid && dateRangeKind.overlaps(r.span(), o.span())
+}
+
+// Intersect returns the values in both r and o, like the * operator.
+// It is null if one of them is null, and empty if they do not overlap.
+func (r DateRange) Intersect(o DateRange) DateRange {
+	if !r.Valid || !o.Valid {
+		return DateRange{}
+	}
+
+	return dateRangeFromSpan(dateRangeKind.intersect(r.span(), o.span()))
+}
+
+// Union returns the values in r or o, like the + operator.
+// It is null if one of them is null, and returns ErrRangeNotContiguous
+// if the ranges neither overlap nor are adjacent.
+func (r DateRange) Union(o DateRange) (DateRange, error) {
+	if !r.Valid || !o.Valid {
+		return DateRange{}, nil
+	}
+
+	s, err := dateRangeKind.union(r.span(), o.span())
+	if err != nil {
+		return DateRange{}, err
+	}
+
+	return dateRangeFromSpan(s), nil
+}
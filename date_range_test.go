@@ -0,0 +1,83 @@
+package std
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateRange(t *testing.T) {
+	r, err := ParseDateRange(`[2020-01-01,2020-01-31]`)
+	assert.NoError(t, err)
+	assert.Equal(t, `[2020-01-01,2020-02-01)`, r.String())
+
+	assert.True(t, r.Contains(time.Date(2020, 1, 31, 23, 0, 0, 0, time.UTC)))
+	assert.False(t, r.Contains(time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, r.Contains(time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)))
+
+	var s DateRange
+
+	assert.NoError(t, s.Scan([]byte(`(2020-01-01,infinity)`)))
+	assert.Equal(t, `[2020-01-02,)`, s.String())
+
+	v, err := s.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `[2020-01-02,)`, v)
+
+	var bad DateRange
+
+	assert.Error(t, bad.Scan(`[2020-01-01 00:00:00,)`))
+	assert.False(t, bad.Valid)
+
+	// The time of day is ignored
+	n := NewDateRange(DateFrom(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)), DateFrom(time.Date(2020, 1, 3, 12, 0, 0, 0, time.UTC)), true, false)
+	assert.Equal(t, `[2020-01-01,2020-01-03)`, n.String())
+
+	data, err := json.Marshal(r)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"lower":"2020-01-01","upper":"2020-02-01","lower_inc":true,"upper_inc":false}`, string(data))
+
+	var j DateRange
+
+	assert.NoError(t, json.Unmarshal(data, &j))
+	assert.Equal(t, r, j)
+
+	i := r.Intersect(n)
+	assert.Equal(t, `[2020-01-01,2020-01-03)`, i.String())
+	assert.True(t, r.Overlaps(n))
+
+	u, err := r.Union(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `[2020-01-01,)`, u.String())
+
+	// [2020-01-01,2020-01-31] is adjacent to [2020-02-01,2020-03-01) once made canonical
+	closed := DateRange{
+		Lower:    DateFrom(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Upper:    DateFrom(time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)),
+		LowerInc: true,
+		UpperInc: true,
+		Valid:    true,
+	}
+	next, err := ParseDateRange(`[2020-02-01,2020-03-01)`)
+	assert.NoError(t, err)
+
+	u, err = closed.Union(next)
+	assert.NoError(t, err)
+	assert.Equal(t, `[2020-01-01,2020-03-01)`, u.String())
+	assert.False(t, closed.Overlaps(next))
+	assert.True(t, closed.Contains(time.Date(2020, 1, 31, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestDateRangeEncodings(t *testing.T) {
+	r, err := ParseDateRange(`[2020-01-01,2020-01-31]`)
+	assert.NoError(t, err)
+
+	assert.True(t, r.Equal(NewDateRange(DateFrom(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)), DateFrom(time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)), true, false)))
+	assert.Equal(t, 1, r.Compare(EmptyDateRange(), NullsFirst))
+
+	for _, v := range []DateRange{r, EmptyDateRange(), {}} {
+		testTextEncodings(t, v, func() interface{} { return &DateRange{} })
+	}
+}
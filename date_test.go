@@ -168,3 +168,117 @@ func TestDateIsZero(t *testing.T) {
 	null := DateFromPtr(nil)
 	assert.True(t, null.IsZero())
 }
+
+func TestDateEqual(t *testing.T) {
+	paris := time.FixedZone("Europe/Paris", 3600)
+
+	assert.True(t, DateFrom(dateValue).Equal(DateFrom(dateValue)))
+	assert.True(t, DateFrom(dateValue).Equal(DateFrom(dateValue.In(paris))))
+	assert.True(t, Date{}.Equal(NewDate(dateValue, false)))
+	assert.False(t, DateFrom(dateValue).Equal(DateFrom(dateValue.Add(time.Hour))))
+	assert.False(t, DateFrom(dateValue).Equal(Date{}))
+}
+
+func TestDateCompare(t *testing.T) {
+	later := dateValue.Add(time.Hour)
+
+	assert.Equal(t, -1, DateFrom(dateValue).Compare(DateFrom(later), NullsFirst))
+	assert.Equal(t, 1, DateFrom(later).Compare(DateFrom(dateValue), NullsFirst))
+	assert.Equal(t, 0, DateFrom(dateValue).Compare(DateFrom(dateValue.UTC()), NullsFirst))
+	assert.Equal(t, -1, Date{}.Compare(DateFrom(dateValue), NullsFirst))
+	assert.Equal(t, 1, Date{}.Compare(DateFrom(dateValue), NullsLast))
+}
+
+func TestDateValueOr(t *testing.T) {
+	valid := DateFrom(dateValue)
+	assert.Equal(t, dateValue, valid.ValueOr(dateValue.AddDate(0, 0, 1)))
+	assert.Equal(t, dateValue, valid.ValueOrZero())
+
+	null := Date{}
+	assert.Equal(t, dateValue.AddDate(0, 0, 1), null.ValueOr(dateValue.AddDate(0, 0, 1)))
+	assert.Equal(t, time.Time{}, null.ValueOrZero())
+}
+
+func TestDateOrElse(t *testing.T) {
+	called := false
+	fallback := func() time.Time {
+		called = true
+
+		return dateValue.AddDate(0, 0, 1)
+	}
+
+	valid := DateFrom(dateValue)
+	assert.Equal(t, dateValue, valid.OrElse(fallback))
+	assert.False(t, called)
+
+	null := Date{}
+	assert.Equal(t, dateValue.AddDate(0, 0, 1), null.OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestDateGet(t *testing.T) {
+	v, ok := DateFrom(dateValue).Get()
+	assert.True(t, ok)
+	assert.Equal(t, dateValue, v)
+
+	v, ok = Date{}.Get()
+	assert.False(t, ok)
+	assert.Equal(t, time.Time{}, v)
+}
+
+func TestDateMustGet(t *testing.T) {
+	assert.Equal(t, dateValue, DateFrom(dateValue).MustGet())
+
+	assert.Panics(t, func() {
+		Date{}.MustGet()
+	})
+}
+
+func TestDateMap(t *testing.T) {
+	mapped := DateFrom(dateValue).Map(func(v time.Time) time.Time { return v.AddDate(0, 0, 1) })
+	assert.True(t, mapped.Valid)
+	assert.Equal(t, dateValue.AddDate(0, 0, 1), mapped.ValueOrZero())
+
+	called := false
+	null := Date{}.Map(func(v time.Time) time.Time {
+		called = true
+
+		return v
+	})
+	assert.False(t, null.Valid)
+	assert.False(t, called)
+}
+
+func TestDateFlatMap(t *testing.T) {
+	toNull := func(v time.Time) Date {
+		return Date{}
+	}
+
+	assert.False(t, DateFrom(dateValue).FlatMap(toNull).Valid)
+	assert.Equal(t, DateFrom(dateValue), DateFrom(dateValue).FlatMap(func(v time.Time) Date {
+		return DateFrom(v)
+	}))
+	assert.False(t, Date{}.FlatMap(func(v time.Time) Date {
+		return DateFrom(dateValue)
+	}).Valid)
+}
+
+func TestDateFilter(t *testing.T) {
+	assert.Equal(t, DateFrom(dateValue), DateFrom(dateValue).Filter(func(v time.Time) bool { return !v.IsZero() }))
+	assert.False(t, DateFrom(dateValue).Filter(func(v time.Time) bool { return false }).Valid)
+	assert.False(t, Date{}.Filter(func(v time.Time) bool { return true }).Valid)
+}
+
+func TestDateToTime(t *testing.T) {
+	assert.Equal(t, TimeFrom(dateValue), DateFrom(dateValue).ToTime())
+	assert.False(t, Date{}.ToTime().Valid)
+}
+
+func TestDateToDateTime(t *testing.T) {
+	paris := time.FixedZone("Europe/Paris", 3600)
+
+	dt := DateFrom(dateValue).ToDateTime(paris)
+	assert.True(t, dt.Valid)
+	assert.Equal(t, time.Date(2012, 12, 21, 0, 0, 0, 0, paris), dt.Data)
+	assert.False(t, Date{}.ToDateTime(paris).Valid)
+}
@@ -73,39 +73,58 @@ func DateTimeFromPtr(t *time.Time) DateTime {
 
 // MarshalText implement the json.Marshaler interface.
 func (t DateTime) MarshalText() ([]byte, error) {
+	return t.AppendText(make([]byte, 0, len(dateTimeFormat)))
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if this DateTime is null.
+func (t DateTime) AppendText(b []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte{}, nil
+		return b, nil
 	}
 
-	return []byte(t.Data.Format(dateTimeFormat)), nil
+	return t.Data.AppendFormat(b, dateTimeFormat), nil
 }
 
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this time is null.
 func (t DateTime) MarshalJSON() ([]byte, error) {
-	if !t.Valid {
-		return nullType, nil
-	}
-
-	b, _ := t.MarshalText()
+	return t.AppendJSON(make([]byte, 0, len(dateTimeFormat)+2))
+}
 
-	if len(b) == 0 {
-		return nullType, nil
+// AppendJSON appends the JSON encoding of this DateTime to b, null if this DateTime is null.
+func (t DateTime) AppendJSON(b []byte) ([]byte, error) {
+	if !t.Valid {
+		return append(b, nullType...), nil
 	}
 
-	dt := []byte{}
-	dt = append(dt, 0x22) // 0x22 => "
-	dt = append(dt, b...)
-	dt = append(dt, 0x22) // 0x22 => "
+	b = append(b, '"')
+	b = t.Data.AppendFormat(b, dateTimeFormat)
 
-	return dt, nil
+	return append(b, '"'), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string, object (e.g. pq.NullTime and friends)
+// It supports string, object (e.g. sql.NullTime and friends)
 // and null input.
 func (t *DateTime) UnmarshalJSON(b []byte) error {
-	if b[0] == '"' && b[len(b)-1] == '"' {
+	if len(b) > 0 && b[0] == '{' {
+		var (
+			err   error
+			value time.Time
+		)
+
+		t.Data = time.Time{}
+		t.Valid, err = unmarshalNullObject(b, "Time", "std.DateTime", &value)
+
+		if t.Valid {
+			t.Data = value
+		}
+
+		return err
+	}
+
+	if len(b) > 1 && b[0] == '"' && b[len(b)-1] == '"' {
 		b = b[1 : len(b)-1]
 	}
 
@@ -165,3 +184,104 @@ func (t DateTime) String() string {
 
 	return t.Data.Format(dateTimeFormat)
 }
+
+// Equal reports whether t and o are both null or both valid with the same time instant.
+func (t DateTime) Equal(o DateTime) bool {
+	return t.Valid == o.Valid && (!t.Valid || t.Data.Equal(o.Data))
+}
+
+// Compare returns -1 if t is before o, 0 if they are the same time instant and +1 if t is after o.
+// Null values are ordered according to nulls.
+func (t DateTime) Compare(o DateTime, nulls NullOrder) int {
+	if c, ok := compareNull(t.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareTime(t.Data, o.Data)
+}
+
+// ValueOr returns this DateTime's value, or def if this DateTime is null.
+func (t DateTime) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+
+	return t.Data
+}
+
+// ValueOrZero returns this DateTime's value, or the zero value of time.Time if this DateTime is null.
+func (t DateTime) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Data
+}
+
+// OrElse returns this DateTime's value, or the result of fn if this DateTime is null.
+// fn is only called when this DateTime is null.
+func (t DateTime) OrElse(fn func() time.Time) time.Time {
+	if !t.Valid {
+		return fn()
+	}
+
+	return t.Data
+}
+
+// Get returns this DateTime's value and true, or the zero value of time.Time and false if this DateTime is null.
+func (t DateTime) Get() (time.Time, bool) {
+	return t.ValueOrZero(), t.Valid
+}
+
+// MustGet returns this DateTime's value, it panics if this DateTime is null.
+func (t DateTime) MustGet() time.Time {
+	if !t.Valid {
+		panic("std: MustGet called on a null DateTime")
+	}
+
+	return t.Data
+}
+
+// Map returns a DateTime holding the result of fn applied to this DateTime's value,
+// or a null DateTime if this DateTime is null. fn is only called when this DateTime is valid.
+func (t DateTime) Map(fn func(time.Time) time.Time) DateTime {
+	if !t.Valid {
+		return DateTime{}
+	}
+
+	return NewDateTime(fn(t.Data), true)
+}
+
+// FlatMap returns the result of fn applied to this DateTime's value,
+// or a null DateTime if this DateTime is null. fn is only called when this DateTime is valid.
+func (t DateTime) FlatMap(fn func(time.Time) DateTime) DateTime {
+	if !t.Valid {
+		return DateTime{}
+	}
+
+	return fn(t.Data)
+}
+
+// Filter returns this DateTime if it is valid and its value satisfies fn,
+// otherwise it returns a null DateTime.
+func (t DateTime) Filter(fn func(time.Time) bool) DateTime {
+	if !t.Valid || !fn(t.Data) {
+		return DateTime{}
+	}
+
+	return t
+}
+
+// ToTime converts this DateTime to a Time holding the same instant.
+func (t DateTime) ToTime() Time {
+	return NewTime(t.Data, t.Valid)
+}
+
+// ToDate converts this DateTime to a Date, dropping the time of day in the location of this DateTime.
+func (t DateTime) ToDate() Date {
+	if !t.Valid {
+		return Date{}
+	}
+
+	return NewDate(truncateDay(t.Data, t.Data.Location()), true)
+}
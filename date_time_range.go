@@ -0,0 +1,319 @@
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"time"
+)
+
+var dateTimeRangeKind = &rangeKind{
+	name:    "DateTimeRange",
+	compare: compareRangeTimes,
+	parse: func(s string) (interface{}, error) {
+		if isRangeInfinity(s) {
+			return nil, nil
+		}
+
+		if t, err := time.Parse(dateTimeFormat, s); err == nil {
+			return t, nil
+		}
+
+		return parseArrayTime(s)
+	},
+	format: func(b []byte, v interface{}) []byte {
+		return v.(time.Time).AppendFormat(b, time.RFC3339Nano) // nolint: forcetypeassert
+	},
+}
+
+// DateTimeRange is a nullable range of times, mapping to a Postgres tstzrange column.
+// A null bound is infinite, and Empty is true for the empty range.
+// It scans from and writes the range literal syntax ([1,5), (,5], empty),
+// and marshals to a JSON object ({"lower":..,"upper":..,"lower_inc":..,"upper_inc":..} or {"empty":true}) or null.
+type DateTimeRange struct {
+	Lower    DateTime
+	Upper    DateTime
+	LowerInc bool
+	UpperInc bool
+	Empty    bool
+	Valid    bool
+}
+
+// NewDateTimeRange creates a new valid DateTimeRange, with the bounds made canonical.
+func NewDateTimeRange(lower, upper DateTime, lowerInc, upperInc bool) DateTimeRange {
+	return DateTimeRange{Lower: lower, Upper: upper, LowerInc: lowerInc, UpperInc: upperInc, Valid: true}.canonical()
+}
+
+// EmptyDateTimeRange creates a new valid empty DateTimeRange.
+func EmptyDateTimeRange() DateTimeRange {
+	return DateTimeRange{Empty: true, Valid: true}
+}
+
+// ParseDateTimeRange parses a range literal like [1,5) into a valid DateTimeRange.
+func ParseDateTimeRange(s string) (DateTimeRange, error) {
+	r, err := dateTimeRangeKind.parseRange([]byte(s))
+	if err != nil {
+		return DateTimeRange{}, err
+	}
+
+	return dateTimeRangeFromSpan(r), nil
+}
+
+func dateTimeRangeFromSpan(s span) DateTimeRange {
+	r := DateTimeRange{LowerInc: s.lowerInc, UpperInc: s.upperInc, Empty: s.empty, Valid: true}
+
+	if v := s.lower; v != nil {
+		r.Lower = NewDateTime(v.(time.Time), true)
+	}
+
+	if v := s.upper; v != nil {
+		r.Upper = NewDateTime(v.(time.Time), true)
+	}
+
+	return r
+}
+
+func (r DateTimeRange) span() span {
+	s := span{lowerInc: r.LowerInc, upperInc: r.UpperInc, empty: r.Empty}
+
+	if r.Lower.Valid {
+		s.lower = r.Lower.Data
+	}
+
+	if r.Upper.Valid {
+		s.upper = r.Upper.Data
+	}
+
+	return s
+}
+
+func (r DateTimeRange) canonical() DateTimeRange {
+	return dateTimeRangeFromSpan(dateTimeRangeKind.canonical(r.span()))
+}
+
+// Scan implements the Scanner interface.
+func (r *DateTimeRange) Scan(value interface{}) error {
+	s, valid, err := dateTimeRangeKind.scanRange(value)
+	if err != nil || !valid {
+		*r = DateTimeRange{}
+
+		return err
+	}
+
+	*r = dateTimeRangeFromSpan(s)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the range literal, or nil if this DateTimeRange is null.
+func (r DateTimeRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+
+	return string(dateTimeRangeKind.appendRange(nil, r.span())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports object and null input, missing or null bounds are infinite.
+func (r *DateTimeRange) UnmarshalJSON(data []byte) error {
+	obj, valid, err := dateTimeRangeKind.unmarshalRangeJSON(data)
+	if err != nil || !valid {
+		*r = DateTimeRange{}
+
+		return err
+	}
+
+	if obj.Empty {
+		*r = EmptyDateTimeRange()
+
+		return nil
+	}
+
+	var lower, upper DateTime
+
+	if err := unmarshalRangeBound(obj.Lower, &lower); err != nil {
+		*r = DateTimeRange{}
+
+		return err
+	}
+
+	if err := unmarshalRangeBound(obj.Upper, &upper); err != nil {
+		*r = DateTimeRange{}
+
+		return err
+	}
+
+	*r = NewDateTimeRange(lower, upper, obj.LowerInc, obj.UpperInc)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this DateTimeRange is null.
+func (r DateTimeRange) MarshalJSON() ([]byte, error) {
+	return r.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this DateTimeRange to b, null if this DateTimeRange is null.
+func (r DateTimeRange) AppendJSON(b []byte) ([]byte, error) {
+	if !r.Valid {
+		return append(b, nullType...), nil
+	}
+
+	lower, err := r.Lower.MarshalJSON()
+	if err != nil {
+		return b, err
+	}
+
+	upper, err := r.Upper.MarshalJSON()
+	if err != nil {
+		return b, err
+	}
+
+	return appendRangeJSON(b, r.Empty, lower, upper, r.LowerInc, r.UpperInc), nil
+}
+
+// IsZero returns true for null DateTimeRange, for potential future omitempty support.
+func (r DateTimeRange) IsZero() bool {
+	return !r.Valid
+}
+
+// String implements fmt.Stringer interface.
+// It returns the range literal, or a blank string if this DateTimeRange is null.
+func (r DateTimeRange) String() string {
+	if !r.Valid {
+		return ""
+	}
+
+	return string(dateTimeRangeKind.appendRange(nil, r.span()))
+}
+
+// Equal reports whether r and o are both null or both valid with the same values.
+func (r DateTimeRange) Equal(o DateTimeRange) bool {
+	return r.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if r is less than o, 0 if they are equal and +1 if r is greater than o,
+// like Postgres does: the empty range first, then by lower bound and by upper bound.
+// Null values are ordered according to nulls.
+func (r DateTimeRange) Compare(o DateTimeRange, nulls NullOrder) int {
+	if c, ok := compareNull(r.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return dateTimeRangeKind.compareRanges(r.span(), o.span())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the range literal, or a blank string when this DateTimeRange is null.
+func (r DateTimeRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses a range literal, a blank string is a null DateTimeRange.
+func (r *DateTimeRange) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = DateTimeRange{}
+
+		return nil
+	}
+
+	return r.Scan(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this DateTimeRange is null, the range literal otherwise.
+func (r DateTimeRange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, r.Valid, r.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null DateTimeRange, an empty element is a null DateTimeRange.
+func (r *DateTimeRange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, r)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this DateTimeRange is null.
+func (r DateTimeRange) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, r.Valid, r.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (r *DateTimeRange) UnmarshalXMLAttr(attr xml.Attr) error {
+	return r.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r DateTimeRange) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(r.Valid, r.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *DateTimeRange) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.DateTimeRange", r)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (r DateTimeRange) GobEncode() ([]byte, error) {
+	return r.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (r *DateTimeRange) GobDecode(data []byte) error {
+	return r.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this DateTimeRange is null, the range literal otherwise.
+func (r DateTimeRange) MarshalYAML() (interface{}, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+
+	return r.String(), nil
+}
+
+// unmarshalYAML decodes this DateTimeRange with the unmarshal func of a YAML library.
+func (r *DateTimeRange) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, "std.DateTimeRange", r)
+}
+
+// Contains reports whether v is in this DateTimeRange, it is false if this DateTimeRange is null.
+func (r DateTimeRange) Contains(v time.Time) bool {
+	return r.Valid && dateTimeRangeKind.contains(r.span(), v)
+}
+
+// Overlaps reports whether r and o have values in common, like the && operator.
+// It is false if one of them is null.
+func (r DateTimeRange) Overlaps(o DateTimeRange) bool {
+	return r.Valid && o.Valid && dateTimeRangeKind.overlaps(r.span(), o.span())
+}
+
+// Intersect returns the values in both r and o, like the * operator.
+// It is null if one of them is null, and empty if they do not overlap.
+func (r DateTimeRange) Intersect(o DateTimeRange) DateTimeRange {
+	if !r.Valid || !o.Valid {
+		return DateTimeRange{}
+	}
+
+	return dateTimeRangeFromSpan(dateTimeRangeKind.intersect(r.span(), o.span()))
+}
+
+// Union returns the values in r or o, like the + operator.
+// It is null if one of them is null, and returns ErrRangeNotContiguous
+// if the ranges neither overlap nor are adjacent.
+func (r DateTimeRange) Union(o DateTimeRange) (DateTimeRange, error) {
+	if !r.Valid || !o.Valid {
+		return DateTimeRange{}, nil
+	}
+
+	s, err := dateTimeRangeKind.union(r.span(), o.span())
+	if err != nil {
+		return DateTimeRange{}, err
+	}
+
+	return dateTimeRangeFromSpan(s), nil
+}
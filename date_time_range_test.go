@@ -0,0 +1,86 @@
+package std
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTimeRange(t *testing.T) {
+	r, err := ParseDateTimeRange(`["2020-01-01 00:00:00+00","2020-01-02 12:30:00.5+01")`)
+	assert.NoError(t, err)
+	assert.Equal(t, `[2020-01-01T00:00:00Z,2020-01-02T12:30:00.5+01:00)`, r.String())
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 1, 2, 11, 30, 0, 500000000, time.UTC)
+
+	assert.True(t, r.Contains(start))
+	assert.True(t, r.Contains(end.Add(-time.Nanosecond)))
+	assert.False(t, r.Contains(end))
+	assert.False(t, r.Contains(start.Add(-time.Nanosecond)))
+
+	// Continuous ranges keep their bounds
+	n := NewDateTimeRange(DateTimeFrom(start), DateTimeFrom(start), true, true)
+	assert.False(t, n.Empty)
+	assert.True(t, n.Contains(start))
+
+	assert.True(t, NewDateTimeRange(DateTimeFrom(start), DateTimeFrom(start), true, false).Empty)
+
+	var s DateTimeRange
+
+	assert.NoError(t, s.Scan(`(-infinity,"2020-01-01 00:00:00+00"]`))
+	assert.Equal(t, `(,2020-01-01T00:00:00Z]`, s.String())
+
+	v, err := s.Value()
+	assert.NoError(t, err)
+
+	var again DateTimeRange
+
+	assert.NoError(t, again.Scan(v))
+	assert.True(t, again.Upper.Data.Equal(start))
+	assert.True(t, again.UpperInc)
+
+	// [a,b] and [b,c) share b
+	assert.True(t, s.Overlaps(r))
+	assert.Equal(t, `[2020-01-01T00:00:00Z,2020-01-01T00:00:00Z]`, s.Intersect(r).String())
+
+	u, err := s.Union(r)
+	assert.NoError(t, err)
+	assert.Equal(t, `(,2020-01-02T12:30:00.5+01:00)`, u.String())
+
+	// (a,b) and [b,c) are adjacent
+	o, err := ParseDateTimeRange(`(,"2020-01-01 00:00:00+00")`)
+	assert.NoError(t, err)
+	assert.False(t, o.Overlaps(r))
+
+	u, err = o.Union(r)
+	assert.NoError(t, err)
+	assert.Equal(t, `(,2020-01-02T12:30:00.5+01:00)`, u.String())
+
+	data, err := json.Marshal(NewDateTimeRange(DateTimeFrom(start), DateTime{}, true, false))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"lower":"2020-01-01T00:00:00+0000","upper":null,"lower_inc":true,"upper_inc":false}`, string(data))
+
+	var j DateTimeRange
+
+	assert.NoError(t, json.Unmarshal(data, &j))
+	assert.True(t, j.Lower.Data.Equal(start))
+	assert.False(t, j.Upper.Valid)
+}
+
+func TestDateTimeRangeEncodings(t *testing.T) {
+	r, err := ParseDateTimeRange(`["2020-01-01 00:00:00+00","2020-01-02 12:30:00.5+01")`)
+	assert.NoError(t, err)
+
+	start := time.Date(2020, 1, 1, 1, 0, 0, 0, time.FixedZone("", 3600))
+	end := time.Date(2020, 1, 2, 11, 30, 0, 500000000, time.UTC)
+
+	assert.True(t, r.Equal(NewDateTimeRange(DateTimeFrom(start), DateTimeFrom(end), true, false)))
+	assert.Equal(t, -1, r.Compare(NewDateTimeRange(DateTimeFrom(start), DateTime{}, true, false), NullsFirst))
+
+	for _, v := range []DateTimeRange{r, EmptyDateTimeRange(), {}} {
+		testTextEncodings(t, v, func() interface{} { return &DateTimeRange{} })
+	}
+}
@@ -189,3 +189,118 @@ func assertNullDateTime(t *testing.T, ti DateTime, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestDateTimeEqual(t *testing.T) {
+	paris := time.FixedZone("Europe/Paris", 3600)
+
+	assert.True(t, DateTimeFrom(dateTimeValue).Equal(DateTimeFrom(dateTimeValue)))
+	assert.True(t, DateTimeFrom(dateTimeValue).Equal(DateTimeFrom(dateTimeValue.In(paris))))
+	assert.True(t, DateTime{}.Equal(NewDateTime(dateTimeValue, false)))
+	assert.False(t, DateTimeFrom(dateTimeValue).Equal(DateTimeFrom(dateTimeValue.Add(time.Hour))))
+	assert.False(t, DateTimeFrom(dateTimeValue).Equal(DateTime{}))
+}
+
+func TestDateTimeCompare(t *testing.T) {
+	later := dateTimeValue.Add(time.Hour)
+
+	assert.Equal(t, -1, DateTimeFrom(dateTimeValue).Compare(DateTimeFrom(later), NullsFirst))
+	assert.Equal(t, 1, DateTimeFrom(later).Compare(DateTimeFrom(dateTimeValue), NullsFirst))
+	assert.Equal(t, 0, DateTimeFrom(dateTimeValue).Compare(DateTimeFrom(dateTimeValue.UTC()), NullsFirst))
+	assert.Equal(t, -1, DateTime{}.Compare(DateTimeFrom(dateTimeValue), NullsFirst))
+	assert.Equal(t, 1, DateTime{}.Compare(DateTimeFrom(dateTimeValue), NullsLast))
+}
+
+func TestDateTimeValueOr(t *testing.T) {
+	valid := DateTimeFrom(dateTimeValue)
+	assert.Equal(t, dateTimeValue, valid.ValueOr(dateTimeValue.Add(time.Hour)))
+	assert.Equal(t, dateTimeValue, valid.ValueOrZero())
+
+	null := DateTime{}
+	assert.Equal(t, dateTimeValue.Add(time.Hour), null.ValueOr(dateTimeValue.Add(time.Hour)))
+	assert.Equal(t, time.Time{}, null.ValueOrZero())
+}
+
+func TestDateTimeOrElse(t *testing.T) {
+	called := false
+	fallback := func() time.Time {
+		called = true
+
+		return dateTimeValue.Add(time.Hour)
+	}
+
+	valid := DateTimeFrom(dateTimeValue)
+	assert.Equal(t, dateTimeValue, valid.OrElse(fallback))
+	assert.False(t, called)
+
+	null := DateTime{}
+	assert.Equal(t, dateTimeValue.Add(time.Hour), null.OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestDateTimeGet(t *testing.T) {
+	v, ok := DateTimeFrom(dateTimeValue).Get()
+	assert.True(t, ok)
+	assert.Equal(t, dateTimeValue, v)
+
+	v, ok = DateTime{}.Get()
+	assert.False(t, ok)
+	assert.Equal(t, time.Time{}, v)
+}
+
+func TestDateTimeMustGet(t *testing.T) {
+	assert.Equal(t, dateTimeValue, DateTimeFrom(dateTimeValue).MustGet())
+
+	assert.Panics(t, func() {
+		DateTime{}.MustGet()
+	})
+}
+
+func TestDateTimeMap(t *testing.T) {
+	mapped := DateTimeFrom(dateTimeValue).Map(func(v time.Time) time.Time { return v.Add(time.Hour) })
+	assert.True(t, mapped.Valid)
+	assert.Equal(t, dateTimeValue.Add(time.Hour), mapped.ValueOrZero())
+
+	called := false
+	null := DateTime{}.Map(func(v time.Time) time.Time {
+		called = true
+
+		return v
+	})
+	assert.False(t, null.Valid)
+	assert.False(t, called)
+}
+
+func TestDateTimeFlatMap(t *testing.T) {
+	toNull := func(v time.Time) DateTime {
+		return DateTime{}
+	}
+
+	assert.False(t, DateTimeFrom(dateTimeValue).FlatMap(toNull).Valid)
+	assert.Equal(t, DateTimeFrom(dateTimeValue), DateTimeFrom(dateTimeValue).FlatMap(func(v time.Time) DateTime {
+		return DateTimeFrom(v)
+	}))
+	assert.False(t, DateTime{}.FlatMap(func(v time.Time) DateTime {
+		return DateTimeFrom(dateTimeValue)
+	}).Valid)
+}
+
+func TestDateTimeFilter(t *testing.T) {
+	assert.Equal(t, DateTimeFrom(dateTimeValue), DateTimeFrom(dateTimeValue).Filter(func(v time.Time) bool { return !v.IsZero() }))
+	assert.False(t, DateTimeFrom(dateTimeValue).Filter(func(v time.Time) bool { return false }).Valid)
+	assert.False(t, DateTime{}.Filter(func(v time.Time) bool { return true }).Valid)
+}
+
+func TestDateTimeToTime(t *testing.T) {
+	assert.Equal(t, TimeFrom(dateTimeValue), DateTimeFrom(dateTimeValue).ToTime())
+	assert.False(t, DateTime{}.ToTime().Valid)
+}
+
+func TestDateTimeToDate(t *testing.T) {
+	paris := time.FixedZone("Europe/Paris", 3600)
+	late := time.Date(2012, 12, 21, 23, 30, 0, 0, paris)
+
+	d := DateTimeFrom(late).ToDate()
+	assert.True(t, d.Valid)
+	assert.Equal(t, time.Date(2012, 12, 21, 0, 0, 0, 0, paris), d.Data)
+	assert.False(t, DateTime{}.ToDate().Valid)
+}
@@ -0,0 +1,49 @@
+package std
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertThroughDriver(t *testing.T) {
+	d := stdtest.NewDriver()
+	db := d.DB()
+
+	defer db.Close()
+
+	args := []interface{}{
+		StringFrom("test"), IntFrom(12345), UintFrom(12345), FloatFrom(1.2345), BoolFrom(true),
+		TimeFrom(timeValue), DateTimeFrom(timeValue), NewDate(timeValue, true),
+		String{}, Int{}, Uint{}, Float{}, Bool{}, Time{}, DateTime{}, Date{},
+	}
+
+	_, err := db.Exec("INSERT", args...)
+	assert.NoError(t, err)
+	assert.Equal(t, []driver.Value{
+		"test", int64(12345), int64(12345), 1.2345, true, timeValue, timeValue, timeValue,
+		nil, nil, nil, nil, nil, nil, nil, nil,
+	}, d.LastArgs())
+
+	d.SetRows("SELECT", make([]string, 8), d.LastArgs()[:8])
+
+	var (
+		s  String
+		i  Int
+		u  Uint
+		f  Float
+		b  Bool
+		ti Time
+		dt DateTime
+		da Date
+	)
+
+	err = db.QueryRow("SELECT").Scan(&s, &i, &u, &f, &b, &ti, &dt, &da)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		StringFrom("test"), IntFrom(12345), UintFrom(12345), FloatFrom(1.2345), BoolFrom(true),
+		TimeFrom(timeValue), DateTimeFrom(timeValue), NewDate(timeValue, true),
+	}, []interface{}{s, i, u, f, b, ti, dt, da})
+}
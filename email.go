@@ -0,0 +1,306 @@
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// Email is a nullable mail.Address. It supports SQL and JSON serialization.
+// It accepts RFC 5322 addresses with an optional display name, like "Gopher <gopher@example.com>",
+// and normalises them with a lower case domain.
+// An Email without display name is serialised as the bare address.
+type Email struct {
+	Data  mail.Address
+	Valid bool
+}
+
+// NewEmail creates a new Email.
+func NewEmail(a mail.Address, valid bool) Email {
+	return Email{
+		Data:  a,
+		Valid: valid,
+	}
+}
+
+// EmailFrom creates a new Email that will always be valid.
+func EmailFrom(a mail.Address) Email {
+	return NewEmail(a, true)
+}
+
+// ParseEmail parses s as an RFC 5322 address, with an optional display name.
+// A blank string gives a null Email.
+func ParseEmail(s string) (Email, error) {
+	var e Email
+
+	err := e.UnmarshalText([]byte(s))
+
+	return e, err
+}
+
+// parseEmail parses and normalises an address.
+func parseEmail(s string) (mail.Address, error) {
+	a, err := mail.ParseAddress(s)
+	if err != nil {
+		return mail.Address{}, fmt.Errorf("std: invalid email %q: %w", s, err)
+	}
+
+	a.Address = normalizeEmail(a.Address)
+
+	return *a, nil
+}
+
+// normalizeEmail lower-cases the domain of the address, the local part is case sensitive.
+func normalizeEmail(address string) string {
+	i := strings.LastIndexByte(address, '@')
+	if i < 0 {
+		return address
+	}
+
+	return address[:i+1] + strings.ToLower(address[i+1:])
+}
+
+// Scan implements the Scanner interface.
+func (e *Email) Scan(value interface{}) error {
+	var err error
+
+	switch x := value.(type) {
+	case nil:
+		e.Data, e.Valid = mail.Address{}, false
+
+		return nil
+	case []byte:
+		e.Data, err = parseEmail(string(x))
+	case string:
+		e.Data, err = parseEmail(x)
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.Email: %v", value, value)
+	}
+
+	if err != nil {
+		e.Data = mail.Address{}
+	}
+
+	e.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (e Email) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+
+	return e.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (e *Email) UnmarshalJSON(data []byte) error {
+	var s String
+
+	if err := s.UnmarshalJSON(data); err != nil {
+		e.Data, e.Valid = mail.Address{}, false
+
+		return err
+	}
+
+	if !s.Valid {
+		e.Data, e.Valid = mail.Address{}, false
+
+		return nil
+	}
+
+	return e.Scan(s.Data)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Email is null.
+func (e Email) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(e.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Email if the input is a blank string.
+func (e *Email) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		e.Data, e.Valid = mail.Address{}, false
+
+		return nil
+	}
+
+	return e.Scan(text)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string when this Email is null.
+func (e Email) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// SetValid changes this Email's value and also sets it to be non-null.
+func (e *Email) SetValid(v mail.Address) {
+	e.Data = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this Email's value, or a nil pointer if this Email is null.
+func (e Email) Ptr() *mail.Address {
+	if !e.Valid {
+		return nil
+	}
+
+	return &e.Data
+}
+
+// IsZero returns true for null Emails, for potential future omitempty support.
+func (e Email) IsZero() bool {
+	return !e.Valid
+}
+
+// Address returns the address of this Email without display name, or a blank string if this Email is null.
+func (e Email) Address() string {
+	if !e.Valid {
+		return ""
+	}
+
+	return normalizeEmail(e.Data.Address)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the bare address, or the RFC 5322 form with the display name if there is one.
+// It returns a blank string if this Email is null.
+func (e Email) String() string {
+	if !e.Valid {
+		return ""
+	}
+
+	if e.Data.Name == "" {
+		return e.Address()
+	}
+
+	return (&mail.Address{Name: e.Data.Name, Address: e.Address()}).String()
+}
+
+// Equal reports whether e and o are both null or both valid with the same address and display name.
+func (e Email) Equal(o Email) bool {
+	return e.Valid == o.Valid && e.String() == o.String()
+}
+
+// Compare returns -1 if e is less than o, 0 if they are equal and +1 if e is greater than o,
+// comparing their text forms. Null values are ordered according to nulls.
+func (e Email) Compare(o Email, nulls NullOrder) int {
+	if c, ok := compareNull(e.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return strings.Compare(e.String(), o.String())
+}
+
+// ValueOr returns this Email's value, or def if this Email is null.
+func (e Email) ValueOr(def mail.Address) mail.Address {
+	if !e.Valid {
+		return def
+	}
+
+	return e.Data
+}
+
+// ValueOrZero returns this Email's value, or the zero value of mail.Address if this Email is null.
+func (e Email) ValueOrZero() mail.Address {
+	if !e.Valid {
+		return mail.Address{}
+	}
+
+	return e.Data
+}
+
+// OrElse returns this Email's value, or the result of fn if this Email is null.
+// fn is only called when this Email is null.
+func (e Email) OrElse(fn func() mail.Address) mail.Address {
+	if !e.Valid {
+		return fn()
+	}
+
+	return e.Data
+}
+
+// Get returns this Email's value and true, or the zero value of mail.Address and false if this Email is null.
+func (e Email) Get() (mail.Address, bool) {
+	return e.ValueOrZero(), e.Valid
+}
+
+// MustGet returns this Email's value, it panics if this Email is null.
+func (e Email) MustGet() mail.Address {
+	if !e.Valid {
+		panic("std: MustGet called on a null Email")
+	}
+
+	return e.Data
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Email is null.
+func (e Email) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(enc, start, e.Valid, e.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Email, an empty element is a null Email.
+func (e *Email) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, e)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Email is null.
+func (e Email) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, e.Valid, e.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (e *Email) UnmarshalXMLAttr(attr xml.Attr) error {
+	return e.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (e Email) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(e.Valid, e.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (e *Email) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.Email", e)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (e Email) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (e *Email) GobDecode(data []byte) error {
+	return e.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Email is null, its text form otherwise.
+func (e Email) MarshalYAML() (interface{}, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+
+	return e.String(), nil
+}
+
+// unmarshalYAML decodes this Email with the unmarshal func of a YAML library.
+func (e *Email) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, "std.Email", e)
+}
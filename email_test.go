@@ -0,0 +1,150 @@
+package std
+
+import (
+	"encoding/json"
+	"net/mail"
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailScan(t *testing.T) {
+	testCases := []struct {
+		src      interface{}
+		expected string
+		address  string
+	}{
+		{"gopher@example.com", "gopher@example.com", "gopher@example.com"},
+		{[]byte("Gopher@Example.COM"), "Gopher@example.com", "Gopher@example.com"},
+		{"<gopher@example.com>", "gopher@example.com", "gopher@example.com"},
+		{"Gopher <gopher@EXAMPLE.com>", `"Gopher" <gopher@example.com>`, "gopher@example.com"},
+		{`"Gopher, Go" <gopher@example.com>`, `"Gopher, Go" <gopher@example.com>`, "gopher@example.com"},
+		{"=?utf-8?q?G=C3=B6pher?= <gopher@example.com>", "=?utf-8?q?G=C3=B6pher?= <gopher@example.com>", "gopher@example.com"},
+	}
+
+	for _, tc := range testCases {
+		var e Email
+
+		assert.NoError(t, e.Scan(tc.src), tc.src)
+		assert.True(t, e.Valid)
+		assert.Equal(t, tc.address, e.Address())
+
+		v, err := e.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, v)
+
+		// The canonical form is parsed back to the same value
+		var again Email
+
+		assert.NoError(t, again.Scan(v))
+		assert.True(t, e.Equal(again), tc.src)
+	}
+
+	var e Email
+
+	assert.EqualError(t, e.Scan("gopher"), `std: invalid email "gopher": mail: missing '@' or angle-addr`)
+	assert.False(t, e.Valid)
+	assert.Error(t, e.Scan(""))
+	assert.Error(t, e.Scan("gopher@"))
+	assert.EqualError(t, e.Scan(int64(1)), "null: cannot scan type int64 into null.Email: 1")
+
+	assert.NoError(t, e.Scan(nil))
+	assert.False(t, e.Valid)
+	assert.Equal(t, "", e.Address())
+
+	v, err := e.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestEmailJSON(t *testing.T) {
+	var v struct {
+		Email Email
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"Email":"Gopher <gopher@EXAMPLE.com>"}`), &v))
+	assert.Equal(t, mail.Address{Name: "Gopher", Address: "gopher@example.com"}, v.Email.Data)
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Email":"\"Gopher\" \u003cgopher@example.com\u003e"}`, string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"Email":null}`), &v))
+	assert.False(t, v.Email.Valid)
+
+	data, err = json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Email":null}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"Email":"gopher"}`), &v))
+	assert.False(t, v.Email.Valid)
+	assert.Error(t, json.Unmarshal([]byte(`{"Email":true}`), &v))
+}
+
+func TestParseEmail(t *testing.T) {
+	e, err := ParseEmail("gopher@Example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, EmailFrom(mail.Address{Address: "gopher@example.com"}), e)
+	assert.Equal(t, "gopher@example.com", e.Ptr().Address)
+
+	e, err = ParseEmail("")
+	assert.NoError(t, err)
+	assert.True(t, e.IsZero())
+	assert.Nil(t, e.Ptr())
+	assert.Equal(t, "", e.String())
+
+	text, err := e.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(text))
+
+	// SetValid does not normalise the address, its serialisation does
+	e.SetValid(mail.Address{Address: "gopher@EXAMPLE.com"})
+	assert.Equal(t, "gopher@example.com", e.String())
+	assert.True(t, e.Equal(EmailFrom(mail.Address{Address: "gopher@example.com"})))
+	assert.False(t, e.Equal(Email{}))
+}
+
+func TestEmailNullableSuite(t *testing.T) {
+	stdtest.RunNullableSuite(t, stdtest.Factory{
+		New:   func() stdtest.Nullable { return &Email{} },
+		Value: mail.Address{Name: "Gopher", Address: "gopher@example.com"},
+		Scan: []stdtest.ScanCase{
+			{Src: []byte("gopher@Example.com"), Want: "gopher@example.com"},
+			{Src: "gopher", Err: true},
+		},
+	})
+}
+
+func TestEmailAccessors(t *testing.T) {
+	a, _ := ParseEmail("alice@example.com")
+	b, _ := ParseEmail("bob@example.com")
+
+	assert.Equal(t, -1, a.Compare(b, NullsFirst))
+	assert.Equal(t, 1, b.Compare(a, NullsFirst))
+	assert.Equal(t, 0, a.Compare(a, NullsFirst))
+	assert.Equal(t, -1, Email{}.Compare(a, NullsFirst))
+	assert.Equal(t, 1, Email{}.Compare(a, NullsLast))
+
+	assert.Equal(t, a.Data, a.ValueOr(b.Data))
+	assert.Equal(t, b.Data, Email{}.ValueOr(b.Data))
+	assert.Equal(t, mail.Address{}, Email{}.ValueOrZero())
+	assert.Equal(t, b.Data, Email{}.OrElse(func() mail.Address { return b.Data }))
+
+	v, ok := a.Get()
+	assert.True(t, ok)
+	assert.Equal(t, a.Data, v)
+
+	assert.Equal(t, a.Data, a.MustGet())
+	assert.Panics(t, func() {
+		Email{}.MustGet()
+	})
+}
+
+func TestEmailEncodings(t *testing.T) {
+	e, _ := ParseEmail("Gopher <gopher@example.com>")
+
+	for _, v := range []Email{e, {}} {
+		testTextEncodings(t, v, func() interface{} { return &Email{} })
+	}
+}
@@ -0,0 +1,142 @@
+package std
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EnumError is returned when a value is not one of the allowed values of an enum.
+type EnumError struct {
+	// Enum is the name of the enum.
+	Enum string
+	// Value is the rejected value.
+	Value string
+}
+
+// Error implements the error interface.
+func (e *EnumError) Error() string {
+	return fmt.Sprintf("std: invalid value %q for enum %s", e.Value, e.Enum)
+}
+
+// Enum is a set of allowed string values, like a Postgres enum or a constrained VARCHAR.
+type Enum struct {
+	name            string
+	values          []string
+	allowed         map[string]bool
+	caseInsensitive bool
+}
+
+// NewEnum creates an Enum named name, allowing values.
+func NewEnum(name string, values ...string) *Enum {
+	e := &Enum{
+		name:    name,
+		values:  make([]string, len(values)),
+		allowed: make(map[string]bool, len(values)),
+	}
+
+	copy(e.values, values)
+
+	for _, v := range values {
+		e.allowed[v] = true
+	}
+
+	return e
+}
+
+// CaseInsensitive returns a copy of this Enum matching the values regardless of their case.
+// Parsed values are normalised to the declared spelling.
+func (e *Enum) CaseInsensitive() *Enum {
+	c := NewEnum(e.name, e.values...)
+	c.caseInsensitive = true
+
+	return c
+}
+
+// Name returns the name of this Enum.
+func (e *Enum) Name() string {
+	return e.name
+}
+
+// Values returns the allowed values, in declaration order.
+func (e *Enum) Values() []string {
+	values := make([]string, len(e.values))
+	copy(values, e.values)
+
+	return values
+}
+
+// Contains reports whether s is an allowed value.
+func (e *Enum) Contains(s string) bool {
+	_, err := e.Parse(s)
+
+	return err == nil
+}
+
+// Parse returns the allowed value matching s, or an *EnumError if there is none.
+func (e *Enum) Parse(s string) (string, error) {
+	if e.allowed[s] {
+		return s, nil
+	}
+
+	if e.caseInsensitive {
+		for _, v := range e.values {
+			if strings.EqualFold(v, s) {
+				return v, nil
+			}
+		}
+	}
+
+	return "", &EnumError{Enum: e.name, Value: s}
+}
+
+// IntEnum is a set of allowed integer values.
+type IntEnum struct {
+	name    string
+	values  []int64
+	allowed map[int64]bool
+}
+
+// NewIntEnum creates an IntEnum named name, allowing values.
+func NewIntEnum(name string, values ...int64) *IntEnum {
+	e := &IntEnum{
+		name:    name,
+		values:  make([]int64, len(values)),
+		allowed: make(map[int64]bool, len(values)),
+	}
+
+	copy(e.values, values)
+
+	for _, v := range values {
+		e.allowed[v] = true
+	}
+
+	return e
+}
+
+// Name returns the name of this IntEnum.
+func (e *IntEnum) Name() string {
+	return e.name
+}
+
+// Values returns the allowed values, in declaration order.
+func (e *IntEnum) Values() []int64 {
+	values := make([]int64, len(e.values))
+	copy(values, e.values)
+
+	return values
+}
+
+// Contains reports whether i is an allowed value.
+func (e *IntEnum) Contains(i int64) bool {
+	return e.allowed[i]
+}
+
+// Check returns an *EnumError if i is not an allowed value.
+func (e *IntEnum) Check(i int64) error {
+	if !e.allowed[i] {
+		return &EnumError{Enum: e.name, Value: strconv.FormatInt(i, 10)}
+	}
+
+	return nil
+}
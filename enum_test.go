@@ -0,0 +1,59 @@
+package std
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnum(t *testing.T) {
+	values := []string{"active", "archived"}
+	e := NewEnum("status", values...)
+
+	// The enum does not share the slice of its caller
+	values[0] = "deleted"
+
+	assert.Equal(t, "status", e.Name())
+	assert.Equal(t, []string{"active", "archived"}, e.Values())
+	assert.True(t, e.Contains("active"))
+	assert.False(t, e.Contains("Active"))
+	assert.False(t, e.Contains("deleted"))
+
+	v, err := e.Parse("archived")
+	assert.NoError(t, err)
+	assert.Equal(t, "archived", v)
+
+	_, err = e.Parse("ACTIVE")
+	assert.EqualError(t, err, `std: invalid value "ACTIVE" for enum status`)
+
+	var enumErr *EnumError
+	assert.True(t, errors.As(err, &enumErr))
+	assert.Equal(t, &EnumError{Enum: "status", Value: "ACTIVE"}, enumErr)
+}
+
+func TestEnumCaseInsensitive(t *testing.T) {
+	e := NewEnum("status", "active", "archived")
+	fold := e.CaseInsensitive()
+
+	v, err := fold.Parse("ACTIVE")
+	assert.NoError(t, err)
+	assert.Equal(t, "active", v)
+
+	assert.True(t, fold.Contains("Archived"))
+	assert.False(t, fold.Contains("deleted"))
+
+	// The original enum is unchanged
+	assert.False(t, e.Contains("ACTIVE"))
+}
+
+func TestIntEnum(t *testing.T) {
+	e := NewIntEnum("priority", 1, 2, 3)
+
+	assert.Equal(t, "priority", e.Name())
+	assert.Equal(t, []int64{1, 2, 3}, e.Values())
+	assert.True(t, e.Contains(2))
+	assert.False(t, e.Contains(4))
+	assert.NoError(t, e.Check(3))
+	assert.EqualError(t, e.Check(0), `std: invalid value "0" for enum priority`)
+}
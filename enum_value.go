@@ -0,0 +1,522 @@
+//go:build go1.18
+
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+)
+
+// EnumDefinition declares the allowed values of an EnumString.
+// It is implemented by an empty type returning a package level Enum:
+//
+//	var statuses = std.NewEnum("status", "active", "archived")
+//
+//	type statusEnum struct{}
+//
+//	func (statusEnum) Enum() *std.Enum { return statuses }
+//
+//	type Status = std.EnumString[statusEnum]
+type EnumDefinition interface {
+	Enum() *Enum
+}
+
+// EnumString is a nullable string restricted to the values of the Enum declared by D.
+// Scan, UnmarshalJSON and UnmarshalText return an *EnumError for any other value,
+// and Value does too if an invalid value was set with SetValid.
+type EnumString[D EnumDefinition] struct {
+	Data  string
+	Valid bool
+}
+
+// ParseEnumString creates a new valid EnumString, it returns an *EnumError if s is not allowed.
+func ParseEnumString[D EnumDefinition](s string) (EnumString[D], error) {
+	var e EnumString[D]
+
+	err := e.Set(s)
+
+	return e, err
+}
+
+func (e EnumString[D]) enum() *Enum {
+	var d D
+
+	return d.Enum()
+}
+
+func (e EnumString[D]) toString() String {
+	return NewString(e.Data, e.Valid)
+}
+
+// fromString sets this EnumString to s, null if s is not allowed.
+func (e *EnumString[D]) fromString(s String, err error) error {
+	if err == nil && s.Valid {
+		s.Data, err = e.enum().Parse(s.Data)
+	}
+
+	if err != nil || !s.Valid {
+		e.Data, e.Valid = "", false
+
+		return err
+	}
+
+	e.Data, e.Valid = s.Data, true
+
+	return nil
+}
+
+// Scan implements the Scanner interface.
+func (e *EnumString[D]) Scan(value interface{}) error {
+	var s String
+
+	err := s.Scan(value)
+
+	return e.fromString(s, err)
+}
+
+// Value implements the driver Valuer interface.
+func (e EnumString[D]) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+
+	return e.enum().Parse(e.Data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (e *EnumString[D]) UnmarshalJSON(data []byte) error {
+	var s String
+
+	err := s.UnmarshalJSON(data)
+
+	return e.fromString(s, err)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this EnumString is null.
+func (e EnumString[D]) MarshalJSON() ([]byte, error) {
+	return e.toString().MarshalJSON()
+}
+
+// AppendJSON appends the JSON encoding of this EnumString to b, null if this EnumString is null.
+func (e EnumString[D]) AppendJSON(b []byte) ([]byte, error) {
+	return e.toString().AppendJSON(b)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null EnumString if the input is a blank string.
+func (e *EnumString[D]) UnmarshalText(text []byte) error {
+	var s String
+
+	err := s.UnmarshalText(text)
+
+	return e.fromString(s, err)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string when this EnumString is null.
+func (e EnumString[D]) MarshalText() ([]byte, error) {
+	return e.toString().MarshalText()
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if this EnumString is null.
+func (e EnumString[D]) AppendText(b []byte) ([]byte, error) {
+	return e.toString().AppendText(b)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this EnumString is null.
+func (e EnumString[D]) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(enc, start, e.Valid, e.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null EnumString, an empty element is a null EnumString.
+func (e *EnumString[D]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, e)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this EnumString is null.
+func (e EnumString[D]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, e.Valid, e.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (e *EnumString[D]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return e.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the binary layout of String.
+func (e EnumString[D]) MarshalBinary() ([]byte, error) {
+	return e.toString().MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (e *EnumString[D]) UnmarshalBinary(data []byte) error {
+	var v String
+
+	err := v.UnmarshalBinary(data)
+
+	return e.fromString(v, err)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (e EnumString[D]) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (e *EnumString[D]) GobDecode(data []byte) error {
+	return e.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this EnumString is null.
+func (e EnumString[D]) MarshalYAML() (interface{}, error) {
+	return e.toString().MarshalYAML()
+}
+
+// unmarshalYAML decodes this EnumString with the unmarshal func of a YAML library.
+func (e *EnumString[D]) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var v String
+
+	err := v.unmarshalYAML(unmarshal)
+
+	return e.fromString(v, err)
+}
+
+// Set changes this EnumString's value and sets it to be non-null,
+// it returns an *EnumError and sets it to be null if v is not allowed.
+func (e *EnumString[D]) Set(v string) error {
+	return e.fromString(StringFrom(v), nil)
+}
+
+// SetValid changes this EnumString's value and also sets it to be non-null.
+// The value is not checked, use Set to reject the values which are not allowed.
+func (e *EnumString[D]) SetValid(v string) {
+	e.Data = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this EnumString's value, or a nil pointer if this EnumString is null.
+func (e EnumString[D]) Ptr() *string {
+	return e.toString().Ptr()
+}
+
+// IsZero returns true for null EnumStrings, for potential future omitempty support.
+func (e EnumString[D]) IsZero() bool {
+	return !e.Valid
+}
+
+// String implements fmt.Stringer interface.
+func (e EnumString[D]) String() string {
+	return e.Data
+}
+
+// Equal reports whether e and o are both null or both valid with the same value.
+func (e EnumString[D]) Equal(o EnumString[D]) bool {
+	return e.toString().Equal(o.toString())
+}
+
+// Compare returns -1 if e is lexicographically less than o, 0 if they are equal
+// and +1 if e is greater than o. Null values are ordered according to nulls.
+func (e EnumString[D]) Compare(o EnumString[D], nulls NullOrder) int {
+	return e.toString().Compare(o.toString(), nulls)
+}
+
+// ValueOr returns this EnumString's value, or def if this EnumString is null.
+func (e EnumString[D]) ValueOr(def string) string {
+	return e.toString().ValueOr(def)
+}
+
+// ValueOrZero returns this EnumString's value, or the zero value of string if this EnumString is null.
+func (e EnumString[D]) ValueOrZero() string {
+	return e.toString().ValueOrZero()
+}
+
+// OrElse returns this EnumString's value, or the result of fn if this EnumString is null.
+// fn is only called when this EnumString is null.
+func (e EnumString[D]) OrElse(fn func() string) string {
+	return e.toString().OrElse(fn)
+}
+
+// Get returns this EnumString's value and true, or the zero value of string and false if this EnumString is null.
+func (e EnumString[D]) Get() (string, bool) {
+	return e.toString().Get()
+}
+
+// MustGet returns this EnumString's value, it panics if this EnumString is null.
+func (e EnumString[D]) MustGet() string {
+	if !e.Valid {
+		panic("std: MustGet called on a null EnumString")
+	}
+
+	return e.Data
+}
+
+// EnumValues returns the allowed values, for schema generation.
+func (e EnumString[D]) EnumValues() []string {
+	return e.enum().Values()
+}
+
+// IntEnumDefinition declares the allowed values of an EnumInt.
+// It is implemented by an empty type returning a package level IntEnum:
+//
+//	var priorities = std.NewIntEnum("priority", 1, 2, 3)
+//
+//	type priorityEnum struct{}
+//
+//	func (priorityEnum) IntEnum() *std.IntEnum { return priorities }
+//
+//	type Priority = std.EnumInt[priorityEnum]
+type IntEnumDefinition interface {
+	IntEnum() *IntEnum
+}
+
+// EnumInt is a nullable int64 restricted to the values of the IntEnum declared by D.
+// Scan, UnmarshalJSON and UnmarshalText return an *EnumError for any other value,
+// and Value does too if an invalid value was set with SetValid.
+type EnumInt[D IntEnumDefinition] struct {
+	Data  int64
+	Valid bool
+}
+
+// ParseEnumInt creates a new valid EnumInt, it returns an *EnumError if i is not allowed.
+func ParseEnumInt[D IntEnumDefinition](i int64) (EnumInt[D], error) {
+	var e EnumInt[D]
+
+	err := e.Set(i)
+
+	return e, err
+}
+
+func (e EnumInt[D]) enum() *IntEnum {
+	var d D
+
+	return d.IntEnum()
+}
+
+func (e EnumInt[D]) toInt() Int {
+	return NewInt(e.Data, e.Valid)
+}
+
+// fromInt sets this EnumInt to i, null if i is not allowed.
+func (e *EnumInt[D]) fromInt(i Int, err error) error {
+	if err == nil && i.Valid {
+		err = e.enum().Check(i.Data)
+	}
+
+	if err != nil || !i.Valid {
+		e.Data, e.Valid = 0, false
+
+		return err
+	}
+
+	e.Data, e.Valid = i.Data, true
+
+	return nil
+}
+
+// Scan implements the Scanner interface.
+func (e *EnumInt[D]) Scan(value interface{}) error {
+	var i Int
+
+	err := i.Scan(value)
+
+	return e.fromInt(i, err)
+}
+
+// Value implements the driver Valuer interface.
+func (e EnumInt[D]) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+
+	if err := e.enum().Check(e.Data); err != nil {
+		return nil, err
+	}
+
+	return e.Data, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number and null input.
+func (e *EnumInt[D]) UnmarshalJSON(data []byte) error {
+	var i Int
+
+	err := i.UnmarshalJSON(data)
+
+	return e.fromInt(i, err)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this EnumInt is null.
+func (e EnumInt[D]) MarshalJSON() ([]byte, error) {
+	return e.toInt().MarshalJSON()
+}
+
+// AppendJSON appends the JSON encoding of this EnumInt to b, null if this EnumInt is null.
+func (e EnumInt[D]) AppendJSON(b []byte) ([]byte, error) {
+	return e.toInt().AppendJSON(b)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null EnumInt if the input is blank or "null".
+func (e *EnumInt[D]) UnmarshalText(text []byte) error {
+	var i Int
+
+	err := i.UnmarshalText(text)
+
+	return e.fromInt(i, err)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this EnumInt is null.
+func (e EnumInt[D]) MarshalText() ([]byte, error) {
+	return e.toInt().MarshalText()
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if this EnumInt is null.
+func (e EnumInt[D]) AppendText(b []byte) ([]byte, error) {
+	return e.toInt().AppendText(b)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this EnumInt is null.
+func (e EnumInt[D]) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(enc, start, e.Valid, e.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null EnumInt, an empty element is a null EnumInt.
+func (e *EnumInt[D]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, e)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this EnumInt is null.
+func (e EnumInt[D]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, e.Valid, e.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (e *EnumInt[D]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return e.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, with the binary layout of Int.
+func (e EnumInt[D]) MarshalBinary() ([]byte, error) {
+	return e.toInt().MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (e *EnumInt[D]) UnmarshalBinary(data []byte) error {
+	var v Int
+
+	err := v.UnmarshalBinary(data)
+
+	return e.fromInt(v, err)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (e EnumInt[D]) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (e *EnumInt[D]) GobDecode(data []byte) error {
+	return e.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this EnumInt is null.
+func (e EnumInt[D]) MarshalYAML() (interface{}, error) {
+	return e.toInt().MarshalYAML()
+}
+
+// unmarshalYAML decodes this EnumInt with the unmarshal func of a YAML library.
+func (e *EnumInt[D]) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var v Int
+
+	err := v.unmarshalYAML(unmarshal)
+
+	return e.fromInt(v, err)
+}
+
+// Set changes this EnumInt's value and sets it to be non-null,
+// it returns an *EnumError and sets it to be null if v is not allowed.
+func (e *EnumInt[D]) Set(v int64) error {
+	return e.fromInt(IntFrom(v), nil)
+}
+
+// SetValid changes this EnumInt's value and also sets it to be non-null.
+// The value is not checked, use Set to reject the values which are not allowed.
+func (e *EnumInt[D]) SetValid(v int64) {
+	e.Data = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this EnumInt's value, or a nil pointer if this EnumInt is null.
+func (e EnumInt[D]) Ptr() *int64 {
+	return e.toInt().Ptr()
+}
+
+// IsZero returns true for null EnumInts, for potential future omitempty support.
+func (e EnumInt[D]) IsZero() bool {
+	return !e.Valid
+}
+
+// String implements fmt.Stringer interface.
+func (e EnumInt[D]) String() string {
+	return e.toInt().String()
+}
+
+// Equal reports whether e and o are both null or both valid with the same value.
+func (e EnumInt[D]) Equal(o EnumInt[D]) bool {
+	return e.toInt().Equal(o.toInt())
+}
+
+// Compare returns -1 if e is less than o, 0 if they are equal and +1 if e is greater than o.
+// Null values are ordered according to nulls.
+func (e EnumInt[D]) Compare(o EnumInt[D], nulls NullOrder) int {
+	return e.toInt().Compare(o.toInt(), nulls)
+}
+
+// ValueOr returns this EnumInt's value, or def if this EnumInt is null.
+func (e EnumInt[D]) ValueOr(def int64) int64 {
+	return e.toInt().ValueOr(def)
+}
+
+// ValueOrZero returns this EnumInt's value, or the zero value of int64 if this EnumInt is null.
+func (e EnumInt[D]) ValueOrZero() int64 {
+	return e.toInt().ValueOrZero()
+}
+
+// OrElse returns this EnumInt's value, or the result of fn if this EnumInt is null.
+// fn is only called when this EnumInt is null.
+func (e EnumInt[D]) OrElse(fn func() int64) int64 {
+	return e.toInt().OrElse(fn)
+}
+
+// Get returns this EnumInt's value and true, or the zero value of int64 and false if this EnumInt is null.
+func (e EnumInt[D]) Get() (int64, bool) {
+	return e.toInt().Get()
+}
+
+// MustGet returns this EnumInt's value, it panics if this EnumInt is null.
+func (e EnumInt[D]) MustGet() int64 {
+	if !e.Valid {
+		panic("std: MustGet called on a null EnumInt")
+	}
+
+	return e.Data
+}
+
+// EnumValues returns the allowed values, for schema generation.
+func (e EnumInt[D]) EnumValues() []int64 {
+	return e.enum().Values()
+}
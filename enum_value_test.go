@@ -0,0 +1,336 @@
+//go:build go1.18
+
+package std
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testStatuses   = NewEnum("status", "active", "archived")
+	testPriorities = NewIntEnum("priority", 1, 2, 3)
+)
+
+type testStatusEnum struct{}
+
+func (testStatusEnum) Enum() *Enum {
+	return testStatuses
+}
+
+type testFoldStatusEnum struct{}
+
+func (testFoldStatusEnum) Enum() *Enum {
+	return testStatuses.CaseInsensitive()
+}
+
+type testPriorityEnum struct{}
+
+func (testPriorityEnum) IntEnum() *IntEnum {
+	return testPriorities
+}
+
+type (
+	testStatus     = EnumString[testStatusEnum]
+	testFoldStatus = EnumString[testFoldStatusEnum]
+	testPriority   = EnumInt[testPriorityEnum]
+)
+
+var (
+	_ sql.Scanner                = (*testStatus)(nil)
+	_ driver.Valuer              = testStatus{}
+	_ json.Marshaler             = testStatus{}
+	_ json.Unmarshaler           = (*testStatus)(nil)
+	_ encoding.TextMarshaler     = testStatus{}
+	_ encoding.TextUnmarshaler   = (*testStatus)(nil)
+	_ xml.Marshaler              = testStatus{}
+	_ xml.Unmarshaler            = (*testStatus)(nil)
+	_ xml.MarshalerAttr          = testStatus{}
+	_ xml.UnmarshalerAttr        = (*testStatus)(nil)
+	_ encoding.BinaryMarshaler   = testStatus{}
+	_ encoding.BinaryUnmarshaler = (*testStatus)(nil)
+	_ gob.GobEncoder             = testStatus{}
+	_ gob.GobDecoder             = (*testStatus)(nil)
+	_ yamlMarshaler              = testStatus{}
+	_ yamlUnmarshaler            = (*testStatus)(nil)
+	_ fmt.Stringer               = testStatus{}
+
+	_ sql.Scanner                = (*testPriority)(nil)
+	_ driver.Valuer              = testPriority{}
+	_ json.Marshaler             = testPriority{}
+	_ json.Unmarshaler           = (*testPriority)(nil)
+	_ encoding.TextMarshaler     = testPriority{}
+	_ encoding.TextUnmarshaler   = (*testPriority)(nil)
+	_ xml.Marshaler              = testPriority{}
+	_ xml.Unmarshaler            = (*testPriority)(nil)
+	_ xml.MarshalerAttr          = testPriority{}
+	_ xml.UnmarshalerAttr        = (*testPriority)(nil)
+	_ encoding.BinaryMarshaler   = testPriority{}
+	_ encoding.BinaryUnmarshaler = (*testPriority)(nil)
+	_ gob.GobEncoder             = testPriority{}
+	_ gob.GobDecoder             = (*testPriority)(nil)
+	_ yamlMarshaler              = testPriority{}
+	_ yamlUnmarshaler            = (*testPriority)(nil)
+	_ fmt.Stringer               = testPriority{}
+)
+
+func TestParseEnumString(t *testing.T) {
+	s, err := ParseEnumString[testStatusEnum]("active")
+	assert.NoError(t, err)
+	assert.True(t, s.Valid)
+	assert.Equal(t, "active", s.Data)
+
+	s, err = ParseEnumString[testStatusEnum]("deleted")
+	assert.EqualError(t, err, `std: invalid value "deleted" for enum status`)
+	assert.False(t, s.Valid)
+
+	f, err := ParseEnumString[testFoldStatusEnum]("ARCHIVED")
+	assert.NoError(t, err)
+	assert.Equal(t, "archived", f.Data)
+}
+
+func TestEnumStringScan(t *testing.T) {
+	var s testStatus
+
+	assert.NoError(t, s.Scan([]byte("archived")))
+	assert.True(t, s.Valid)
+	assert.Equal(t, "archived", s.Data)
+
+	assert.NoError(t, s.Scan(nil))
+	assert.False(t, s.Valid)
+
+	s.SetValid("active")
+
+	err := s.Scan("deleted")
+
+	var enumErr *EnumError
+	assert.True(t, errors.As(err, &enumErr))
+	assert.Equal(t, "deleted", enumErr.Value)
+	assert.False(t, s.Valid)
+	assert.Equal(t, "", s.Data)
+
+	var f testFoldStatus
+
+	assert.NoError(t, f.Scan("Active"))
+	assert.Equal(t, "active", f.Data)
+}
+
+func TestEnumStringValue(t *testing.T) {
+	var s testStatus
+
+	v, err := s.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	s.SetValid("active")
+
+	v, err = s.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "active", v)
+
+	// SetValid does not check the value, Value does
+	s.SetValid("deleted")
+
+	_, err = s.Value()
+	assert.EqualError(t, err, `std: invalid value "deleted" for enum status`)
+
+	assert.EqualError(t, s.Set("deleted"), `std: invalid value "deleted" for enum status`)
+	assert.False(t, s.Valid)
+}
+
+func TestEnumStringJSON(t *testing.T) {
+	var v struct {
+		Status testStatus
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"Status":"archived"}`), &v))
+	assert.Equal(t, "archived", v.Status.ValueOrZero())
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Status":"archived"}`, string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"Status":null}`), &v))
+	assert.False(t, v.Status.Valid)
+
+	data, err = json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Status":null}`, string(data))
+
+	err = json.Unmarshal([]byte(`{"Status":"deleted"}`), &v)
+
+	var enumErr *EnumError
+	assert.True(t, errors.As(err, &enumErr))
+	assert.False(t, v.Status.Valid)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"Status":1}`), &v))
+}
+
+func TestEnumStringText(t *testing.T) {
+	var s testStatus
+
+	assert.NoError(t, s.UnmarshalText([]byte("active")))
+	assert.Equal(t, "active", s.ValueOr("archived"))
+
+	text, err := s.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "active", string(text))
+
+	assert.NoError(t, s.UnmarshalText(nil))
+	assert.False(t, s.Valid)
+	assert.Equal(t, "archived", s.ValueOr("archived"))
+
+	assert.EqualError(t, s.UnmarshalText([]byte("deleted")), `std: invalid value "deleted" for enum status`)
+}
+
+func TestEnumStringValues(t *testing.T) {
+	var s testStatus
+
+	assert.Equal(t, []string{"active", "archived"}, s.EnumValues())
+	assert.Nil(t, s.Ptr())
+	assert.True(t, s.IsZero())
+
+	s.SetValid("active")
+	assert.Equal(t, "active", *s.Ptr())
+	assert.Equal(t, "active", s.String())
+	assert.True(t, s.Equal(testStatus{Data: "active", Valid: true}))
+	assert.False(t, s.Equal(testStatus{}))
+
+	v, ok := s.Get()
+	assert.True(t, ok)
+	assert.Equal(t, "active", v)
+}
+
+func TestParseEnumInt(t *testing.T) {
+	p, err := ParseEnumInt[testPriorityEnum](2)
+	assert.NoError(t, err)
+	assert.True(t, p.Valid)
+	assert.Equal(t, int64(2), p.Data)
+
+	p, err = ParseEnumInt[testPriorityEnum](4)
+	assert.EqualError(t, err, `std: invalid value "4" for enum priority`)
+	assert.False(t, p.Valid)
+}
+
+func TestEnumInt(t *testing.T) {
+	var p testPriority
+
+	assert.NoError(t, p.Scan(int64(3)))
+	assert.Equal(t, int64(3), p.ValueOrZero())
+
+	v, err := p.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), v)
+
+	assert.EqualError(t, p.Scan([]byte("5")), `std: invalid value "5" for enum priority`)
+	assert.False(t, p.Valid)
+
+	p.SetValid(5)
+
+	_, err = p.Value()
+	assert.Error(t, err)
+
+	assert.NoError(t, json.Unmarshal([]byte(`1`), &p))
+	assert.Equal(t, int64(1), p.Data)
+
+	data, err := json.Marshal(p)
+	assert.NoError(t, err)
+	assert.Equal(t, `1`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`7`), &p))
+	assert.False(t, p.Valid)
+
+	assert.NoError(t, p.UnmarshalText([]byte("2")))
+	assert.Equal(t, "2", p.String())
+
+	assert.Error(t, p.UnmarshalText([]byte("0")))
+	assert.Equal(t, []int64{1, 2, 3}, p.EnumValues())
+}
+
+func TestEnumAccessors(t *testing.T) {
+	active, _ := ParseEnumString[testStatusEnum]("active")
+	archived, _ := ParseEnumString[testStatusEnum]("archived")
+
+	assert.Equal(t, -1, active.Compare(archived, NullsFirst))
+	assert.Equal(t, 1, active.Compare(testStatus{}, NullsFirst))
+	assert.Equal(t, -1, active.Compare(testStatus{}, NullsLast))
+	assert.Equal(t, "active", active.MustGet())
+	assert.Equal(t, "active", active.OrElse(func() string { return "archived" }))
+	assert.Equal(t, "archived", testStatus{}.OrElse(func() string { return "archived" }))
+	assert.PanicsWithValue(t, "std: MustGet called on a null EnumString", func() { testStatus{}.MustGet() })
+
+	low, _ := ParseEnumInt[testPriorityEnum](1)
+	high, _ := ParseEnumInt[testPriorityEnum](3)
+
+	assert.Equal(t, 1, high.Compare(low, NullsFirst))
+	assert.Equal(t, 0, low.Compare(low, NullsFirst))
+	assert.Equal(t, -1, testPriority{}.Compare(low, NullsFirst))
+	assert.Equal(t, int64(3), high.MustGet())
+	assert.Equal(t, int64(2), testPriority{}.OrElse(func() int64 { return 2 }))
+	assert.PanicsWithValue(t, "std: MustGet called on a null EnumInt", func() { testPriority{}.MustGet() })
+}
+
+func TestEnumNullableSuite(t *testing.T) {
+	t.Run("EnumString", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &testStatus{} },
+			Value: "active",
+			Scan: []stdtest.ScanCase{
+				{Src: []byte("archived"), Want: "archived"},
+				{Src: "deleted", Err: true},
+			},
+		})
+	})
+
+	t.Run("EnumInt", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &testPriority{} },
+			Value: int64(2),
+			Scan: []stdtest.ScanCase{
+				{Src: "3", Want: int64(3)},
+				{Src: int64(4), Err: true},
+			},
+		})
+	})
+}
+
+func TestEnumEncodings(t *testing.T) {
+	status, _ := ParseEnumString[testStatusEnum]("active")
+
+	for _, v := range []testStatus{status, {}} {
+		testTextEncodings(t, v, func() interface{} { return &testStatus{} })
+	}
+
+	priority, _ := ParseEnumInt[testPriorityEnum](2)
+
+	for _, v := range []testPriority{priority, {}} {
+		testTextEncodings(t, v, func() interface{} { return &testPriority{} })
+	}
+
+	// Values not allowed by the enum are rejected
+	data, err := StringFrom("deleted").MarshalBinary()
+	assert.NoError(t, err)
+
+	var s testStatus
+
+	assert.Error(t, s.UnmarshalBinary(data))
+	assert.False(t, s.Valid)
+
+	assert.Error(t, xml.Unmarshal([]byte(`<status>deleted</status>`), &s))
+	assert.False(t, s.Valid)
+
+	var p testPriority
+
+	assert.Error(t, p.unmarshalYAML(yamlUnmarshal(5)))
+	assert.False(t, p.Valid)
+}
@@ -4,7 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
-	"reflect"
+	"math"
 	"strconv"
 )
 
@@ -46,9 +46,10 @@ func (f *Float) Scan(value interface{}) error {
 		return nil
 	}
 
-	f.Valid = true
+	err := scanFloat64(&f.Data, value)
+	f.Valid = err == nil
 
-	return convertAssign(&f.Data, value)
+	return err
 }
 
 // Value implements the driver Valuer interface.
@@ -65,24 +66,33 @@ func (f Float) Value() (driver.Value, error) {
 // 0 will not be considered a null Float.
 // It also supports unmarshalling a sql.NullFloat64.
 func (f *Float) UnmarshalJSON(data []byte) error {
-	var (
-		err error
-		v   interface{}
-	)
-
-	if err = json.Unmarshal(data, &v); err != nil {
+	kind, err := jsonKindOf(data)
+	if err != nil {
 		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Float: %w", string(data), err)
 	}
 
-	switch x := v.(type) {
-	case float64:
-		f.Data = float64(x)
-	case nil:
+	switch kind {
+	case jsonNumber:
+		var n float64
+
+		if n, err = strconv.ParseFloat(string(data), 64); err != nil {
+			// Let encoding/json report out of range numbers
+			if err = json.Unmarshal(data, &n); err != nil {
+				return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Float: %w", string(data), err)
+			}
+		}
+
+		f.Data = n
+	case jsonObject:
+		f.Valid, err = unmarshalNullObject(data, "Float64", "null.Float", &f.Data)
+
+		return err
+	case jsonNull:
 		f.Valid = false
 
 		return nil
 	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Float", reflect.TypeOf(v).Name())
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Float", kind)
 	}
 
 	f.Valid = err == nil
@@ -113,21 +123,32 @@ func (f *Float) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Float is null.
 func (f Float) MarshalJSON() ([]byte, error) {
+	return f.AppendJSON(make([]byte, 0, 24))
+}
+
+// AppendJSON appends the JSON encoding of this Float to b, null if this Float is null.
+func (f Float) AppendJSON(b []byte) ([]byte, error) {
 	if !f.Valid {
-		return []byte("null"), nil
+		return append(b, nullType...), nil
 	}
 
-	return []byte(strconv.FormatFloat(f.Data, 'f', -1, 64)), nil
+	return strconv.AppendFloat(b, f.Data, 'f', -1, 64), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Float is null.
 func (f Float) MarshalText() ([]byte, error) {
+	return f.AppendText(make([]byte, 0, 24))
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if this Float is null.
+func (f Float) AppendText(b []byte) ([]byte, error) {
 	if !f.Valid {
-		return []byte{}, nil
+		return b, nil
 	}
 
-	return []byte(strconv.FormatFloat(f.Data, 'f', -1, 64)), nil
+	return strconv.AppendFloat(b, f.Data, 'f', -1, 64), nil
 }
 
 // SetValid changes this Float's value and also sets it to be non-null.
@@ -159,3 +180,142 @@ func (f Float) String() string {
 
 	return strconv.FormatFloat(f.Data, 'f', -1, 64)
 }
+
+// Equal reports whether f and o are both null or both valid with the same value.
+// Two NaNs are equal, as they are for Compare.
+func (f Float) Equal(o Float) bool {
+	return f.Valid == o.Valid && (!f.Valid || f.Data == o.Data || (math.IsNaN(f.Data) && math.IsNaN(o.Data)))
+}
+
+// Compare returns -1 if f is less than o, 0 if they are equal and +1 if f is greater than o.
+// A NaN is considered less than any non-NaN and equal to another NaN,
+// null values are ordered according to nulls.
+func (f Float) Compare(o Float, nulls NullOrder) int {
+	if c, ok := compareNull(f.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	fNaN, oNaN := math.IsNaN(f.Data), math.IsNaN(o.Data)
+
+	switch {
+	case fNaN && oNaN:
+		return 0
+	case fNaN || f.Data < o.Data:
+		return -1
+	case oNaN || f.Data > o.Data:
+		return 1
+	}
+
+	return 0
+}
+
+// ValueOr returns this Float's value, or def if this Float is null.
+func (f Float) ValueOr(def float64) float64 {
+	if !f.Valid {
+		return def
+	}
+
+	return f.Data
+}
+
+// ValueOrZero returns this Float's value, or the zero value of float64 if this Float is null.
+func (f Float) ValueOrZero() float64 {
+	if !f.Valid {
+		return 0
+	}
+
+	return f.Data
+}
+
+// OrElse returns this Float's value, or the result of fn if this Float is null.
+// fn is only called when this Float is null.
+func (f Float) OrElse(fn func() float64) float64 {
+	if !f.Valid {
+		return fn()
+	}
+
+	return f.Data
+}
+
+// Get returns this Float's value and true, or the zero value of float64 and false if this Float is null.
+func (f Float) Get() (float64, bool) {
+	return f.ValueOrZero(), f.Valid
+}
+
+// MustGet returns this Float's value, it panics if this Float is null.
+func (f Float) MustGet() float64 {
+	if !f.Valid {
+		panic("std: MustGet called on a null Float")
+	}
+
+	return f.Data
+}
+
+// Map returns a Float holding the result of fn applied to this Float's value,
+// or a null Float if this Float is null. fn is only called when this Float is valid.
+func (f Float) Map(fn func(float64) float64) Float {
+	if !f.Valid {
+		return Float{}
+	}
+
+	return NewFloat(fn(f.Data), true)
+}
+
+// FlatMap returns the result of fn applied to this Float's value,
+// or a null Float if this Float is null. fn is only called when this Float is valid.
+func (f Float) FlatMap(fn func(float64) Float) Float {
+	if !f.Valid {
+		return Float{}
+	}
+
+	return fn(f.Data)
+}
+
+// Filter returns this Float if it is valid and its value satisfies fn,
+// otherwise it returns a null Float.
+func (f Float) Filter(fn func(float64) bool) Float {
+	if !f.Valid || !fn(f.Data) {
+		return Float{}
+	}
+
+	return f
+}
+
+// ToInt converts this Float to an Int.
+// It returns ErrLossyConversion if the value is not a whole number in the int64 range.
+func (f Float) ToInt() (Int, error) {
+	if !f.Valid {
+		return Int{}, nil
+	}
+
+	i, err := floatToInt(f.Data)
+	if err != nil {
+		return Int{}, err
+	}
+
+	return IntFrom(i), nil
+}
+
+// ToUint converts this Float to an Uint.
+// It returns ErrLossyConversion if the value is not a whole number in the uint64 range.
+func (f Float) ToUint() (Uint, error) {
+	if !f.Valid {
+		return Uint{}, nil
+	}
+
+	i, err := floatToUint(f.Data)
+	if err != nil {
+		return Uint{}, err
+	}
+
+	return UintFrom(i), nil
+}
+
+// ToString converts this Float to a String holding its decimal representation.
+func (f Float) ToString() String {
+	if !f.Valid {
+		return String{}
+	}
+
+	return StringFrom(f.String())
+}
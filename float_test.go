@@ -3,6 +3,7 @@ package std
 import (
 	"encoding/json"
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -196,3 +197,146 @@ func BenchmarkFloatString(b *testing.B) {
 		_ = f.String()
 	}
 }
+
+func TestFloatEqual(t *testing.T) {
+	assert.True(t, FloatFrom(1.2345).Equal(FloatFrom(1.2345)))
+	assert.True(t, Float{}.Equal(NewFloat(1.2345, false)))
+	assert.False(t, FloatFrom(1.2345).Equal(FloatFrom(0)))
+	assert.True(t, FloatFrom(math.NaN()).Equal(FloatFrom(math.NaN())))
+	assert.False(t, FloatFrom(math.NaN()).Equal(FloatFrom(0)))
+	assert.False(t, FloatFrom(0).Equal(Float{}))
+
+	// Equal agrees with Compare
+	values := []Float{{}, FloatFrom(math.NaN()), FloatFrom(math.Inf(-1)), FloatFrom(0), FloatFrom(math.Copysign(0, -1)), FloatFrom(1)}
+	for _, a := range values {
+		for _, b := range values {
+			assert.Equal(t, a.Compare(b, NullsFirst) == 0, a.Equal(b), "%v %v", a, b)
+		}
+	}
+}
+
+func TestFloatCompare(t *testing.T) {
+	assert.Equal(t, -1, FloatFrom(1).Compare(FloatFrom(2), NullsFirst))
+	assert.Equal(t, 1, FloatFrom(2).Compare(FloatFrom(1), NullsFirst))
+	assert.Equal(t, 0, FloatFrom(2).Compare(FloatFrom(2), NullsFirst))
+	assert.Equal(t, -1, FloatFrom(math.NaN()).Compare(FloatFrom(1), NullsFirst))
+	assert.Equal(t, 1, FloatFrom(1).Compare(FloatFrom(math.NaN()), NullsFirst))
+	assert.Equal(t, 0, FloatFrom(math.NaN()).Compare(FloatFrom(math.NaN()), NullsFirst))
+	assert.Equal(t, -1, Float{}.Compare(FloatFrom(1), NullsFirst))
+	assert.Equal(t, 1, Float{}.Compare(FloatFrom(1), NullsLast))
+}
+
+func TestFloatValueOr(t *testing.T) {
+	valid := FloatFrom(1.2345)
+	assert.Equal(t, 1.2345, valid.ValueOr(4.2))
+	assert.Equal(t, 1.2345, valid.ValueOrZero())
+
+	null := Float{}
+	assert.Equal(t, 4.2, null.ValueOr(4.2))
+	assert.Equal(t, 0.0, null.ValueOrZero())
+}
+
+func TestFloatOrElse(t *testing.T) {
+	called := false
+	fallback := func() float64 {
+		called = true
+
+		return 4.2
+	}
+
+	valid := FloatFrom(1.2345)
+	assert.Equal(t, 1.2345, valid.OrElse(fallback))
+	assert.False(t, called)
+
+	null := Float{}
+	assert.Equal(t, 4.2, null.OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestFloatGet(t *testing.T) {
+	v, ok := FloatFrom(1.2345).Get()
+	assert.True(t, ok)
+	assert.Equal(t, 1.2345, v)
+
+	v, ok = Float{}.Get()
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, v)
+}
+
+func TestFloatMustGet(t *testing.T) {
+	assert.Equal(t, 1.2345, FloatFrom(1.2345).MustGet())
+
+	assert.Panics(t, func() {
+		Float{}.MustGet()
+	})
+}
+
+func TestFloatMap(t *testing.T) {
+	mapped := FloatFrom(1.2345).Map(func(v float64) float64 { return v * 2 })
+	assert.True(t, mapped.Valid)
+	assert.Equal(t, 2.469, mapped.ValueOrZero())
+
+	called := false
+	null := Float{}.Map(func(v float64) float64 {
+		called = true
+
+		return v
+	})
+	assert.False(t, null.Valid)
+	assert.False(t, called)
+}
+
+func TestFloatFlatMap(t *testing.T) {
+	toNull := func(v float64) Float {
+		return Float{}
+	}
+
+	assert.False(t, FloatFrom(1.2345).FlatMap(toNull).Valid)
+	assert.Equal(t, FloatFrom(1.2345), FloatFrom(1.2345).FlatMap(func(v float64) Float {
+		return FloatFrom(v)
+	}))
+	assert.False(t, Float{}.FlatMap(func(v float64) Float {
+		return FloatFrom(1.2345)
+	}).Valid)
+}
+
+func TestFloatFilter(t *testing.T) {
+	assert.Equal(t, FloatFrom(1.2345), FloatFrom(1.2345).Filter(func(v float64) bool { return v > 0 }))
+	assert.False(t, FloatFrom(1.2345).Filter(func(v float64) bool { return false }).Valid)
+	assert.False(t, Float{}.Filter(func(v float64) bool { return true }).Valid)
+}
+
+func TestFloatToInt(t *testing.T) {
+	i, err := FloatFrom(-12345).ToInt()
+	assert.NoError(t, err)
+	assert.Equal(t, IntFrom(-12345), i)
+
+	i, err = Float{}.ToInt()
+	assert.NoError(t, err)
+	assert.False(t, i.Valid)
+
+	for _, f := range []float64{1.5, math.NaN(), math.Inf(1), math.MaxInt64, -math.MaxFloat64} {
+		_, err = FloatFrom(f).ToInt()
+		assert.ErrorIs(t, err, ErrLossyConversion)
+	}
+}
+
+func TestFloatToUint(t *testing.T) {
+	u, err := FloatFrom(12345).ToUint()
+	assert.NoError(t, err)
+	assert.Equal(t, UintFrom(12345), u)
+
+	u, err = Float{}.ToUint()
+	assert.NoError(t, err)
+	assert.False(t, u.Valid)
+
+	for _, f := range []float64{1.5, -1, math.NaN(), math.MaxUint64} {
+		_, err = FloatFrom(f).ToUint()
+		assert.ErrorIs(t, err, ErrLossyConversion)
+	}
+}
+
+func TestFloatToString(t *testing.T) {
+	assert.Equal(t, StringFrom("1.2345"), FloatFrom(1.2345).ToString())
+	assert.False(t, Float{}.ToString().Valid)
+}
@@ -0,0 +1,527 @@
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"strconv"
+)
+
+// Floats is a nullable []float64, mapping to a Postgres float8[] column.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+// The elements can not be NULL, use NullFloats for arrays with NULL elements.
+type Floats struct {
+	Data  []float64
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewFloats creates a new Floats.
+func NewFloats(data []float64, valid bool) Floats {
+	return Floats{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// FloatsFrom creates a new Floats that will always be valid.
+func FloatsFrom(data []float64) Floats {
+	return NewFloats(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal, a NULL element is an error.
+func (f *Floats) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "Floats")
+	if err == nil && valid {
+		err = f.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		f.Data, f.Dims, f.Valid = nil, nil, false
+
+		return err
+	}
+
+	f.Dims, f.Valid = dims, true
+
+	return nil
+}
+
+func (f *Floats) fromArray(elems []arrayElement) error {
+	var err error
+
+	data := make([]float64, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			return errArrayNull("Floats")
+		}
+
+		if data[k], err = strconv.ParseFloat(e.text, 64); err != nil {
+			return errArrayElement(e.text, "Floats", err)
+		}
+	}
+
+	f.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this Floats is null.
+func (f Floats) Value() (driver.Value, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, f.Dims, len(f.Data), "Floats", func(buf []byte, k int) []byte {
+		return appendArrayFloat(buf, f.Data[k])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input.
+func (f *Floats) UnmarshalJSON(data []byte) error {
+	var d []float64
+
+	dims, valid, err := unmarshalJSONArray(data, "Floats", &d)
+	if err != nil || !valid {
+		f.Data, f.Dims, f.Valid = nil, nil, false
+
+		return err
+	}
+
+	f.Data, f.Dims, f.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Floats is null, and an empty array if it is valid without elements.
+func (f Floats) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(f.Data, len(f.Data), f.Dims, "Floats")
+}
+
+// SetValid changes this Floats's value and also sets it to be non-null.
+func (f *Floats) SetValid(v []float64) {
+	f.Data = v
+	f.Dims = nil
+	f.Valid = true
+}
+
+// IsZero returns true for null Floats, for potential future omitempty support.
+func (f Floats) IsZero() bool {
+	return !f.Valid
+}
+
+// Equal reports whether f and o are both null or both valid with the same elements and dimensions.
+func (f Floats) Equal(o Floats) bool {
+	return f.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if f is less than o, 0 if they are equal and +1 if f is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+func (f Floats) Compare(o Floats, nulls NullOrder) int {
+	if c, ok := compareNull(f.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(f.Data), len(o.Data), f.Dims, o.Dims, func(k int) int {
+		return FloatFrom(f.Data[k]).Compare(FloatFrom(o.Data[k]), nulls)
+	})
+}
+
+// ValueOr returns this Floats's elements, or def if this Floats is null.
+func (f Floats) ValueOr(def []float64) []float64 {
+	if !f.Valid {
+		return def
+	}
+
+	return f.Data
+}
+
+// ValueOrZero returns this Floats's elements, or nil if this Floats is null.
+func (f Floats) ValueOrZero() []float64 {
+	if !f.Valid {
+		return nil
+	}
+
+	return f.Data
+}
+
+// OrElse returns this Floats's elements, or the result of fn if this Floats is null.
+// fn is only called when this Floats is null.
+func (f Floats) OrElse(fn func() []float64) []float64 {
+	if !f.Valid {
+		return fn()
+	}
+
+	return f.Data
+}
+
+// Get returns this Floats's elements and true, or nil and false if this Floats is null.
+func (f Floats) Get() ([]float64, bool) {
+	return f.ValueOrZero(), f.Valid
+}
+
+// MustGet returns this Floats's elements, it panics if this Floats is null.
+func (f Floats) MustGet() []float64 {
+	if !f.Valid {
+		panic("std: MustGet called on a null Floats")
+	}
+
+	return f.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this Floats is null.
+func (f Floats) MarshalText() ([]byte, error) {
+	return marshalArrayText(f)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null Floats.
+func (f *Floats) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, f)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this Floats is null.
+func (f Floats) String() string {
+	text, _ := f.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Floats is null, the array literal otherwise.
+func (f Floats) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, f.Valid, f.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Floats, an empty element is a null Floats.
+func (f *Floats) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Floats is null.
+func (f Floats) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f.Valid, f.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (f *Floats) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (f Floats) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(f.Valid, f.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *Floats) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.Floats", f)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (f Floats) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (f *Floats) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Floats is null, a sequence otherwise.
+func (f Floats) MarshalYAML() (interface{}, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(f.Data, len(f.Data), f.Dims, "Floats")
+}
+
+// unmarshalYAML decodes this Floats with the unmarshal func of a YAML library.
+func (f *Floats) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.Floats", f)
+}
+
+// NullFloats is a nullable []Float, mapping to a Postgres float8[] column with NULL elements.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+type NullFloats struct {
+	Data  []Float
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewNullFloats creates a new NullFloats.
+func NewNullFloats(data []Float, valid bool) NullFloats {
+	return NullFloats{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullFloatsFrom creates a new NullFloats that will always be valid.
+func NullFloatsFrom(data []Float) NullFloats {
+	return NewNullFloats(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal.
+func (f *NullFloats) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "NullFloats")
+	if err == nil && valid {
+		err = f.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		f.Data, f.Dims, f.Valid = nil, nil, false
+
+		return err
+	}
+
+	f.Dims, f.Valid = dims, true
+
+	return nil
+}
+
+func (f *NullFloats) fromArray(elems []arrayElement) error {
+	var err error
+
+	data := make([]Float, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			continue
+		}
+
+		data[k].Valid = true
+
+		if data[k].Data, err = strconv.ParseFloat(e.text, 64); err != nil {
+			return errArrayElement(e.text, "NullFloats", err)
+		}
+	}
+
+	f.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this NullFloats is null.
+func (f NullFloats) Value() (driver.Value, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, f.Dims, len(f.Data), "NullFloats", func(buf []byte, k int) []byte {
+		if !f.Data[k].Valid {
+			return append(buf, "NULL"...)
+		}
+
+		return appendArrayFloat(buf, f.Data[k].Data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input, the elements can be null.
+func (f *NullFloats) UnmarshalJSON(data []byte) error {
+	var d []Float
+
+	dims, valid, err := unmarshalJSONArray(data, "NullFloats", &d)
+	if err != nil || !valid {
+		f.Data, f.Dims, f.Valid = nil, nil, false
+
+		return err
+	}
+
+	f.Data, f.Dims, f.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullFloats is null, and an empty array if it is valid without elements.
+func (f NullFloats) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(f.Data, len(f.Data), f.Dims, "NullFloats")
+}
+
+// SetValid changes this NullFloats's value and also sets it to be non-null.
+func (f *NullFloats) SetValid(v []Float) {
+	f.Data = v
+	f.Dims = nil
+	f.Valid = true
+}
+
+// IsZero returns true for null NullFloats, for potential future omitempty support.
+func (f NullFloats) IsZero() bool {
+	return !f.Valid
+}
+
+// Equal reports whether f and o are both null or both valid with the same elements and dimensions.
+func (f NullFloats) Equal(o NullFloats) bool {
+	return f.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if f is less than o, 0 if they are equal and +1 if f is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+// Null elements are ordered according to nulls too.
+func (f NullFloats) Compare(o NullFloats, nulls NullOrder) int {
+	if c, ok := compareNull(f.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(f.Data), len(o.Data), f.Dims, o.Dims, func(k int) int {
+		return f.Data[k].Compare(o.Data[k], nulls)
+	})
+}
+
+// ValueOr returns this NullFloats's elements, or def if this NullFloats is null.
+func (f NullFloats) ValueOr(def []Float) []Float {
+	if !f.Valid {
+		return def
+	}
+
+	return f.Data
+}
+
+// ValueOrZero returns this NullFloats's elements, or nil if this NullFloats is null.
+func (f NullFloats) ValueOrZero() []Float {
+	if !f.Valid {
+		return nil
+	}
+
+	return f.Data
+}
+
+// OrElse returns this NullFloats's elements, or the result of fn if this NullFloats is null.
+// fn is only called when this NullFloats is null.
+func (f NullFloats) OrElse(fn func() []Float) []Float {
+	if !f.Valid {
+		return fn()
+	}
+
+	return f.Data
+}
+
+// Get returns this NullFloats's elements and true, or nil and false if this NullFloats is null.
+func (f NullFloats) Get() ([]Float, bool) {
+	return f.ValueOrZero(), f.Valid
+}
+
+// MustGet returns this NullFloats's elements, it panics if this NullFloats is null.
+func (f NullFloats) MustGet() []Float {
+	if !f.Valid {
+		panic("std: MustGet called on a null NullFloats")
+	}
+
+	return f.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this NullFloats is null.
+func (f NullFloats) MarshalText() ([]byte, error) {
+	return marshalArrayText(f)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null NullFloats.
+func (f *NullFloats) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, f)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this NullFloats is null.
+func (f NullFloats) String() string {
+	text, _ := f.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this NullFloats is null, the array literal otherwise.
+func (f NullFloats) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, f.Valid, f.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null NullFloats, an empty element is a null NullFloats.
+func (f *NullFloats) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, f)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this NullFloats is null.
+func (f NullFloats) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f.Valid, f.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (f *NullFloats) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (f NullFloats) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(f.Valid, f.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (f *NullFloats) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.NullFloats", f)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (f NullFloats) GobEncode() ([]byte, error) {
+	return f.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (f *NullFloats) GobDecode(data []byte) error {
+	return f.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this NullFloats is null, a sequence otherwise.
+func (f NullFloats) MarshalYAML() (interface{}, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(f.Data, len(f.Data), f.Dims, "NullFloats")
+}
+
+// unmarshalYAML decodes this NullFloats with the unmarshal func of a YAML library.
+func (f *NullFloats) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.NullFloats", f)
+}
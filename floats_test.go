@@ -0,0 +1,67 @@
+package std
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloats(t *testing.T) {
+	var f Floats
+
+	assert.NoError(t, f.Scan(`{1.5,-2,1e+30,Infinity,-Infinity,NaN}`))
+	assert.True(t, f.Valid)
+	assert.Equal(t, []float64{1.5, -2, 1e30, math.Inf(1), math.Inf(-1)}, f.Data[:5])
+	assert.True(t, math.IsNaN(f.Data[5]))
+
+	v, err := f.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{1.5,-2,1e+30,Infinity,-Infinity,NaN}`, v)
+
+	assert.Error(t, f.Scan(`{a}`))
+	assert.False(t, f.Valid)
+
+	assert.NoError(t, json.Unmarshal([]byte(`[1.5,2]`), &f))
+
+	data, err := json.Marshal(f)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1.5,2]`, string(data))
+}
+
+func TestNullFloats(t *testing.T) {
+	var f NullFloats
+
+	assert.NoError(t, f.Scan(`{NULL,1.5}`))
+	assert.Equal(t, []Float{{}, FloatFrom(1.5)}, f.Data)
+
+	v, err := f.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{NULL,1.5}`, v)
+
+	data, err := json.Marshal(f)
+	assert.NoError(t, err)
+	assert.Equal(t, `[null,1.5]`, string(data))
+}
+
+func TestFloatsAccessors(t *testing.T) {
+	a := FloatsFrom([]float64{1.5, math.NaN()})
+
+	assert.True(t, a.Equal(FloatsFrom([]float64{1.5, math.NaN()})))
+	assert.Equal(t, -1, a.Compare(FloatsFrom([]float64{2}), NullsFirst))
+	assert.Equal(t, `{1.5,NaN}`, a.String())
+
+	n := NullFloatsFrom([]Float{FloatFrom(1.5), {}})
+	assert.Equal(t, 1, n.Compare(NullFloatsFrom([]Float{FloatFrom(1.5), FloatFrom(0)}), NullsLast))
+}
+
+func TestFloatsEncodings(t *testing.T) {
+	for _, v := range []Floats{FloatsFrom([]float64{1.5, -2}), {}} {
+		testTextEncodings(t, v, func() interface{} { return &Floats{} })
+	}
+
+	for _, v := range []NullFloats{NullFloatsFrom([]Float{FloatFrom(1.5), {}}), {}} {
+		testTextEncodings(t, v, func() interface{} { return &NullFloats{} })
+	}
+}
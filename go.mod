@@ -2,4 +2,7 @@ module github.com/euskadi31/go-std
 
 go 1.12
 
-require github.com/stretchr/testify v1.11.1
+require (
+	github.com/stretchr/testify v1.11.1
+	gopkg.in/yaml.v3 v3.0.1
+)
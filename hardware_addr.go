@@ -0,0 +1,292 @@
+package std
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// HardwareAddr is a nullable net.HardwareAddr, mapping to a Postgres macaddr or macaddr8 column.
+// It scans from the formats accepted by Postgres and net.ParseMAC,
+// and marshals to the lower case colon separated form, like 08:00:2b:01:02:03.
+type HardwareAddr struct {
+	Data  net.HardwareAddr
+	Valid bool
+}
+
+// NewHardwareAddr creates a new HardwareAddr.
+func NewHardwareAddr(addr net.HardwareAddr, valid bool) HardwareAddr {
+	return HardwareAddr{
+		Data:  addr,
+		Valid: valid,
+	}
+}
+
+// HardwareAddrFrom creates a new HardwareAddr that will be null if addr is nil.
+func HardwareAddrFrom(addr net.HardwareAddr) HardwareAddr {
+	return NewHardwareAddr(addr, addr != nil)
+}
+
+// ParseHardwareAddr parses s as a MAC address.
+// A blank string gives a null HardwareAddr.
+func ParseHardwareAddr(s string) (HardwareAddr, error) {
+	var addr HardwareAddr
+
+	err := addr.UnmarshalText([]byte(s))
+
+	return addr, err
+}
+
+// parseMAC parses the MAC address formats of net.ParseMAC,
+// and the ones of Postgres grouping the hexadecimal digits differently, like 08002b:010203 or 08002b010203.
+func parseMAC(s string) (net.HardwareAddr, error) {
+	addr, err := net.ParseMAC(s)
+	if err == nil {
+		return addr, nil
+	}
+
+	digits := strings.NewReplacer(":", "", "-", "", ".", "").Replace(s)
+	if len(digits) != 12 && len(digits) != 16 {
+		return nil, err // nolint: wrapcheck
+	}
+
+	addr, herr := hex.DecodeString(digits)
+	if herr != nil {
+		return nil, err // nolint: wrapcheck
+	}
+
+	return addr, nil
+}
+
+// Scan implements the Scanner interface.
+func (a *HardwareAddr) Scan(value interface{}) error {
+	var err error
+
+	switch x := value.(type) {
+	case nil:
+		a.Data, a.Valid = nil, false
+
+		return nil
+	case []byte:
+		a.Data, err = parseMAC(string(x))
+	case string:
+		a.Data, err = parseMAC(x)
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.HardwareAddr: %v", value, value)
+	}
+
+	if err != nil {
+		a.Data = nil
+	}
+
+	a.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (a HardwareAddr) Value() (driver.Value, error) {
+	if !a.Valid {
+		return nil, nil
+	}
+
+	return a.Data.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (a *HardwareAddr) UnmarshalJSON(data []byte) error {
+	var s String
+
+	if err := s.UnmarshalJSON(data); err != nil {
+		a.Data, a.Valid = nil, false
+
+		return err
+	}
+
+	if !s.Valid {
+		a.Data, a.Valid = nil, false
+
+		return nil
+	}
+
+	return a.Scan(s.Data)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this HardwareAddr is null.
+func (a HardwareAddr) MarshalJSON() ([]byte, error) {
+	if !a.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(a.Data.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null HardwareAddr if the input is a blank string.
+func (a *HardwareAddr) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		a.Data, a.Valid = nil, false
+
+		return nil
+	}
+
+	return a.Scan(text)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string when this HardwareAddr is null.
+func (a HardwareAddr) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// SetValid changes this HardwareAddr's value and also sets it to be non-null.
+func (a *HardwareAddr) SetValid(v net.HardwareAddr) {
+	a.Data = v
+	a.Valid = true
+}
+
+// Ptr returns a pointer to this HardwareAddr's value, or a nil pointer if this HardwareAddr is null.
+func (a HardwareAddr) Ptr() *net.HardwareAddr {
+	if !a.Valid {
+		return nil
+	}
+
+	return &a.Data
+}
+
+// IsZero returns true for null HardwareAddrs, for potential future omitempty support.
+func (a HardwareAddr) IsZero() bool {
+	return !a.Valid
+}
+
+// String implements fmt.Stringer interface.
+// It returns a blank string if this HardwareAddr is null.
+func (a HardwareAddr) String() string {
+	if !a.Valid {
+		return ""
+	}
+
+	return a.Data.String()
+}
+
+// Equal reports whether a and o are both null or both valid with the same address.
+func (a HardwareAddr) Equal(o HardwareAddr) bool {
+	return a.Valid == o.Valid && (!a.Valid || string(a.Data) == string(o.Data))
+}
+
+// Compare returns -1 if a is less than o, 0 if they are equal and +1 if a is greater than o,
+// comparing the bytes of the addresses. Null values are ordered according to nulls.
+func (a HardwareAddr) Compare(o HardwareAddr, nulls NullOrder) int {
+	if c, ok := compareNull(a.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return bytes.Compare(a.Data, o.Data)
+}
+
+// ValueOr returns this HardwareAddr's value, or def if this HardwareAddr is null.
+func (a HardwareAddr) ValueOr(def net.HardwareAddr) net.HardwareAddr {
+	if !a.Valid {
+		return def
+	}
+
+	return a.Data
+}
+
+// ValueOrZero returns this HardwareAddr's value, or the zero value of net.HardwareAddr if this HardwareAddr is null.
+func (a HardwareAddr) ValueOrZero() net.HardwareAddr {
+	if !a.Valid {
+		return nil
+	}
+
+	return a.Data
+}
+
+// OrElse returns this HardwareAddr's value, or the result of fn if this HardwareAddr is null.
+// fn is only called when this HardwareAddr is null.
+func (a HardwareAddr) OrElse(fn func() net.HardwareAddr) net.HardwareAddr {
+	if !a.Valid {
+		return fn()
+	}
+
+	return a.Data
+}
+
+// Get returns this HardwareAddr's value and true, or the zero value of net.HardwareAddr and false if this HardwareAddr is null.
+func (a HardwareAddr) Get() (net.HardwareAddr, bool) {
+	return a.ValueOrZero(), a.Valid
+}
+
+// MustGet returns this HardwareAddr's value, it panics if this HardwareAddr is null.
+func (a HardwareAddr) MustGet() net.HardwareAddr {
+	if !a.Valid {
+		panic("std: MustGet called on a null HardwareAddr")
+	}
+
+	return a.Data
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this HardwareAddr is null.
+func (a HardwareAddr) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, a.Valid, a.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null HardwareAddr, an empty element is a null HardwareAddr.
+func (a *HardwareAddr) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, a)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this HardwareAddr is null.
+func (a HardwareAddr) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, a.Valid, a.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (a *HardwareAddr) UnmarshalXMLAttr(attr xml.Attr) error {
+	return a.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (a HardwareAddr) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(a.Valid, a.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (a *HardwareAddr) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.HardwareAddr", a)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (a HardwareAddr) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (a *HardwareAddr) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this HardwareAddr is null, its text form otherwise.
+func (a HardwareAddr) MarshalYAML() (interface{}, error) {
+	if !a.Valid {
+		return nil, nil
+	}
+
+	return a.String(), nil
+}
+
+// unmarshalYAML decodes this HardwareAddr with the unmarshal func of a YAML library.
+func (a *HardwareAddr) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, "std.HardwareAddr", a)
+}
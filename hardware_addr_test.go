@@ -0,0 +1,119 @@
+package std
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testHardwareAddr = net.HardwareAddr{0x08, 0x00, 0x2b, 0x01, 0x02, 0x03}
+
+func TestHardwareAddrScan(t *testing.T) {
+	for _, src := range []interface{}{
+		"08:00:2b:01:02:03",
+		"08-00-2B-01-02-03",
+		[]byte("0800.2b01.0203"),
+		"08002b:010203",
+		"08002b-010203",
+		"0800-2b01-0203",
+		"08002b010203",
+	} {
+		var a HardwareAddr
+
+		assert.NoError(t, a.Scan(src), src)
+		assert.Equal(t, HardwareAddrFrom(testHardwareAddr), a, src)
+
+		v, err := a.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "08:00:2b:01:02:03", v)
+	}
+
+	var a HardwareAddr
+
+	assert.NoError(t, a.Scan("08:00:2b:01:02:03:04:05"))
+	assert.Equal(t, "08:00:2b:01:02:03:04:05", a.String())
+
+	assert.Error(t, a.Scan("08:00:2b"))
+	assert.False(t, a.Valid)
+	assert.Error(t, a.Scan("08002b01020x"))
+	assert.EqualError(t, a.Scan(int64(1)), "null: cannot scan type int64 into null.HardwareAddr: 1")
+
+	assert.NoError(t, a.Scan(nil))
+	assert.False(t, a.Valid)
+
+	v, err := a.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestHardwareAddrJSON(t *testing.T) {
+	var a HardwareAddr
+
+	assert.NoError(t, json.Unmarshal([]byte(`"08-00-2B-01-02-03"`), &a))
+	assert.Equal(t, testHardwareAddr, a.Data)
+
+	data, err := json.Marshal(a)
+	assert.NoError(t, err)
+	assert.Equal(t, `"08:00:2b:01:02:03"`, string(data))
+
+	text, err := a.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "08:00:2b:01:02:03", string(text))
+
+	assert.NoError(t, json.Unmarshal(nullJSON, &a))
+	assert.True(t, a.IsZero())
+
+	data, err = json.Marshal(a)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`"x"`), &a))
+	assert.False(t, a.Valid)
+}
+
+func TestParseHardwareAddr(t *testing.T) {
+	a, err := ParseHardwareAddr("08:00:2b:01:02:03")
+	assert.NoError(t, err)
+	assert.True(t, a.Equal(HardwareAddrFrom(testHardwareAddr)))
+	assert.Equal(t, testHardwareAddr, *a.Ptr())
+
+	a, err = ParseHardwareAddr("")
+	assert.NoError(t, err)
+	assert.False(t, a.Valid)
+	assert.Nil(t, a.Ptr())
+	assert.True(t, a.Equal(HardwareAddr{}))
+	assert.False(t, HardwareAddrFrom(nil).Valid)
+}
+
+func TestHardwareAddrAccessors(t *testing.T) {
+	a := HardwareAddrFrom(testHardwareAddr)
+	b, _ := ParseHardwareAddr("08:00:2b:01:02:04")
+
+	assert.Equal(t, -1, a.Compare(b, NullsFirst))
+	assert.Equal(t, 1, b.Compare(a, NullsFirst))
+	assert.Equal(t, 0, a.Compare(a, NullsFirst))
+	assert.Equal(t, -1, HardwareAddr{}.Compare(a, NullsFirst))
+	assert.Equal(t, 1, HardwareAddr{}.Compare(a, NullsLast))
+
+	assert.Equal(t, a.Data, a.ValueOr(b.Data))
+	assert.Equal(t, b.Data, HardwareAddr{}.ValueOr(b.Data))
+	assert.Nil(t, HardwareAddr{}.ValueOrZero())
+	assert.Equal(t, b.Data, HardwareAddr{}.OrElse(func() net.HardwareAddr { return b.Data }))
+
+	v, ok := a.Get()
+	assert.True(t, ok)
+	assert.Equal(t, a.Data, v)
+
+	assert.Equal(t, a.Data, a.MustGet())
+	assert.Panics(t, func() {
+		HardwareAddr{}.MustGet()
+	})
+}
+
+func TestHardwareAddrEncodings(t *testing.T) {
+	for _, v := range []HardwareAddr{HardwareAddrFrom(testHardwareAddr), {}} {
+		testTextEncodings(t, v, func() interface{} { return &HardwareAddr{} })
+	}
+}
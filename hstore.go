@@ -0,0 +1,183 @@
+package std
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidHstore is returned when a Postgres hstore literal is malformed.
+var ErrInvalidHstore = errors.New("std: invalid hstore literal")
+
+// parseHstore parses a Postgres hstore literal like "a"=>"1", "b"=>NULL.
+// Keys and values can be quoted or not, an unquoted NULL value is a null String.
+func parseHstore(src []byte) (map[string]String, error) {
+	p := hstoreParser{src: src}
+	m := make(map[string]String)
+
+	p.skipSpaces()
+
+	for p.pos < len(p.src) {
+		key, quoted, err := p.parseToken()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidHstore, src, err)
+		}
+
+		if !quoted && strings.EqualFold(key, "NULL") {
+			return nil, fmt.Errorf("%w: %q: NULL key", ErrInvalidHstore, src)
+		}
+
+		p.skipSpaces()
+
+		if !strings.HasPrefix(string(p.src[p.pos:]), "=>") {
+			return nil, fmt.Errorf("%w: %q: expected => after key %q", ErrInvalidHstore, src, key)
+		}
+
+		p.pos += 2
+		p.skipSpaces()
+
+		value, quoted, err := p.parseToken()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidHstore, src, err)
+		}
+
+		if !quoted && strings.EqualFold(value, "NULL") {
+			m[key] = String{}
+		} else {
+			m[key] = StringFrom(value)
+		}
+
+		p.skipSpaces()
+
+		if p.pos == len(p.src) {
+			break
+		}
+
+		if p.src[p.pos] != ',' {
+			return nil, fmt.Errorf("%w: %q: unexpected %q", ErrInvalidHstore, src, p.src[p.pos])
+		}
+
+		p.pos++
+		p.skipSpaces()
+
+		if p.pos == len(p.src) {
+			return nil, fmt.Errorf("%w: %q: expected a key after ,", ErrInvalidHstore, src)
+		}
+	}
+
+	return m, nil
+}
+
+type hstoreParser struct {
+	src []byte
+	pos int
+}
+
+func (p *hstoreParser) skipSpaces() {
+	for p.pos < len(p.src) && isArraySpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// parseToken parses a quoted or unquoted key or value.
+func (p *hstoreParser) parseToken() (string, bool, error) {
+	var buf strings.Builder
+
+	if p.pos < len(p.src) && p.src[p.pos] == '"' {
+		p.pos++
+
+		for p.pos < len(p.src) {
+			c := p.src[p.pos]
+			p.pos++
+
+			switch c {
+			case '"':
+				return buf.String(), true, nil
+			case '\\':
+				if p.pos == len(p.src) {
+					return "", false, errors.New("unterminated escape")
+				}
+
+				buf.WriteByte(p.src[p.pos])
+				p.pos++
+			default:
+				buf.WriteByte(c)
+			}
+		}
+
+		return "", false, errors.New("unterminated quoted string")
+	}
+
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+
+		if c == ',' || c == '"' || isArraySpace(c) || strings.HasPrefix(string(p.src[p.pos:]), "=>") {
+			break
+		}
+
+		if c == '\\' {
+			p.pos++
+			if p.pos == len(p.src) {
+				return "", false, errors.New("unterminated escape")
+			}
+
+			c = p.src[p.pos]
+		}
+
+		buf.WriteByte(c)
+		p.pos++
+	}
+
+	if buf.Len() == 0 {
+		return "", false, fmt.Errorf("expected a string at offset %d", p.pos)
+	}
+
+	return buf.String(), false, nil
+}
+
+// appendHstore appends the hstore literal of m to b, with its keys sorted.
+func appendHstore(b []byte, m map[string]String) []byte {
+	for i, key := range sortedKeys(m) {
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+
+		b = appendHstoreString(b, key)
+		b = append(b, "=>"...)
+
+		if v := m[key]; v.Valid {
+			b = appendHstoreString(b, v.Data)
+		} else {
+			b = append(b, "NULL"...)
+		}
+	}
+
+	return b
+}
+
+func appendHstoreString(b []byte, s string) []byte {
+	b = append(b, '"')
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b = append(b, '\\')
+		}
+
+		b = append(b, s[i])
+	}
+
+	return append(b, '"')
+}
+
+func sortedKeys(m map[string]String) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
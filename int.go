@@ -4,7 +4,6 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
-	"reflect"
 	"strconv"
 )
 
@@ -46,9 +45,10 @@ func (i *Int) Scan(value interface{}) error {
 		return nil
 	}
 
-	i.Valid = true
+	err := scanInt64(&i.Data, value)
+	i.Valid = err == nil
 
-	return convertAssign(&i.Data, value)
+	return err
 }
 
 // Value implements the driver Valuer interface.
@@ -65,25 +65,29 @@ func (i Int) Value() (driver.Value, error) {
 // 0 will not be considered a null Int.
 // It also supports unmarshalling a sql.NullInt64.
 func (i *Int) UnmarshalJSON(data []byte) error {
-	var (
-		err error
-		v   interface{}
-	)
-
-	if err = json.Unmarshal(data, &v); err != nil {
+	kind, err := jsonKindOf(data)
+	if err != nil {
 		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Int: %w", string(data), err)
 	}
 
-	switch v.(type) {
-	case float64:
-		// Unmarshal again, directly to int64, to avoid intermediate float64
-		err = json.Unmarshal(data, &i.Data)
-	case nil:
+	switch kind {
+	case jsonNumber:
+		if n, perr := strconv.ParseInt(string(data), 10, 64); perr == nil {
+			i.Data = n
+		} else {
+			// Let encoding/json report non-integer and out of range numbers
+			err = json.Unmarshal(data, &i.Data)
+		}
+	case jsonObject:
+		i.Valid, err = unmarshalNullObject(data, "Int64", "null.Int", &i.Data)
+
+		return err
+	case jsonNull:
 		i.Valid = false
 
 		return nil
 	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", kind)
 	}
 
 	i.Valid = err == nil
@@ -113,21 +117,32 @@ func (i *Int) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Int is null.
 func (i Int) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(make([]byte, 0, 20))
+}
+
+// AppendJSON appends the JSON encoding of this Int to b, null if this Int is null.
+func (i Int) AppendJSON(b []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte("null"), nil
+		return append(b, nullType...), nil
 	}
 
-	return []byte(strconv.FormatInt(i.Data, 10)), nil
+	return strconv.AppendInt(b, i.Data, 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Int is null.
 func (i Int) MarshalText() ([]byte, error) {
+	return i.AppendText(make([]byte, 0, 20))
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if this Int is null.
+func (i Int) AppendText(b []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte{}, nil
+		return b, nil
 	}
 
-	return []byte(strconv.FormatInt(i.Data, 10)), nil
+	return strconv.AppendInt(b, i.Data, 10), nil
 }
 
 // SetValid changes this Int's value and also sets it to be non-null.
@@ -159,3 +174,137 @@ func (i Int) String() string {
 
 	return strconv.Itoa(int(i.Data))
 }
+
+// Equal reports whether i and o are both null or both valid with the same value.
+func (i Int) Equal(o Int) bool {
+	return i.Valid == o.Valid && (!i.Valid || i.Data == o.Data)
+}
+
+// Compare returns -1 if i is less than o, 0 if they are equal and +1 if i is greater than o.
+// Null values are ordered according to nulls.
+func (i Int) Compare(o Int, nulls NullOrder) int {
+	if c, ok := compareNull(i.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	switch {
+	case i.Data < o.Data:
+		return -1
+	case i.Data > o.Data:
+		return 1
+	}
+
+	return 0
+}
+
+// ValueOr returns this Int's value, or def if this Int is null.
+func (i Int) ValueOr(def int64) int64 {
+	if !i.Valid {
+		return def
+	}
+
+	return i.Data
+}
+
+// ValueOrZero returns this Int's value, or the zero value of int64 if this Int is null.
+func (i Int) ValueOrZero() int64 {
+	if !i.Valid {
+		return 0
+	}
+
+	return i.Data
+}
+
+// OrElse returns this Int's value, or the result of fn if this Int is null.
+// fn is only called when this Int is null.
+func (i Int) OrElse(fn func() int64) int64 {
+	if !i.Valid {
+		return fn()
+	}
+
+	return i.Data
+}
+
+// Get returns this Int's value and true, or the zero value of int64 and false if this Int is null.
+func (i Int) Get() (int64, bool) {
+	return i.ValueOrZero(), i.Valid
+}
+
+// MustGet returns this Int's value, it panics if this Int is null.
+func (i Int) MustGet() int64 {
+	if !i.Valid {
+		panic("std: MustGet called on a null Int")
+	}
+
+	return i.Data
+}
+
+// Map returns a Int holding the result of fn applied to this Int's value,
+// or a null Int if this Int is null. fn is only called when this Int is valid.
+func (i Int) Map(fn func(int64) int64) Int {
+	if !i.Valid {
+		return Int{}
+	}
+
+	return NewInt(fn(i.Data), true)
+}
+
+// FlatMap returns the result of fn applied to this Int's value,
+// or a null Int if this Int is null. fn is only called when this Int is valid.
+func (i Int) FlatMap(fn func(int64) Int) Int {
+	if !i.Valid {
+		return Int{}
+	}
+
+	return fn(i.Data)
+}
+
+// Filter returns this Int if it is valid and its value satisfies fn,
+// otherwise it returns a null Int.
+func (i Int) Filter(fn func(int64) bool) Int {
+	if !i.Valid || !fn(i.Data) {
+		return Int{}
+	}
+
+	return i
+}
+
+// ToFloat converts this Int to a Float.
+// It returns ErrLossyConversion if the value cannot be represented exactly by a float64.
+func (i Int) ToFloat() (Float, error) {
+	if !i.Valid {
+		return Float{}, nil
+	}
+
+	f := float64(i.Data)
+
+	// MaxInt64 rounds to 2^63, which is out of the int64 range
+	if v, err := floatToInt(f); err != nil || v != i.Data {
+		return Float{}, lossyErr(i.Data, "float64")
+	}
+
+	return FloatFrom(f), nil
+}
+
+// ToUint converts this Int to an Uint.
+// It returns ErrLossyConversion if the value is negative.
+func (i Int) ToUint() (Uint, error) {
+	if !i.Valid {
+		return Uint{}, nil
+	}
+
+	if i.Data < 0 {
+		return Uint{}, lossyErr(i.Data, "uint64")
+	}
+
+	return UintFrom(uint64(i.Data)), nil
+}
+
+// ToString converts this Int to a String holding its decimal representation.
+func (i Int) ToString() String {
+	if !i.Valid {
+		return String{}
+	}
+
+	return StringFrom(strconv.FormatInt(i.Data, 10))
+}
@@ -0,0 +1,333 @@
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"math"
+	"strconv"
+)
+
+var intRangeKind = &rangeKind{
+	name: "IntRange",
+	compare: func(a, b interface{}) int {
+		x, y := a.(int64), b.(int64) // nolint: forcetypeassert
+
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+
+		return 0
+	},
+	next: func(v interface{}) interface{} {
+		i := v.(int64) // nolint: forcetypeassert
+		if i == math.MaxInt64 {
+			return nil
+		}
+
+		return i + 1
+	},
+	parse: func(s string) (interface{}, error) {
+		return strconv.ParseInt(s, 10, 64)
+	},
+	format: func(b []byte, v interface{}) []byte {
+		return strconv.AppendInt(b, v.(int64), 10) // nolint: forcetypeassert
+	},
+}
+
+// IntRange is a nullable range of int64, mapping to a Postgres int8range column.
+// A null bound is infinite, and Empty is true for the empty range.
+// The ranges are canonical, like Postgres does for discrete types:
+// a finite lower bound is inclusive and a finite upper bound exclusive.
+// It scans from and writes the range literal syntax ([1,5), (,5], empty),
+// and marshals to a JSON object ({"lower":..,"upper":..,"lower_inc":..,"upper_inc":..} or {"empty":true}) or null.
+type IntRange struct {
+	Lower    Int
+	Upper    Int
+	LowerInc bool
+	UpperInc bool
+	Empty    bool
+	Valid    bool
+}
+
+// NewIntRange creates a new valid IntRange, with the bounds made canonical.
+func NewIntRange(lower, upper Int, lowerInc, upperInc bool) IntRange {
+	return IntRange{Lower: lower, Upper: upper, LowerInc: lowerInc, UpperInc: upperInc, Valid: true}.canonical()
+}
+
+// EmptyIntRange creates a new valid empty IntRange.
+func EmptyIntRange() IntRange {
+	return IntRange{Empty: true, Valid: true}
+}
+
+// ParseIntRange parses a range literal like [1,5) into a valid IntRange.
+func ParseIntRange(s string) (IntRange, error) {
+	r, err := intRangeKind.parseRange([]byte(s))
+	if err != nil {
+		return IntRange{}, err
+	}
+
+	return intRangeFromSpan(r), nil
+}
+
+func intRangeFromSpan(s span) IntRange {
+	r := IntRange{LowerInc: s.lowerInc, UpperInc: s.upperInc, Empty: s.empty, Valid: true}
+
+	if v := s.lower; v != nil {
+		r.Lower = IntFrom(v.(int64))
+	}
+
+	if v := s.upper; v != nil {
+		r.Upper = IntFrom(v.(int64))
+	}
+
+	return r
+}
+
+func (r IntRange) span() span {
+	s := span{lowerInc: r.LowerInc, upperInc: r.UpperInc, empty: r.Empty}
+
+	if r.Lower.Valid {
+		s.lower = r.Lower.Data
+	}
+
+	if r.Upper.Valid {
+		s.upper = r.Upper.Data
+	}
+
+	return s
+}
+
+func (r IntRange) canonical() IntRange {
+	return intRangeFromSpan(intRangeKind.canonical(r.span()))
+}
+
+// Scan implements the Scanner interface.
+func (r *IntRange) Scan(value interface{}) error {
+	s, valid, err := intRangeKind.scanRange(value)
+	if err != nil || !valid {
+		*r = IntRange{}
+
+		return err
+	}
+
+	*r = intRangeFromSpan(s)
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the range literal, or nil if this IntRange is null.
+func (r IntRange) Value() (driver.Value, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+
+	return string(intRangeKind.appendRange(nil, r.span())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports object and null input, missing or null bounds are infinite.
+func (r *IntRange) UnmarshalJSON(data []byte) error {
+	obj, valid, err := intRangeKind.unmarshalRangeJSON(data)
+	if err != nil || !valid {
+		*r = IntRange{}
+
+		return err
+	}
+
+	if obj.Empty {
+		*r = EmptyIntRange()
+
+		return nil
+	}
+
+	var lower, upper Int
+
+	if err := unmarshalRangeBound(obj.Lower, &lower); err != nil {
+		*r = IntRange{}
+
+		return err
+	}
+
+	if err := unmarshalRangeBound(obj.Upper, &upper); err != nil {
+		*r = IntRange{}
+
+		return err
+	}
+
+	*r = NewIntRange(lower, upper, obj.LowerInc, obj.UpperInc)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this IntRange is null.
+func (r IntRange) MarshalJSON() ([]byte, error) {
+	return r.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this IntRange to b, null if this IntRange is null.
+func (r IntRange) AppendJSON(b []byte) ([]byte, error) {
+	if !r.Valid {
+		return append(b, nullType...), nil
+	}
+
+	lower, err := r.Lower.MarshalJSON()
+	if err != nil {
+		return b, err
+	}
+
+	upper, err := r.Upper.MarshalJSON()
+	if err != nil {
+		return b, err
+	}
+
+	return appendRangeJSON(b, r.Empty, lower, upper, r.LowerInc, r.UpperInc), nil
+}
+
+// IsZero returns true for null IntRange, for potential future omitempty support.
+func (r IntRange) IsZero() bool {
+	return !r.Valid
+}
+
+// String implements fmt.Stringer interface.
+// It returns the range literal, or a blank string if this IntRange is null.
+func (r IntRange) String() string {
+	if !r.Valid {
+		return ""
+	}
+
+	return string(intRangeKind.appendRange(nil, r.span()))
+}
+
+// Equal reports whether r and o are both null or both valid with the same values.
+func (r IntRange) Equal(o IntRange) bool {
+	return r.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if r is less than o, 0 if they are equal and +1 if r is greater than o,
+// like Postgres does: the empty range first, then by lower bound and by upper bound.
+// Null values are ordered according to nulls.
+func (r IntRange) Compare(o IntRange, nulls NullOrder) int {
+	if c, ok := compareNull(r.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return intRangeKind.compareRanges(r.span(), o.span())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the range literal, or a blank string when this IntRange is null.
+func (r IntRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses a range literal, a blank string is a null IntRange.
+func (r *IntRange) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = IntRange{}
+
+		return nil
+	}
+
+	return r.Scan(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this IntRange is null, the range literal otherwise.
+func (r IntRange) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, r.Valid, r.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null IntRange, an empty element is a null IntRange.
+func (r *IntRange) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, r)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this IntRange is null.
+func (r IntRange) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, r.Valid, r.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (r *IntRange) UnmarshalXMLAttr(attr xml.Attr) error {
+	return r.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r IntRange) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(r.Valid, r.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *IntRange) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.IntRange", r)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (r IntRange) GobEncode() ([]byte, error) {
+	return r.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (r *IntRange) GobDecode(data []byte) error {
+	return r.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this IntRange is null, the range literal otherwise.
+func (r IntRange) MarshalYAML() (interface{}, error) {
+	if !r.Valid {
+		return nil, nil
+	}
+
+	return r.String(), nil
+}
+
+// unmarshalYAML decodes this IntRange with the unmarshal func of a YAML library.
+func (r *IntRange) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, "std.IntRange", r)
+}
+
+// Contains reports whether v is in this IntRange, it is false if this IntRange is null.
+func (r IntRange) Contains(v int64) bool {
+	return r.Valid && intRangeKind.contains(r.span(), v)
+}
+
+// Overlaps reports whether r and o have values in common, like the && operator.
+// It is false if one of them is null.
+func (r IntRange) Overlaps(o IntRange) bool {
+	return r.Valid && o.Valid && intRangeKind.overlaps(r.span(), o.span())
+}
+
+// Intersect returns the values in both r and o, like the * operator.
+// It is null if one of them is null, and empty if they do not overlap.
+func (r IntRange) Intersect(o IntRange) IntRange {
+	if !r.Valid || !o.Valid {
+		return IntRange{}
+	}
+
+	return intRangeFromSpan(intRangeKind.intersect(r.span(), o.span()))
+}
+
+// Union returns the values in r or o, like the + operator.
+// It is null if one of them is null, and returns ErrRangeNotContiguous
+// if the ranges neither overlap nor are adjacent.
+func (r IntRange) Union(o IntRange) (IntRange, error) {
+	if !r.Valid || !o.Valid {
+		return IntRange{}, nil
+	}
+
+	s, err := intRangeKind.union(r.span(), o.span())
+	if err != nil {
+		return IntRange{}, err
+	}
+
+	return intRangeFromSpan(s), nil
+}
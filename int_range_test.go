@@ -0,0 +1,264 @@
+package std
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseIntRange(t *testing.T, s string) IntRange {
+	t.Helper()
+
+	r, err := ParseIntRange(s)
+	assert.NoError(t, err, s)
+
+	return r
+}
+
+func TestNewIntRange(t *testing.T) {
+	r := NewIntRange(IntFrom(1), IntFrom(5), false, true)
+	assert.Equal(t, IntRange{Lower: IntFrom(2), Upper: IntFrom(6), LowerInc: true, Valid: true}, r)
+
+	r = NewIntRange(Int{}, IntFrom(5), true, true)
+	assert.Equal(t, IntRange{Upper: IntFrom(6), Valid: true}, r)
+
+	assert.Equal(t, EmptyIntRange(), NewIntRange(IntFrom(5), IntFrom(5), true, false))
+	assert.Equal(t, EmptyIntRange(), NewIntRange(IntFrom(6), IntFrom(5), true, true))
+	assert.Equal(t, EmptyIntRange(), NewIntRange(IntFrom(math.MaxInt64), Int{}, false, false))
+	assert.Equal(t, IntRange{Lower: IntFrom(1), LowerInc: true, Valid: true}, NewIntRange(IntFrom(1), IntFrom(math.MaxInt64), true, true))
+}
+
+func TestIntRangeLiteral(t *testing.T) {
+	testCases := []struct {
+		src      string
+		expected string
+	}{
+		{`[1,5)`, `[1,5)`},
+		{`[1,5]`, `[1,6)`},
+		{`(1,5)`, `[2,5)`},
+		{` ( 1 , 5 ] `, `[2,6)`},
+		{`(,5)`, `(,5)`},
+		{`[,5]`, `(,6)`},
+		{`[1,)`, `[1,)`},
+		{`(,)`, `(,)`},
+		{`["1","5")`, `[1,5)`},
+		{`[5,5)`, `empty`},
+		{`EMPTY`, `empty`},
+	}
+
+	for _, tc := range testCases {
+		r := mustParseIntRange(t, tc.src)
+		assert.Equal(t, tc.expected, r.String(), tc.src)
+
+		v, err := r.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, v)
+	}
+}
+
+func TestIntRangeScan(t *testing.T) {
+	var r IntRange
+
+	assert.NoError(t, r.Scan([]byte(`[1,10)`)))
+	assert.Equal(t, NewIntRange(IntFrom(1), IntFrom(10), true, false), r)
+
+	assert.NoError(t, r.Scan(`empty`))
+	assert.True(t, r.Valid)
+	assert.True(t, r.Empty)
+
+	assert.NoError(t, r.Scan(nil))
+	assert.False(t, r.Valid)
+	assert.True(t, r.IsZero())
+	assert.Equal(t, "", r.String())
+
+	v, err := r.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	assert.Error(t, r.Scan(`[a,b)`))
+	assert.False(t, r.Valid)
+	assert.EqualError(t, r.Scan(int64(1)), "std: cannot scan type int64 into std.IntRange: 1")
+}
+
+func TestIntRangeJSON(t *testing.T) {
+	testCases := []struct {
+		r        IntRange
+		expected string
+	}{
+		{mustParseIntRange(t, `[1,5)`), `{"lower":1,"upper":5,"lower_inc":true,"upper_inc":false}`},
+		{mustParseIntRange(t, `(,5)`), `{"lower":null,"upper":5,"lower_inc":false,"upper_inc":false}`},
+		{EmptyIntRange(), `{"empty":true}`},
+		{IntRange{}, `null`},
+	}
+
+	for _, tc := range testCases {
+		data, err := json.Marshal(tc.r)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, string(data))
+
+		var r IntRange
+
+		assert.NoError(t, json.Unmarshal(data, &r))
+		assert.Equal(t, tc.r, r)
+	}
+
+	var r IntRange
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"lower":1,"upper":5,"upper_inc":true}`), &r))
+	assert.Equal(t, `[2,6)`, r.String())
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"upper":5}`), &r))
+	assert.Equal(t, `(,5)`, r.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"lower":"a"}`), &r))
+	assert.False(t, r.Valid)
+	assert.EqualError(t, r.UnmarshalJSON([]byte(`[1,5]`)), "json: cannot unmarshal [1,5] into Go value of type std.IntRange")
+	assert.Error(t, json.Unmarshal(invalidJSON, &r))
+}
+
+func TestIntRangeContains(t *testing.T) {
+	r := mustParseIntRange(t, `[1,5)`)
+
+	assert.False(t, r.Contains(0))
+	assert.True(t, r.Contains(1))
+	assert.True(t, r.Contains(4))
+	assert.False(t, r.Contains(5))
+
+	assert.True(t, mustParseIntRange(t, `(,)`).Contains(math.MinInt64))
+	assert.True(t, mustParseIntRange(t, `(,5)`).Contains(-100))
+	assert.False(t, EmptyIntRange().Contains(1))
+	assert.False(t, IntRange{}.Contains(1))
+}
+
+func TestIntRangeOperations(t *testing.T) {
+	testCases := []struct {
+		a, b      string
+		overlaps  bool
+		intersect string
+		union     string
+	}{
+		{`[1,5)`, `[3,8)`, true, `[3,5)`, `[1,8)`},
+		{`[1,5)`, `[5,8)`, false, `empty`, `[1,8)`},
+		{`[1,5)`, `[6,8)`, false, `empty`, ``},
+		{`[1,10)`, `[3,5)`, true, `[3,5)`, `[1,10)`},
+		{`(,5)`, `[3,)`, true, `[3,5)`, `(,)`},
+		{`[1,5)`, `empty`, false, `empty`, `[1,5)`},
+	}
+
+	for _, tc := range testCases {
+		a, b := mustParseIntRange(t, tc.a), mustParseIntRange(t, tc.b)
+
+		assert.Equal(t, tc.overlaps, a.Overlaps(b), "%s && %s", tc.a, tc.b)
+		assert.Equal(t, tc.overlaps, b.Overlaps(a), "%s && %s", tc.b, tc.a)
+		assert.Equal(t, tc.intersect, a.Intersect(b).String(), "%s * %s", tc.a, tc.b)
+		assert.Equal(t, tc.intersect, b.Intersect(a).String(), "%s * %s", tc.b, tc.a)
+
+		u, err := a.Union(b)
+		if tc.union == "" {
+			assert.Equal(t, ErrRangeNotContiguous, err)
+			assert.False(t, u.Valid)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, tc.union, u.String(), "%s + %s", tc.a, tc.b)
+
+		u, err = b.Union(a)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.union, u.String(), "%s + %s", tc.b, tc.a)
+	}
+
+	a := mustParseIntRange(t, `[1,5)`)
+
+	assert.False(t, a.Overlaps(IntRange{}))
+	assert.False(t, a.Intersect(IntRange{}).Valid)
+
+	u, err := a.Union(IntRange{})
+	assert.NoError(t, err)
+	assert.False(t, u.Valid)
+}
+
+func TestIntRangeOperationsNonCanonical(t *testing.T) {
+	closed := IntRange{Lower: IntFrom(1), Upper: IntFrom(2), LowerInc: true, UpperInc: true, Valid: true} // [1,2]
+	open := IntRange{Lower: IntFrom(0), Upper: IntFrom(3), Valid: true}                                   // (0,3)
+	unbounded := IntRange{Upper: IntFrom(5), LowerInc: true, UpperInc: true, Valid: true}                 // (,5]
+
+	testCases := []struct {
+		name      string
+		a, b      IntRange
+		overlaps  bool
+		intersect string
+		union     string
+	}{
+		{`[1,2] [3,5)`, closed, mustParseIntRange(t, `[3,5)`), false, `empty`, `[1,5)`},
+		{`[1,2] [2,5)`, closed, mustParseIntRange(t, `[2,5)`), true, `[2,3)`, `[1,5)`},
+		{`(0,3) [2,5)`, open, mustParseIntRange(t, `[2,5)`), true, `[2,3)`, `[1,5)`},
+		{`(0,3) [3,5)`, open, mustParseIntRange(t, `[3,5)`), false, `empty`, `[1,5)`},
+		{`(0,3) [4,5)`, open, mustParseIntRange(t, `[4,5)`), false, `empty`, ``},
+		{`(,5] [5,8)`, unbounded, mustParseIntRange(t, `[5,8)`), true, `[5,6)`, `(,8)`},
+		{`(,5] [6,8)`, unbounded, mustParseIntRange(t, `[6,8)`), false, `empty`, `(,8)`},
+		{`[1,2] (0,3)`, closed, open, true, `[1,3)`, `[1,3)`},
+	}
+
+	for _, tc := range testCases {
+		a, b := tc.a, tc.b
+
+		assert.Equal(t, tc.overlaps, a.Overlaps(b), tc.name)
+		assert.Equal(t, tc.overlaps, b.Overlaps(a), tc.name)
+		assert.Equal(t, tc.intersect, a.Intersect(b).String(), tc.name)
+		assert.Equal(t, tc.intersect, b.Intersect(a).String(), tc.name)
+
+		u, err := a.Union(b)
+		if tc.union == "" {
+			assert.Equal(t, ErrRangeNotContiguous, err, tc.name)
+
+			continue
+		}
+
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.union, u.String(), tc.name)
+
+		u, err = b.Union(a)
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.union, u.String(), tc.name)
+	}
+
+	assert.True(t, closed.Contains(2))
+	assert.False(t, open.Contains(0))
+	assert.True(t, open.Contains(1))
+	assert.False(t, open.Contains(3))
+	assert.True(t, unbounded.Contains(5))
+	assert.False(t, unbounded.Contains(6))
+}
+
+func TestIntRangeCompare(t *testing.T) {
+	a := mustParseIntRange(t, "[1,5)")
+
+	assert.True(t, a.Equal(mustParseIntRange(t, "[1,4]")))
+	assert.False(t, a.Equal(mustParseIntRange(t, "[1,5]")))
+	assert.True(t, EmptyIntRange().Equal(mustParseIntRange(t, "empty")))
+	assert.False(t, EmptyIntRange().Equal(IntRange{}))
+	assert.True(t, IntRange{}.Equal(IntRange{}))
+
+	assert.Equal(t, -1, EmptyIntRange().Compare(a, NullsFirst))
+	assert.Equal(t, -1, mustParseIntRange(t, "(,5)").Compare(a, NullsFirst))
+	assert.Equal(t, 1, mustParseIntRange(t, "[2,3)").Compare(a, NullsFirst))
+	assert.Equal(t, -1, a.Compare(mustParseIntRange(t, "[1,6)"), NullsFirst))
+	assert.Equal(t, 1, mustParseIntRange(t, "[1,)").Compare(a, NullsFirst))
+	assert.Equal(t, -1, IntRange{}.Compare(EmptyIntRange(), NullsFirst))
+	assert.Equal(t, 1, IntRange{}.Compare(EmptyIntRange(), NullsLast))
+}
+
+func TestIntRangeEncodings(t *testing.T) {
+	for _, v := range []IntRange{mustParseIntRange(t, "[1,5)"), mustParseIntRange(t, "(,5)"), EmptyIntRange(), {}} {
+		testTextEncodings(t, v, func() interface{} { return &IntRange{} })
+	}
+
+	var r IntRange
+
+	assert.Error(t, r.UnmarshalText([]byte("[1,")))
+	assert.False(t, r.Valid)
+}
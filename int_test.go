@@ -215,3 +215,143 @@ func assertNullInt(t *testing.T, i Int, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestIntEqual(t *testing.T) {
+	assert.True(t, IntFrom(12345).Equal(IntFrom(12345)))
+	assert.True(t, Int{}.Equal(NewInt(12345, false)))
+	assert.False(t, IntFrom(12345).Equal(IntFrom(0)))
+	assert.False(t, IntFrom(0).Equal(Int{}))
+}
+
+func TestIntCompare(t *testing.T) {
+	assert.Equal(t, -1, IntFrom(1).Compare(IntFrom(2), NullsFirst))
+	assert.Equal(t, 1, IntFrom(2).Compare(IntFrom(1), NullsFirst))
+	assert.Equal(t, 0, IntFrom(2).Compare(IntFrom(2), NullsFirst))
+	assert.Equal(t, -1, Int{}.Compare(IntFrom(1), NullsFirst))
+	assert.Equal(t, 1, Int{}.Compare(IntFrom(1), NullsLast))
+}
+
+func TestIntValueOr(t *testing.T) {
+	valid := IntFrom(int64(12345))
+	assert.Equal(t, int64(12345), valid.ValueOr(int64(42)))
+	assert.Equal(t, int64(12345), valid.ValueOrZero())
+
+	null := Int{}
+	assert.Equal(t, int64(42), null.ValueOr(int64(42)))
+	assert.Equal(t, int64(0), null.ValueOrZero())
+}
+
+func TestIntOrElse(t *testing.T) {
+	called := false
+	fallback := func() int64 {
+		called = true
+
+		return int64(42)
+	}
+
+	valid := IntFrom(int64(12345))
+	assert.Equal(t, int64(12345), valid.OrElse(fallback))
+	assert.False(t, called)
+
+	null := Int{}
+	assert.Equal(t, int64(42), null.OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestIntGet(t *testing.T) {
+	v, ok := IntFrom(int64(12345)).Get()
+	assert.True(t, ok)
+	assert.Equal(t, int64(12345), v)
+
+	v, ok = Int{}.Get()
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), v)
+}
+
+func TestIntMustGet(t *testing.T) {
+	assert.Equal(t, int64(12345), IntFrom(int64(12345)).MustGet())
+
+	assert.Panics(t, func() {
+		Int{}.MustGet()
+	})
+}
+
+func TestIntMap(t *testing.T) {
+	mapped := IntFrom(int64(12345)).Map(func(v int64) int64 { return v * 2 })
+	assert.True(t, mapped.Valid)
+	assert.Equal(t, int64(24690), mapped.ValueOrZero())
+
+	called := false
+	null := Int{}.Map(func(v int64) int64 {
+		called = true
+
+		return v
+	})
+	assert.False(t, null.Valid)
+	assert.False(t, called)
+}
+
+func TestIntFlatMap(t *testing.T) {
+	toNull := func(v int64) Int {
+		return Int{}
+	}
+
+	assert.False(t, IntFrom(int64(12345)).FlatMap(toNull).Valid)
+	assert.Equal(t, IntFrom(int64(12345)), IntFrom(int64(12345)).FlatMap(func(v int64) Int {
+		return IntFrom(v)
+	}))
+	assert.False(t, Int{}.FlatMap(func(v int64) Int {
+		return IntFrom(int64(12345))
+	}).Valid)
+}
+
+func TestIntFilter(t *testing.T) {
+	assert.Equal(t, IntFrom(int64(12345)), IntFrom(int64(12345)).Filter(func(v int64) bool { return v > 0 }))
+	assert.False(t, IntFrom(int64(12345)).Filter(func(v int64) bool { return false }).Valid)
+	assert.False(t, Int{}.Filter(func(v int64) bool { return true }).Valid)
+}
+
+func TestIntToFloat(t *testing.T) {
+	f, err := IntFrom(12345).ToFloat()
+	assert.NoError(t, err)
+	assert.Equal(t, FloatFrom(12345), f)
+
+	f, err = Int{}.ToFloat()
+	assert.NoError(t, err)
+	assert.False(t, f.Valid)
+
+	f, err = IntFrom(1 << 60).ToFloat()
+	assert.NoError(t, err)
+	assert.Equal(t, FloatFrom(1<<60), f)
+
+	f, err = IntFrom(math.MinInt64).ToFloat()
+	assert.NoError(t, err)
+	assert.Equal(t, FloatFrom(math.MinInt64), f)
+
+	_, err = IntFrom(math.MaxInt64).ToFloat()
+	assert.ErrorIs(t, err, ErrLossyConversion)
+
+	_, err = IntFrom(1<<53 + 1).ToFloat()
+	assert.ErrorIs(t, err, ErrLossyConversion)
+
+	_, err = IntFrom(-(1<<53 + 1)).ToFloat()
+	assert.ErrorIs(t, err, ErrLossyConversion)
+}
+
+func TestIntToUint(t *testing.T) {
+	u, err := IntFrom(12345).ToUint()
+	assert.NoError(t, err)
+	assert.Equal(t, UintFrom(12345), u)
+
+	u, err = Int{}.ToUint()
+	assert.NoError(t, err)
+	assert.False(t, u.Valid)
+
+	_, err = IntFrom(-1).ToUint()
+	assert.ErrorIs(t, err, ErrLossyConversion)
+}
+
+func TestIntToString(t *testing.T) {
+	assert.Equal(t, StringFrom("-12345"), IntFrom(-12345).ToString())
+	assert.False(t, Int{}.ToString().Valid)
+}
@@ -0,0 +1,514 @@
+package std
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+)
+
+// yamlMarshaler is the yaml.Marshaler interface of gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+type yamlMarshaler interface {
+	MarshalYAML() (interface{}, error)
+}
+
+// Values are used as query arguments and encoded by value, and decoded through a pointer.
+
+var (
+	_ sql.Scanner                = (*Bool)(nil)
+	_ driver.Valuer              = Bool{}
+	_ json.Marshaler             = Bool{}
+	_ json.Unmarshaler           = (*Bool)(nil)
+	_ encoding.TextMarshaler     = Bool{}
+	_ encoding.TextUnmarshaler   = (*Bool)(nil)
+	_ xml.Marshaler              = Bool{}
+	_ xml.Unmarshaler            = (*Bool)(nil)
+	_ xml.MarshalerAttr          = Bool{}
+	_ xml.UnmarshalerAttr        = (*Bool)(nil)
+	_ encoding.BinaryMarshaler   = Bool{}
+	_ encoding.BinaryUnmarshaler = (*Bool)(nil)
+	_ gob.GobEncoder             = Bool{}
+	_ gob.GobDecoder             = (*Bool)(nil)
+	_ yamlMarshaler              = Bool{}
+	_ yamlUnmarshaler            = (*Bool)(nil)
+	_ fmt.Stringer               = Bool{}
+)
+
+var (
+	_ sql.Scanner                = (*Float)(nil)
+	_ driver.Valuer              = Float{}
+	_ json.Marshaler             = Float{}
+	_ json.Unmarshaler           = (*Float)(nil)
+	_ encoding.TextMarshaler     = Float{}
+	_ encoding.TextUnmarshaler   = (*Float)(nil)
+	_ xml.Marshaler              = Float{}
+	_ xml.Unmarshaler            = (*Float)(nil)
+	_ xml.MarshalerAttr          = Float{}
+	_ xml.UnmarshalerAttr        = (*Float)(nil)
+	_ encoding.BinaryMarshaler   = Float{}
+	_ encoding.BinaryUnmarshaler = (*Float)(nil)
+	_ gob.GobEncoder             = Float{}
+	_ gob.GobDecoder             = (*Float)(nil)
+	_ yamlMarshaler              = Float{}
+	_ yamlUnmarshaler            = (*Float)(nil)
+	_ fmt.Stringer               = Float{}
+)
+
+var (
+	_ sql.Scanner                = (*Int)(nil)
+	_ driver.Valuer              = Int{}
+	_ json.Marshaler             = Int{}
+	_ json.Unmarshaler           = (*Int)(nil)
+	_ encoding.TextMarshaler     = Int{}
+	_ encoding.TextUnmarshaler   = (*Int)(nil)
+	_ xml.Marshaler              = Int{}
+	_ xml.Unmarshaler            = (*Int)(nil)
+	_ xml.MarshalerAttr          = Int{}
+	_ xml.UnmarshalerAttr        = (*Int)(nil)
+	_ encoding.BinaryMarshaler   = Int{}
+	_ encoding.BinaryUnmarshaler = (*Int)(nil)
+	_ gob.GobEncoder             = Int{}
+	_ gob.GobDecoder             = (*Int)(nil)
+	_ yamlMarshaler              = Int{}
+	_ yamlUnmarshaler            = (*Int)(nil)
+	_ fmt.Stringer               = Int{}
+)
+
+var (
+	_ sql.Scanner                = (*Uint)(nil)
+	_ driver.Valuer              = Uint{}
+	_ json.Marshaler             = Uint{}
+	_ json.Unmarshaler           = (*Uint)(nil)
+	_ encoding.TextMarshaler     = Uint{}
+	_ encoding.TextUnmarshaler   = (*Uint)(nil)
+	_ xml.Marshaler              = Uint{}
+	_ xml.Unmarshaler            = (*Uint)(nil)
+	_ xml.MarshalerAttr          = Uint{}
+	_ xml.UnmarshalerAttr        = (*Uint)(nil)
+	_ encoding.BinaryMarshaler   = Uint{}
+	_ encoding.BinaryUnmarshaler = (*Uint)(nil)
+	_ gob.GobEncoder             = Uint{}
+	_ gob.GobDecoder             = (*Uint)(nil)
+	_ yamlMarshaler              = Uint{}
+	_ yamlUnmarshaler            = (*Uint)(nil)
+	_ fmt.Stringer               = Uint{}
+)
+
+var (
+	_ sql.Scanner                = (*String)(nil)
+	_ driver.Valuer              = String{}
+	_ json.Marshaler             = String{}
+	_ json.Unmarshaler           = (*String)(nil)
+	_ encoding.TextMarshaler     = String{}
+	_ encoding.TextUnmarshaler   = (*String)(nil)
+	_ xml.Marshaler              = String{}
+	_ xml.Unmarshaler            = (*String)(nil)
+	_ xml.MarshalerAttr          = String{}
+	_ xml.UnmarshalerAttr        = (*String)(nil)
+	_ encoding.BinaryMarshaler   = String{}
+	_ encoding.BinaryUnmarshaler = (*String)(nil)
+	_ gob.GobEncoder             = String{}
+	_ gob.GobDecoder             = (*String)(nil)
+	_ yamlMarshaler              = String{}
+	_ yamlUnmarshaler            = (*String)(nil)
+	_ fmt.Stringer               = String{}
+)
+
+var (
+	_ sql.Scanner                = (*Time)(nil)
+	_ driver.Valuer              = Time{}
+	_ json.Marshaler             = Time{}
+	_ json.Unmarshaler           = (*Time)(nil)
+	_ encoding.TextMarshaler     = Time{}
+	_ encoding.TextUnmarshaler   = (*Time)(nil)
+	_ xml.Marshaler              = Time{}
+	_ xml.Unmarshaler            = (*Time)(nil)
+	_ xml.MarshalerAttr          = Time{}
+	_ xml.UnmarshalerAttr        = (*Time)(nil)
+	_ encoding.BinaryMarshaler   = Time{}
+	_ encoding.BinaryUnmarshaler = (*Time)(nil)
+	_ gob.GobEncoder             = Time{}
+	_ gob.GobDecoder             = (*Time)(nil)
+	_ yamlMarshaler              = Time{}
+	_ yamlUnmarshaler            = (*Time)(nil)
+	_ fmt.Stringer               = Time{}
+)
+
+var (
+	_ sql.Scanner                = (*DateTime)(nil)
+	_ driver.Valuer              = DateTime{}
+	_ json.Marshaler             = DateTime{}
+	_ json.Unmarshaler           = (*DateTime)(nil)
+	_ encoding.TextMarshaler     = DateTime{}
+	_ encoding.TextUnmarshaler   = (*DateTime)(nil)
+	_ xml.Marshaler              = DateTime{}
+	_ xml.Unmarshaler            = (*DateTime)(nil)
+	_ xml.MarshalerAttr          = DateTime{}
+	_ xml.UnmarshalerAttr        = (*DateTime)(nil)
+	_ encoding.BinaryMarshaler   = DateTime{}
+	_ encoding.BinaryUnmarshaler = (*DateTime)(nil)
+	_ gob.GobEncoder             = DateTime{}
+	_ gob.GobDecoder             = (*DateTime)(nil)
+	_ yamlMarshaler              = DateTime{}
+	_ yamlUnmarshaler            = (*DateTime)(nil)
+	_ fmt.Stringer               = DateTime{}
+)
+
+var (
+	_ sql.Scanner                = (*Date)(nil)
+	_ driver.Valuer              = Date{}
+	_ json.Marshaler             = Date{}
+	_ json.Unmarshaler           = (*Date)(nil)
+	_ encoding.TextMarshaler     = Date{}
+	_ encoding.TextUnmarshaler   = (*Date)(nil)
+	_ xml.Marshaler              = Date{}
+	_ xml.Unmarshaler            = (*Date)(nil)
+	_ xml.MarshalerAttr          = Date{}
+	_ xml.UnmarshalerAttr        = (*Date)(nil)
+	_ encoding.BinaryMarshaler   = Date{}
+	_ encoding.BinaryUnmarshaler = (*Date)(nil)
+	_ gob.GobEncoder             = Date{}
+	_ gob.GobDecoder             = (*Date)(nil)
+	_ yamlMarshaler              = Date{}
+	_ yamlUnmarshaler            = (*Date)(nil)
+	_ fmt.Stringer               = Date{}
+)
+
+// Array types are scanned from and written as Postgres array literals, and encoded as JSON arrays.
+
+var (
+	_ sql.Scanner                = (*Strings)(nil)
+	_ driver.Valuer              = Strings{}
+	_ json.Marshaler             = Strings{}
+	_ json.Unmarshaler           = (*Strings)(nil)
+	_ encoding.TextMarshaler     = Strings{}
+	_ encoding.TextUnmarshaler   = (*Strings)(nil)
+	_ xml.Marshaler              = Strings{}
+	_ xml.Unmarshaler            = (*Strings)(nil)
+	_ xml.MarshalerAttr          = Strings{}
+	_ xml.UnmarshalerAttr        = (*Strings)(nil)
+	_ encoding.BinaryMarshaler   = Strings{}
+	_ encoding.BinaryUnmarshaler = (*Strings)(nil)
+	_ gob.GobEncoder             = Strings{}
+	_ gob.GobDecoder             = (*Strings)(nil)
+	_ yamlMarshaler              = Strings{}
+	_ yamlUnmarshaler            = (*Strings)(nil)
+	_ fmt.Stringer               = Strings{}
+
+	_ sql.Scanner                = (*NullStrings)(nil)
+	_ driver.Valuer              = NullStrings{}
+	_ json.Marshaler             = NullStrings{}
+	_ json.Unmarshaler           = (*NullStrings)(nil)
+	_ encoding.TextMarshaler     = NullStrings{}
+	_ encoding.TextUnmarshaler   = (*NullStrings)(nil)
+	_ xml.Marshaler              = NullStrings{}
+	_ xml.Unmarshaler            = (*NullStrings)(nil)
+	_ xml.MarshalerAttr          = NullStrings{}
+	_ xml.UnmarshalerAttr        = (*NullStrings)(nil)
+	_ encoding.BinaryMarshaler   = NullStrings{}
+	_ encoding.BinaryUnmarshaler = (*NullStrings)(nil)
+	_ gob.GobEncoder             = NullStrings{}
+	_ gob.GobDecoder             = (*NullStrings)(nil)
+	_ yamlMarshaler              = NullStrings{}
+	_ yamlUnmarshaler            = (*NullStrings)(nil)
+	_ fmt.Stringer               = NullStrings{}
+
+	_ sql.Scanner                = (*Ints)(nil)
+	_ driver.Valuer              = Ints{}
+	_ json.Marshaler             = Ints{}
+	_ json.Unmarshaler           = (*Ints)(nil)
+	_ encoding.TextMarshaler     = Ints{}
+	_ encoding.TextUnmarshaler   = (*Ints)(nil)
+	_ xml.Marshaler              = Ints{}
+	_ xml.Unmarshaler            = (*Ints)(nil)
+	_ xml.MarshalerAttr          = Ints{}
+	_ xml.UnmarshalerAttr        = (*Ints)(nil)
+	_ encoding.BinaryMarshaler   = Ints{}
+	_ encoding.BinaryUnmarshaler = (*Ints)(nil)
+	_ gob.GobEncoder             = Ints{}
+	_ gob.GobDecoder             = (*Ints)(nil)
+	_ yamlMarshaler              = Ints{}
+	_ yamlUnmarshaler            = (*Ints)(nil)
+	_ fmt.Stringer               = Ints{}
+
+	_ sql.Scanner                = (*NullInts)(nil)
+	_ driver.Valuer              = NullInts{}
+	_ json.Marshaler             = NullInts{}
+	_ json.Unmarshaler           = (*NullInts)(nil)
+	_ encoding.TextMarshaler     = NullInts{}
+	_ encoding.TextUnmarshaler   = (*NullInts)(nil)
+	_ xml.Marshaler              = NullInts{}
+	_ xml.Unmarshaler            = (*NullInts)(nil)
+	_ xml.MarshalerAttr          = NullInts{}
+	_ xml.UnmarshalerAttr        = (*NullInts)(nil)
+	_ encoding.BinaryMarshaler   = NullInts{}
+	_ encoding.BinaryUnmarshaler = (*NullInts)(nil)
+	_ gob.GobEncoder             = NullInts{}
+	_ gob.GobDecoder             = (*NullInts)(nil)
+	_ yamlMarshaler              = NullInts{}
+	_ yamlUnmarshaler            = (*NullInts)(nil)
+	_ fmt.Stringer               = NullInts{}
+
+	_ sql.Scanner                = (*Floats)(nil)
+	_ driver.Valuer              = Floats{}
+	_ json.Marshaler             = Floats{}
+	_ json.Unmarshaler           = (*Floats)(nil)
+	_ encoding.TextMarshaler     = Floats{}
+	_ encoding.TextUnmarshaler   = (*Floats)(nil)
+	_ xml.Marshaler              = Floats{}
+	_ xml.Unmarshaler            = (*Floats)(nil)
+	_ xml.MarshalerAttr          = Floats{}
+	_ xml.UnmarshalerAttr        = (*Floats)(nil)
+	_ encoding.BinaryMarshaler   = Floats{}
+	_ encoding.BinaryUnmarshaler = (*Floats)(nil)
+	_ gob.GobEncoder             = Floats{}
+	_ gob.GobDecoder             = (*Floats)(nil)
+	_ yamlMarshaler              = Floats{}
+	_ yamlUnmarshaler            = (*Floats)(nil)
+	_ fmt.Stringer               = Floats{}
+
+	_ sql.Scanner                = (*NullFloats)(nil)
+	_ driver.Valuer              = NullFloats{}
+	_ json.Marshaler             = NullFloats{}
+	_ json.Unmarshaler           = (*NullFloats)(nil)
+	_ encoding.TextMarshaler     = NullFloats{}
+	_ encoding.TextUnmarshaler   = (*NullFloats)(nil)
+	_ xml.Marshaler              = NullFloats{}
+	_ xml.Unmarshaler            = (*NullFloats)(nil)
+	_ xml.MarshalerAttr          = NullFloats{}
+	_ xml.UnmarshalerAttr        = (*NullFloats)(nil)
+	_ encoding.BinaryMarshaler   = NullFloats{}
+	_ encoding.BinaryUnmarshaler = (*NullFloats)(nil)
+	_ gob.GobEncoder             = NullFloats{}
+	_ gob.GobDecoder             = (*NullFloats)(nil)
+	_ yamlMarshaler              = NullFloats{}
+	_ yamlUnmarshaler            = (*NullFloats)(nil)
+	_ fmt.Stringer               = NullFloats{}
+
+	_ sql.Scanner                = (*Bools)(nil)
+	_ driver.Valuer              = Bools{}
+	_ json.Marshaler             = Bools{}
+	_ json.Unmarshaler           = (*Bools)(nil)
+	_ encoding.TextMarshaler     = Bools{}
+	_ encoding.TextUnmarshaler   = (*Bools)(nil)
+	_ xml.Marshaler              = Bools{}
+	_ xml.Unmarshaler            = (*Bools)(nil)
+	_ xml.MarshalerAttr          = Bools{}
+	_ xml.UnmarshalerAttr        = (*Bools)(nil)
+	_ encoding.BinaryMarshaler   = Bools{}
+	_ encoding.BinaryUnmarshaler = (*Bools)(nil)
+	_ gob.GobEncoder             = Bools{}
+	_ gob.GobDecoder             = (*Bools)(nil)
+	_ yamlMarshaler              = Bools{}
+	_ yamlUnmarshaler            = (*Bools)(nil)
+	_ fmt.Stringer               = Bools{}
+
+	_ sql.Scanner                = (*NullBools)(nil)
+	_ driver.Valuer              = NullBools{}
+	_ json.Marshaler             = NullBools{}
+	_ json.Unmarshaler           = (*NullBools)(nil)
+	_ encoding.TextMarshaler     = NullBools{}
+	_ encoding.TextUnmarshaler   = (*NullBools)(nil)
+	_ xml.Marshaler              = NullBools{}
+	_ xml.Unmarshaler            = (*NullBools)(nil)
+	_ xml.MarshalerAttr          = NullBools{}
+	_ xml.UnmarshalerAttr        = (*NullBools)(nil)
+	_ encoding.BinaryMarshaler   = NullBools{}
+	_ encoding.BinaryUnmarshaler = (*NullBools)(nil)
+	_ gob.GobEncoder             = NullBools{}
+	_ gob.GobDecoder             = (*NullBools)(nil)
+	_ yamlMarshaler              = NullBools{}
+	_ yamlUnmarshaler            = (*NullBools)(nil)
+	_ fmt.Stringer               = NullBools{}
+
+	_ sql.Scanner                = (*Times)(nil)
+	_ driver.Valuer              = Times{}
+	_ json.Marshaler             = Times{}
+	_ json.Unmarshaler           = (*Times)(nil)
+	_ encoding.TextMarshaler     = Times{}
+	_ encoding.TextUnmarshaler   = (*Times)(nil)
+	_ xml.Marshaler              = Times{}
+	_ xml.Unmarshaler            = (*Times)(nil)
+	_ xml.MarshalerAttr          = Times{}
+	_ xml.UnmarshalerAttr        = (*Times)(nil)
+	_ encoding.BinaryMarshaler   = Times{}
+	_ encoding.BinaryUnmarshaler = (*Times)(nil)
+	_ gob.GobEncoder             = Times{}
+	_ gob.GobDecoder             = (*Times)(nil)
+	_ yamlMarshaler              = Times{}
+	_ yamlUnmarshaler            = (*Times)(nil)
+	_ fmt.Stringer               = Times{}
+
+	_ sql.Scanner                = (*NullTimes)(nil)
+	_ driver.Valuer              = NullTimes{}
+	_ json.Marshaler             = NullTimes{}
+	_ json.Unmarshaler           = (*NullTimes)(nil)
+	_ encoding.TextMarshaler     = NullTimes{}
+	_ encoding.TextUnmarshaler   = (*NullTimes)(nil)
+	_ xml.Marshaler              = NullTimes{}
+	_ xml.Unmarshaler            = (*NullTimes)(nil)
+	_ xml.MarshalerAttr          = NullTimes{}
+	_ xml.UnmarshalerAttr        = (*NullTimes)(nil)
+	_ encoding.BinaryMarshaler   = NullTimes{}
+	_ encoding.BinaryUnmarshaler = (*NullTimes)(nil)
+	_ gob.GobEncoder             = NullTimes{}
+	_ gob.GobDecoder             = (*NullTimes)(nil)
+	_ yamlMarshaler              = NullTimes{}
+	_ yamlUnmarshaler            = (*NullTimes)(nil)
+	_ fmt.Stringer               = NullTimes{}
+)
+
+var (
+	_ sql.Scanner                = (*StringMap)(nil)
+	_ driver.Valuer              = StringMap{}
+	_ json.Marshaler             = StringMap{}
+	_ json.Unmarshaler           = (*StringMap)(nil)
+	_ encoding.TextMarshaler     = StringMap{}
+	_ encoding.TextUnmarshaler   = (*StringMap)(nil)
+	_ xml.Marshaler              = StringMap{}
+	_ xml.Unmarshaler            = (*StringMap)(nil)
+	_ xml.MarshalerAttr          = StringMap{}
+	_ xml.UnmarshalerAttr        = (*StringMap)(nil)
+	_ encoding.BinaryMarshaler   = StringMap{}
+	_ encoding.BinaryUnmarshaler = (*StringMap)(nil)
+	_ gob.GobEncoder             = StringMap{}
+	_ gob.GobDecoder             = (*StringMap)(nil)
+	_ yamlMarshaler              = StringMap{}
+	_ yamlUnmarshaler            = (*StringMap)(nil)
+	_ fmt.Stringer               = StringMap{}
+
+	_ sql.Scanner                = (*JSONStringMap)(nil)
+	_ driver.Valuer              = JSONStringMap{}
+	_ json.Marshaler             = JSONStringMap{}
+	_ json.Unmarshaler           = (*JSONStringMap)(nil)
+	_ encoding.TextMarshaler     = JSONStringMap{}
+	_ encoding.TextUnmarshaler   = (*JSONStringMap)(nil)
+	_ xml.Marshaler              = JSONStringMap{}
+	_ xml.Unmarshaler            = (*JSONStringMap)(nil)
+	_ xml.MarshalerAttr          = JSONStringMap{}
+	_ xml.UnmarshalerAttr        = (*JSONStringMap)(nil)
+	_ encoding.BinaryMarshaler   = JSONStringMap{}
+	_ encoding.BinaryUnmarshaler = (*JSONStringMap)(nil)
+	_ gob.GobEncoder             = JSONStringMap{}
+	_ gob.GobDecoder             = (*JSONStringMap)(nil)
+	_ yamlMarshaler              = JSONStringMap{}
+	_ yamlUnmarshaler            = (*JSONStringMap)(nil)
+	_ fmt.Stringer               = JSONStringMap{}
+)
+
+var (
+	_ sql.Scanner                = (*IntRange)(nil)
+	_ driver.Valuer              = IntRange{}
+	_ json.Marshaler             = IntRange{}
+	_ json.Unmarshaler           = (*IntRange)(nil)
+	_ encoding.TextMarshaler     = IntRange{}
+	_ encoding.TextUnmarshaler   = (*IntRange)(nil)
+	_ xml.Marshaler              = IntRange{}
+	_ xml.Unmarshaler            = (*IntRange)(nil)
+	_ xml.MarshalerAttr          = IntRange{}
+	_ xml.UnmarshalerAttr        = (*IntRange)(nil)
+	_ encoding.BinaryMarshaler   = IntRange{}
+	_ encoding.BinaryUnmarshaler = (*IntRange)(nil)
+	_ gob.GobEncoder             = IntRange{}
+	_ gob.GobDecoder             = (*IntRange)(nil)
+	_ yamlMarshaler              = IntRange{}
+	_ yamlUnmarshaler            = (*IntRange)(nil)
+	_ fmt.Stringer               = IntRange{}
+
+	_ sql.Scanner                = (*DateRange)(nil)
+	_ driver.Valuer              = DateRange{}
+	_ json.Marshaler             = DateRange{}
+	_ json.Unmarshaler           = (*DateRange)(nil)
+	_ encoding.TextMarshaler     = DateRange{}
+	_ encoding.TextUnmarshaler   = (*DateRange)(nil)
+	_ xml.Marshaler              = DateRange{}
+	_ xml.Unmarshaler            = (*DateRange)(nil)
+	_ xml.MarshalerAttr          = DateRange{}
+	_ xml.UnmarshalerAttr        = (*DateRange)(nil)
+	_ encoding.BinaryMarshaler   = DateRange{}
+	_ encoding.BinaryUnmarshaler = (*DateRange)(nil)
+	_ gob.GobEncoder             = DateRange{}
+	_ gob.GobDecoder             = (*DateRange)(nil)
+	_ yamlMarshaler              = DateRange{}
+	_ yamlUnmarshaler            = (*DateRange)(nil)
+	_ fmt.Stringer               = DateRange{}
+
+	_ sql.Scanner                = (*DateTimeRange)(nil)
+	_ driver.Valuer              = DateTimeRange{}
+	_ json.Marshaler             = DateTimeRange{}
+	_ json.Unmarshaler           = (*DateTimeRange)(nil)
+	_ encoding.TextMarshaler     = DateTimeRange{}
+	_ encoding.TextUnmarshaler   = (*DateTimeRange)(nil)
+	_ xml.Marshaler              = DateTimeRange{}
+	_ xml.Unmarshaler            = (*DateTimeRange)(nil)
+	_ xml.MarshalerAttr          = DateTimeRange{}
+	_ xml.UnmarshalerAttr        = (*DateTimeRange)(nil)
+	_ encoding.BinaryMarshaler   = DateTimeRange{}
+	_ encoding.BinaryUnmarshaler = (*DateTimeRange)(nil)
+	_ gob.GobEncoder             = DateTimeRange{}
+	_ gob.GobDecoder             = (*DateTimeRange)(nil)
+	_ yamlMarshaler              = DateTimeRange{}
+	_ yamlUnmarshaler            = (*DateTimeRange)(nil)
+	_ fmt.Stringer               = DateTimeRange{}
+)
+
+var (
+	_ sql.Scanner                = (*HardwareAddr)(nil)
+	_ driver.Valuer              = HardwareAddr{}
+	_ json.Marshaler             = HardwareAddr{}
+	_ json.Unmarshaler           = (*HardwareAddr)(nil)
+	_ encoding.TextMarshaler     = HardwareAddr{}
+	_ encoding.TextUnmarshaler   = (*HardwareAddr)(nil)
+	_ xml.Marshaler              = HardwareAddr{}
+	_ xml.Unmarshaler            = (*HardwareAddr)(nil)
+	_ xml.MarshalerAttr          = HardwareAddr{}
+	_ xml.UnmarshalerAttr        = (*HardwareAddr)(nil)
+	_ encoding.BinaryMarshaler   = HardwareAddr{}
+	_ encoding.BinaryUnmarshaler = (*HardwareAddr)(nil)
+	_ gob.GobEncoder             = HardwareAddr{}
+	_ gob.GobDecoder             = (*HardwareAddr)(nil)
+	_ yamlMarshaler              = HardwareAddr{}
+	_ yamlUnmarshaler            = (*HardwareAddr)(nil)
+	_ fmt.Stringer               = HardwareAddr{}
+)
+
+var (
+	_ sql.Scanner                = (*URL)(nil)
+	_ driver.Valuer              = URL{}
+	_ json.Marshaler             = URL{}
+	_ json.Unmarshaler           = (*URL)(nil)
+	_ encoding.TextMarshaler     = URL{}
+	_ encoding.TextUnmarshaler   = (*URL)(nil)
+	_ xml.Marshaler              = URL{}
+	_ xml.Unmarshaler            = (*URL)(nil)
+	_ xml.MarshalerAttr          = URL{}
+	_ xml.UnmarshalerAttr        = (*URL)(nil)
+	_ encoding.BinaryMarshaler   = URL{}
+	_ encoding.BinaryUnmarshaler = (*URL)(nil)
+	_ gob.GobEncoder             = URL{}
+	_ gob.GobDecoder             = (*URL)(nil)
+	_ yamlMarshaler              = URL{}
+	_ yamlUnmarshaler            = (*URL)(nil)
+	_ fmt.Stringer               = URL{}
+
+	_ sql.Scanner                = (*Email)(nil)
+	_ driver.Valuer              = Email{}
+	_ json.Marshaler             = Email{}
+	_ json.Unmarshaler           = (*Email)(nil)
+	_ encoding.TextMarshaler     = Email{}
+	_ encoding.TextUnmarshaler   = (*Email)(nil)
+	_ xml.Marshaler              = Email{}
+	_ xml.Unmarshaler            = (*Email)(nil)
+	_ xml.MarshalerAttr          = Email{}
+	_ xml.UnmarshalerAttr        = (*Email)(nil)
+	_ encoding.BinaryMarshaler   = Email{}
+	_ encoding.BinaryUnmarshaler = (*Email)(nil)
+	_ gob.GobEncoder             = Email{}
+	_ gob.GobDecoder             = (*Email)(nil)
+	_ yamlMarshaler              = Email{}
+	_ yamlUnmarshaler            = (*Email)(nil)
+	_ fmt.Stringer               = Email{}
+)
@@ -0,0 +1,527 @@
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"strconv"
+)
+
+// Ints is a nullable []int64, mapping to a Postgres int8[] column.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+// The elements can not be NULL, use NullInts for arrays with NULL elements.
+type Ints struct {
+	Data  []int64
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewInts creates a new Ints.
+func NewInts(data []int64, valid bool) Ints {
+	return Ints{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// IntsFrom creates a new Ints that will always be valid.
+func IntsFrom(data []int64) Ints {
+	return NewInts(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal, a NULL element is an error.
+func (i *Ints) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "Ints")
+	if err == nil && valid {
+		err = i.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		i.Data, i.Dims, i.Valid = nil, nil, false
+
+		return err
+	}
+
+	i.Dims, i.Valid = dims, true
+
+	return nil
+}
+
+func (i *Ints) fromArray(elems []arrayElement) error {
+	var err error
+
+	data := make([]int64, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			return errArrayNull("Ints")
+		}
+
+		if data[k], err = strconv.ParseInt(e.text, 10, 64); err != nil {
+			return errArrayElement(e.text, "Ints", err)
+		}
+	}
+
+	i.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this Ints is null.
+func (i Ints) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, i.Dims, len(i.Data), "Ints", func(buf []byte, k int) []byte {
+		return strconv.AppendInt(buf, i.Data[k], 10)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input.
+func (i *Ints) UnmarshalJSON(data []byte) error {
+	var d []int64
+
+	dims, valid, err := unmarshalJSONArray(data, "Ints", &d)
+	if err != nil || !valid {
+		i.Data, i.Dims, i.Valid = nil, nil, false
+
+		return err
+	}
+
+	i.Data, i.Dims, i.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Ints is null, and an empty array if it is valid without elements.
+func (i Ints) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(i.Data, len(i.Data), i.Dims, "Ints")
+}
+
+// SetValid changes this Ints's value and also sets it to be non-null.
+func (i *Ints) SetValid(v []int64) {
+	i.Data = v
+	i.Dims = nil
+	i.Valid = true
+}
+
+// IsZero returns true for null Ints, for potential future omitempty support.
+func (i Ints) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal reports whether i and o are both null or both valid with the same elements and dimensions.
+func (i Ints) Equal(o Ints) bool {
+	return i.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if i is less than o, 0 if they are equal and +1 if i is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+func (i Ints) Compare(o Ints, nulls NullOrder) int {
+	if c, ok := compareNull(i.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(i.Data), len(o.Data), i.Dims, o.Dims, func(k int) int {
+		return IntFrom(i.Data[k]).Compare(IntFrom(o.Data[k]), nulls)
+	})
+}
+
+// ValueOr returns this Ints's elements, or def if this Ints is null.
+func (i Ints) ValueOr(def []int64) []int64 {
+	if !i.Valid {
+		return def
+	}
+
+	return i.Data
+}
+
+// ValueOrZero returns this Ints's elements, or nil if this Ints is null.
+func (i Ints) ValueOrZero() []int64 {
+	if !i.Valid {
+		return nil
+	}
+
+	return i.Data
+}
+
+// OrElse returns this Ints's elements, or the result of fn if this Ints is null.
+// fn is only called when this Ints is null.
+func (i Ints) OrElse(fn func() []int64) []int64 {
+	if !i.Valid {
+		return fn()
+	}
+
+	return i.Data
+}
+
+// Get returns this Ints's elements and true, or nil and false if this Ints is null.
+func (i Ints) Get() ([]int64, bool) {
+	return i.ValueOrZero(), i.Valid
+}
+
+// MustGet returns this Ints's elements, it panics if this Ints is null.
+func (i Ints) MustGet() []int64 {
+	if !i.Valid {
+		panic("std: MustGet called on a null Ints")
+	}
+
+	return i.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this Ints is null.
+func (i Ints) MarshalText() ([]byte, error) {
+	return marshalArrayText(i)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null Ints.
+func (i *Ints) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, i)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this Ints is null.
+func (i Ints) String() string {
+	text, _ := i.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Ints is null, the array literal otherwise.
+func (i Ints) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, i.Valid, i.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Ints, an empty element is a null Ints.
+func (i *Ints) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Ints is null.
+func (i Ints) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, i.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Ints) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i Ints) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(i.Valid, i.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *Ints) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.Ints", i)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i Ints) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *Ints) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Ints is null, a sequence otherwise.
+func (i Ints) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(i.Data, len(i.Data), i.Dims, "Ints")
+}
+
+// unmarshalYAML decodes this Ints with the unmarshal func of a YAML library.
+func (i *Ints) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.Ints", i)
+}
+
+// NullInts is a nullable []Int, mapping to a Postgres int8[] column with NULL elements.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+type NullInts struct {
+	Data  []Int
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewNullInts creates a new NullInts.
+func NewNullInts(data []Int, valid bool) NullInts {
+	return NullInts{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullIntsFrom creates a new NullInts that will always be valid.
+func NullIntsFrom(data []Int) NullInts {
+	return NewNullInts(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal.
+func (i *NullInts) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "NullInts")
+	if err == nil && valid {
+		err = i.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		i.Data, i.Dims, i.Valid = nil, nil, false
+
+		return err
+	}
+
+	i.Dims, i.Valid = dims, true
+
+	return nil
+}
+
+func (i *NullInts) fromArray(elems []arrayElement) error {
+	var err error
+
+	data := make([]Int, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			continue
+		}
+
+		data[k].Valid = true
+
+		if data[k].Data, err = strconv.ParseInt(e.text, 10, 64); err != nil {
+			return errArrayElement(e.text, "NullInts", err)
+		}
+	}
+
+	i.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this NullInts is null.
+func (i NullInts) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, i.Dims, len(i.Data), "NullInts", func(buf []byte, k int) []byte {
+		if !i.Data[k].Valid {
+			return append(buf, "NULL"...)
+		}
+
+		return strconv.AppendInt(buf, i.Data[k].Data, 10)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input, the elements can be null.
+func (i *NullInts) UnmarshalJSON(data []byte) error {
+	var d []Int
+
+	dims, valid, err := unmarshalJSONArray(data, "NullInts", &d)
+	if err != nil || !valid {
+		i.Data, i.Dims, i.Valid = nil, nil, false
+
+		return err
+	}
+
+	i.Data, i.Dims, i.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullInts is null, and an empty array if it is valid without elements.
+func (i NullInts) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(i.Data, len(i.Data), i.Dims, "NullInts")
+}
+
+// SetValid changes this NullInts's value and also sets it to be non-null.
+func (i *NullInts) SetValid(v []Int) {
+	i.Data = v
+	i.Dims = nil
+	i.Valid = true
+}
+
+// IsZero returns true for null NullInts, for potential future omitempty support.
+func (i NullInts) IsZero() bool {
+	return !i.Valid
+}
+
+// Equal reports whether i and o are both null or both valid with the same elements and dimensions.
+func (i NullInts) Equal(o NullInts) bool {
+	return i.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if i is less than o, 0 if they are equal and +1 if i is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+// Null elements are ordered according to nulls too.
+func (i NullInts) Compare(o NullInts, nulls NullOrder) int {
+	if c, ok := compareNull(i.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(i.Data), len(o.Data), i.Dims, o.Dims, func(k int) int {
+		return i.Data[k].Compare(o.Data[k], nulls)
+	})
+}
+
+// ValueOr returns this NullInts's elements, or def if this NullInts is null.
+func (i NullInts) ValueOr(def []Int) []Int {
+	if !i.Valid {
+		return def
+	}
+
+	return i.Data
+}
+
+// ValueOrZero returns this NullInts's elements, or nil if this NullInts is null.
+func (i NullInts) ValueOrZero() []Int {
+	if !i.Valid {
+		return nil
+	}
+
+	return i.Data
+}
+
+// OrElse returns this NullInts's elements, or the result of fn if this NullInts is null.
+// fn is only called when this NullInts is null.
+func (i NullInts) OrElse(fn func() []Int) []Int {
+	if !i.Valid {
+		return fn()
+	}
+
+	return i.Data
+}
+
+// Get returns this NullInts's elements and true, or nil and false if this NullInts is null.
+func (i NullInts) Get() ([]Int, bool) {
+	return i.ValueOrZero(), i.Valid
+}
+
+// MustGet returns this NullInts's elements, it panics if this NullInts is null.
+func (i NullInts) MustGet() []Int {
+	if !i.Valid {
+		panic("std: MustGet called on a null NullInts")
+	}
+
+	return i.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this NullInts is null.
+func (i NullInts) MarshalText() ([]byte, error) {
+	return marshalArrayText(i)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null NullInts.
+func (i *NullInts) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, i)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this NullInts is null.
+func (i NullInts) String() string {
+	text, _ := i.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this NullInts is null, the array literal otherwise.
+func (i NullInts) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, i.Valid, i.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null NullInts, an empty element is a null NullInts.
+func (i *NullInts) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, i)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this NullInts is null.
+func (i NullInts) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, i.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *NullInts) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (i NullInts) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(i.Valid, i.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (i *NullInts) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.NullInts", i)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (i NullInts) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (i *NullInts) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this NullInts is null, a sequence otherwise.
+func (i NullInts) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(i.Data, len(i.Data), i.Dims, "NullInts")
+}
+
+// unmarshalYAML decodes this NullInts with the unmarshal func of a YAML library.
+func (i *NullInts) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.NullInts", i)
+}
@@ -0,0 +1,137 @@
+package std
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInts(t *testing.T) {
+	var i Ints
+
+	assert.NoError(t, i.Scan([]byte(`{1, -2,3}`)))
+	assert.Equal(t, IntsFrom([]int64{1, -2, 3}), i)
+
+	v, err := i.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{1,-2,3}`, v)
+
+	assert.EqualError(t, i.Scan(`{1,a}`), `std: cannot scan element "a" into std.Ints: strconv.ParseInt: parsing "a": invalid syntax`)
+	assert.False(t, i.Valid)
+
+	assert.Error(t, i.Scan(`{1,NULL}`))
+
+	assert.NoError(t, json.Unmarshal([]byte(`[1,2]`), &i))
+	assert.Equal(t, []int64{1, 2}, i.Data)
+
+	data, err := json.Marshal(i)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,2]`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`[1.5]`), &i))
+	assert.False(t, i.Valid)
+}
+
+func TestNullInts(t *testing.T) {
+	var i NullInts
+
+	assert.NoError(t, i.Scan(`{1,NULL,3}`))
+	assert.Equal(t, NullIntsFrom([]Int{IntFrom(1), {}, IntFrom(3)}), i)
+
+	v, err := i.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{1,NULL,3}`, v)
+
+	data, err := json.Marshal(i)
+	assert.NoError(t, err)
+	assert.Equal(t, `[1,null,3]`, string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte(`[null,2]`), &i))
+	assert.Equal(t, []Int{{}, IntFrom(2)}, i.Data)
+
+	assert.Error(t, i.Scan(`{1,x}`))
+	assert.False(t, i.Valid)
+}
+
+func TestIntsDims(t *testing.T) {
+	var i Ints
+
+	assert.NoError(t, i.Scan(`{{1,2,3},{4,5,6}}`))
+	assert.Equal(t, Ints{Data: []int64{1, 2, 3, 4, 5, 6}, Valid: true, Dims: []int{2, 3}}, i)
+
+	v, err := i.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{{1,2,3},{4,5,6}}`, v)
+
+	data, err := json.Marshal(i)
+	assert.NoError(t, err)
+	assert.Equal(t, `[[1,2,3],[4,5,6]]`, string(data))
+
+	var decoded Ints
+
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, i, decoded)
+
+	assert.NoError(t, i.Scan(`{1,2}`))
+	assert.Nil(t, i.Dims)
+
+	i.Dims = []int{2, 2}
+
+	_, err = i.Value()
+	assert.Error(t, err)
+
+	_, err = json.Marshal(i)
+	assert.Error(t, err)
+
+	i.SetValid([]int64{1})
+	assert.Nil(t, i.Dims)
+
+	assert.Error(t, i.Scan(`{{1,2},{3}}`))
+	assert.False(t, i.Valid)
+	assert.Nil(t, i.Dims)
+}
+
+func TestNullIntsDims(t *testing.T) {
+	var i NullInts
+
+	assert.NoError(t, i.Scan(`{{1,NULL},{NULL,4}}`))
+	assert.Equal(t, []int{2, 2}, i.Dims)
+	assert.Equal(t, []Int{IntFrom(1), {}, {}, IntFrom(4)}, i.Data)
+
+	v, err := i.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{{1,NULL},{NULL,4}}`, v)
+
+	data, err := json.Marshal(i)
+	assert.NoError(t, err)
+	assert.Equal(t, `[[1,null],[null,4]]`, string(data))
+}
+
+func TestIntsAccessors(t *testing.T) {
+	a := IntsFrom([]int64{1, 2})
+	b := IntsFrom([]int64{1, 10})
+
+	assert.Equal(t, -1, a.Compare(b, NullsFirst))
+	assert.Equal(t, 1, b.Compare(a, NullsFirst))
+	assert.True(t, a.Equal(IntsFrom([]int64{1, 2})))
+	assert.Equal(t, []int64{1, 2}, a.MustGet())
+	assert.Equal(t, `{1,2}`, a.String())
+
+	n := NullIntsFrom([]Int{{}, IntFrom(1)})
+	assert.True(t, n.Equal(NullIntsFrom([]Int{{}, IntFrom(1)})))
+	assert.False(t, n.Equal(NullIntsFrom([]Int{IntFrom(0), IntFrom(1)})))
+	assert.Panics(t, func() {
+		NullInts{}.MustGet()
+	})
+}
+
+func TestIntsEncodings(t *testing.T) {
+	for _, v := range []Ints{IntsFrom([]int64{1, -2}), {Data: []int64{1, 2, 3, 4, 5, 6}, Valid: true, Dims: []int{3, 1, 2}}, {}} {
+		testTextEncodings(t, v, func() interface{} { return &Ints{} })
+	}
+
+	for _, v := range []NullInts{NullIntsFrom([]Int{IntFrom(1), {}}), {}} {
+		testTextEncodings(t, v, func() interface{} { return &NullInts{} })
+	}
+}
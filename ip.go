@@ -0,0 +1,317 @@
+//go:build go1.18
+
+package std
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+var (
+	_ sql.Scanner                = (*IP)(nil)
+	_ driver.Valuer              = IP{}
+	_ json.Marshaler             = IP{}
+	_ json.Unmarshaler           = (*IP)(nil)
+	_ encoding.TextMarshaler     = IP{}
+	_ encoding.TextUnmarshaler   = (*IP)(nil)
+	_ xml.Marshaler              = IP{}
+	_ xml.Unmarshaler            = (*IP)(nil)
+	_ xml.MarshalerAttr          = IP{}
+	_ xml.UnmarshalerAttr        = (*IP)(nil)
+	_ encoding.BinaryMarshaler   = IP{}
+	_ encoding.BinaryUnmarshaler = (*IP)(nil)
+	_ gob.GobEncoder             = IP{}
+	_ gob.GobDecoder             = (*IP)(nil)
+	_ yamlMarshaler              = IP{}
+	_ yamlUnmarshaler            = (*IP)(nil)
+	_ fmt.Stringer               = IP{}
+)
+
+// IP is a nullable netip.Addr, mapping to a Postgres inet column holding host addresses.
+// It scans from and marshals to the canonical text form of the address,
+// the mask of an inet value is accepted if it covers the whole address, like 192.0.2.1/32.
+// Use Prefix for inet values with a network mask.
+type IP struct {
+	Data  netip.Addr
+	Valid bool
+}
+
+// NewIP creates a new IP.
+func NewIP(ip netip.Addr, valid bool) IP {
+	return IP{
+		Data:  ip,
+		Valid: valid,
+	}
+}
+
+// IPFrom creates a new IP that will be null if ip is the zero netip.Addr.
+func IPFrom(ip netip.Addr) IP {
+	return NewIP(ip, ip.IsValid())
+}
+
+// ParseIP parses s as an IP address, with or without a host mask.
+// A blank string gives a null IP.
+func ParseIP(s string) (IP, error) {
+	var ip IP
+
+	err := ip.UnmarshalText([]byte(s))
+
+	return ip, err
+}
+
+// parseIPAddr parses an address, with an optional host mask.
+func parseIPAddr(s string) (netip.Addr, error) {
+	if !strings.Contains(s, "/") {
+		return netip.ParseAddr(s)
+	}
+
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Addr{}, err // nolint: wrapcheck
+	}
+
+	if !p.IsSingleIP() {
+		return netip.Addr{}, fmt.Errorf("null: %q is a network, not an IP address, use null.Prefix", s)
+	}
+
+	return p.Addr(), nil
+}
+
+// Scan implements the Scanner interface.
+func (ip *IP) Scan(value interface{}) error {
+	var err error
+
+	switch x := value.(type) {
+	case nil:
+		ip.Data, ip.Valid = netip.Addr{}, false
+
+		return nil
+	case []byte:
+		ip.Data, err = parseIPAddr(string(x))
+	case string:
+		ip.Data, err = parseIPAddr(x)
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.IP: %v", value, value)
+	}
+
+	if err != nil {
+		ip.Data = netip.Addr{}
+	}
+
+	ip.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (ip IP) Value() (driver.Value, error) {
+	if !ip.Valid {
+		return nil, nil
+	}
+
+	return ip.Data.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (ip *IP) UnmarshalJSON(data []byte) error {
+	var s String
+
+	if err := s.UnmarshalJSON(data); err != nil {
+		ip.Data, ip.Valid = netip.Addr{}, false
+
+		return err
+	}
+
+	if !s.Valid {
+		ip.Data, ip.Valid = netip.Addr{}, false
+
+		return nil
+	}
+
+	return ip.Scan(s.Data)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this IP is null.
+func (ip IP) MarshalJSON() ([]byte, error) {
+	if !ip.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(ip.Data.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null IP if the input is a blank string.
+func (ip *IP) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		ip.Data, ip.Valid = netip.Addr{}, false
+
+		return nil
+	}
+
+	return ip.Scan(text)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string when this IP is null.
+func (ip IP) MarshalText() ([]byte, error) {
+	if !ip.Valid {
+		return []byte{}, nil
+	}
+
+	return ip.Data.MarshalText()
+}
+
+// SetValid changes this IP's value and also sets it to be non-null.
+func (ip *IP) SetValid(v netip.Addr) {
+	ip.Data = v
+	ip.Valid = true
+}
+
+// Ptr returns a pointer to this IP's value, or a nil pointer if this IP is null.
+func (ip IP) Ptr() *netip.Addr {
+	if !ip.Valid {
+		return nil
+	}
+
+	return &ip.Data
+}
+
+// IsZero returns true for null IPs, for potential future omitempty support.
+func (ip IP) IsZero() bool {
+	return !ip.Valid
+}
+
+// String implements fmt.Stringer interface.
+// It returns a blank string if this IP is null.
+func (ip IP) String() string {
+	if !ip.Valid {
+		return ""
+	}
+
+	return ip.Data.String()
+}
+
+// Equal reports whether ip and o are both null or both valid with the same address.
+func (ip IP) Equal(o IP) bool {
+	return ip.Valid == o.Valid && (!ip.Valid || ip.Data == o.Data)
+}
+
+// Compare returns -1 if ip is less than o, 0 if they are equal and +1 if ip is greater than o,
+// like netip.Addr.Compare. Null values are ordered according to nulls.
+func (ip IP) Compare(o IP, nulls NullOrder) int {
+	if c, ok := compareNull(ip.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return ip.Data.Compare(o.Data)
+}
+
+// ValueOr returns this IP's value, or def if this IP is null.
+func (ip IP) ValueOr(def netip.Addr) netip.Addr {
+	if !ip.Valid {
+		return def
+	}
+
+	return ip.Data
+}
+
+// ValueOrZero returns this IP's value, or the zero value of netip.Addr if this IP is null.
+func (ip IP) ValueOrZero() netip.Addr {
+	if !ip.Valid {
+		return netip.Addr{}
+	}
+
+	return ip.Data
+}
+
+// OrElse returns this IP's value, or the result of fn if this IP is null.
+// fn is only called when this IP is null.
+func (ip IP) OrElse(fn func() netip.Addr) netip.Addr {
+	if !ip.Valid {
+		return fn()
+	}
+
+	return ip.Data
+}
+
+// Get returns this IP's value and true, or the zero value of netip.Addr and false if this IP is null.
+func (ip IP) Get() (netip.Addr, bool) {
+	return ip.ValueOrZero(), ip.Valid
+}
+
+// MustGet returns this IP's value, it panics if this IP is null.
+func (ip IP) MustGet() netip.Addr {
+	if !ip.Valid {
+		panic("std: MustGet called on a null IP")
+	}
+
+	return ip.Data
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this IP is null.
+func (ip IP) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, ip.Valid, ip.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null IP, an empty element is a null IP.
+func (ip *IP) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, ip)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this IP is null.
+func (ip IP) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, ip.Valid, ip.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (ip *IP) UnmarshalXMLAttr(attr xml.Attr) error {
+	return ip.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (ip IP) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(ip.Valid, ip.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (ip *IP) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.IP", ip)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (ip IP) GobEncode() ([]byte, error) {
+	return ip.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (ip *IP) GobDecode(data []byte) error {
+	return ip.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this IP is null, its text form otherwise.
+func (ip IP) MarshalYAML() (interface{}, error) {
+	if !ip.Valid {
+		return nil, nil
+	}
+
+	return ip.String(), nil
+}
+
+// unmarshalYAML decodes this IP with the unmarshal func of a YAML library.
+func (ip *IP) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, "std.IP", ip)
+}
@@ -0,0 +1,169 @@
+//go:build go1.18
+
+package std
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPFrom(t *testing.T) {
+	ip := IPFrom(netip.MustParseAddr("192.0.2.1"))
+	assert.True(t, ip.Valid)
+	assert.Equal(t, "192.0.2.1", ip.String())
+
+	assert.False(t, IPFrom(netip.Addr{}).Valid)
+}
+
+func TestIPScan(t *testing.T) {
+	testCases := []struct {
+		src      interface{}
+		expected string
+	}{
+		{"192.0.2.1", "192.0.2.1"},
+		{[]byte("192.0.2.1/32"), "192.0.2.1"},
+		{"2001:DB8:0:0::1", "2001:db8::1"},
+		{"2001:db8::1/128", "2001:db8::1"},
+		{"::ffff:192.0.2.1", "::ffff:192.0.2.1"},
+	}
+
+	for _, tc := range testCases {
+		var ip IP
+
+		assert.NoError(t, ip.Scan(tc.src), tc.src)
+		assert.True(t, ip.Valid)
+
+		v, err := ip.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, v)
+	}
+
+	var ip IP
+
+	assert.EqualError(t, ip.Scan("192.0.2.1/24"), `null: "192.0.2.1/24" is a network, not an IP address, use null.Prefix`)
+	assert.False(t, ip.Valid)
+	assert.Error(t, ip.Scan("192.0.2.256"))
+	assert.EqualError(t, ip.Scan(int64(1)), "null: cannot scan type int64 into null.IP: 1")
+
+	assert.NoError(t, ip.Scan(nil))
+	assert.False(t, ip.Valid)
+
+	v, err := ip.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestIPJSON(t *testing.T) {
+	var ip IP
+
+	assert.NoError(t, json.Unmarshal([]byte(`"2001:DB8::1"`), &ip))
+	assert.Equal(t, netip.MustParseAddr("2001:db8::1"), ip.Data)
+
+	data, err := json.Marshal(ip)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2001:db8::1"`, string(data))
+
+	assert.NoError(t, json.Unmarshal(nullJSON, &ip))
+	assert.False(t, ip.Valid)
+
+	data, err = json.Marshal(ip)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`"x"`), &ip))
+	assert.Error(t, json.Unmarshal([]byte(`1`), &ip))
+	assert.False(t, ip.Valid)
+}
+
+func TestParseIP(t *testing.T) {
+	ip, err := ParseIP("192.0.2.1")
+	assert.NoError(t, err)
+	assert.True(t, ip.Equal(IPFrom(netip.MustParseAddr("192.0.2.1"))))
+	assert.Equal(t, netip.MustParseAddr("192.0.2.1"), *ip.Ptr())
+
+	ip, err = ParseIP("")
+	assert.NoError(t, err)
+	assert.True(t, ip.IsZero())
+	assert.Nil(t, ip.Ptr())
+	assert.True(t, ip.Equal(IP{}))
+
+	text, err := ip.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(text))
+}
+
+func TestNetworkNullableSuite(t *testing.T) {
+	t.Run("IP", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &IP{} },
+			Value: netip.MustParseAddr("2001:db8::1"),
+			Scan: []stdtest.ScanCase{
+				{Src: []byte("192.0.2.1"), Want: "192.0.2.1"},
+				{Src: "192.0.2.0/24", Err: true},
+			},
+		})
+	})
+
+	t.Run("Prefix", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &Prefix{} },
+			Value: netip.MustParsePrefix("192.0.2.0/24"),
+			Scan: []stdtest.ScanCase{
+				{Src: []byte("192.0.2.1"), Want: "192.0.2.1/32"},
+				{Src: "192.0.2.0/33", Err: true},
+			},
+		})
+	})
+
+	t.Run("HardwareAddr", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &HardwareAddr{} },
+			Value: testHardwareAddr,
+			Scan: []stdtest.ScanCase{
+				{Src: []byte("08002b010203"), Want: "08:00:2b:01:02:03"},
+				{Src: "08:00:2b", Err: true},
+			},
+		})
+	})
+}
+
+func TestIPAccessors(t *testing.T) {
+	a, _ := ParseIP("192.0.2.1")
+	b, _ := ParseIP("2001:db8::1")
+
+	assert.Equal(t, -1, a.Compare(b, NullsFirst))
+	assert.Equal(t, 1, b.Compare(a, NullsFirst))
+	assert.Equal(t, 0, a.Compare(a, NullsFirst))
+	assert.Equal(t, -1, IP{}.Compare(a, NullsFirst))
+	assert.Equal(t, 1, IP{}.Compare(a, NullsLast))
+	assert.Equal(t, 0, IP{}.Compare(IP{}, NullsLast))
+
+	assert.Equal(t, a.Data, a.ValueOr(b.Data))
+	assert.Equal(t, b.Data, IP{}.ValueOr(b.Data))
+	assert.Equal(t, netip.Addr{}, IP{}.ValueOrZero())
+	assert.Equal(t, b.Data, IP{}.OrElse(func() netip.Addr { return b.Data }))
+
+	v, ok := a.Get()
+	assert.True(t, ok)
+	assert.Equal(t, a.Data, v)
+
+	_, ok = IP{}.Get()
+	assert.False(t, ok)
+
+	assert.Equal(t, a.Data, a.MustGet())
+	assert.Panics(t, func() {
+		IP{}.MustGet()
+	})
+}
+
+func TestIPEncodings(t *testing.T) {
+	ip, _ := ParseIP("2001:db8::1")
+
+	for _, v := range []IP{ip, {}} {
+		testTextEncodings(t, v, func() interface{} { return &IP{} })
+	}
+}
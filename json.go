@@ -0,0 +1,185 @@
+package std
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"unicode/utf8"
+)
+
+// jsonKind is the kind of a JSON value.
+type jsonKind int
+
+const (
+	jsonNull jsonKind = iota
+	jsonBool
+	jsonNumber
+	jsonString
+	jsonObject
+	jsonArray
+)
+
+// String returns the name of the Go type encoding/json decodes the kind to,
+// as reported in the type errors.
+func (k jsonKind) String() string {
+	switch k {
+	case jsonNull:
+		return "nil"
+	case jsonBool:
+		return "bool"
+	case jsonNumber:
+		return "float64"
+	case jsonString:
+		return "string"
+	case jsonObject:
+		return "map"
+	}
+
+	return ""
+}
+
+// jsonKindOf returns the kind of the JSON value in data.
+// Well-formed scalars are recognised from their raw token in a single pass,
+// anything else is validated by encoding/json whose syntax error is returned.
+func jsonKindOf(data []byte) (jsonKind, error) {
+	if kind, ok := scanJSONScalar(data); ok {
+		if kind == jsonNumber && !fitsJSONFloat(data) {
+			var f float64
+
+			return jsonNull, json.Unmarshal(data, &f) // nolint: wrapcheck
+		}
+
+		return kind, nil
+	}
+
+	if !json.Valid(data) {
+		var raw json.RawMessage
+
+		return jsonNull, json.Unmarshal(data, &raw) // nolint: wrapcheck
+	}
+
+	data = bytes.TrimLeft(data, " \t\r\n")
+
+	switch data[0] {
+	case 'n':
+		return jsonNull, nil
+	case 't', 'f':
+		return jsonBool, nil
+	case '"':
+		return jsonString, nil
+	case '{':
+		return jsonObject, nil
+	case '[':
+		return jsonArray, nil
+	}
+
+	if !fitsJSONFloat(data) {
+		var f float64
+
+		return jsonNull, json.Unmarshal(data, &f) // nolint: wrapcheck
+	}
+
+	return jsonNumber, nil
+}
+
+// fitsJSONFloat reports whether the JSON number in data fits a float64,
+// as encoding/json requires when decoding any value.
+// Only numbers with an exponent or more than 308 digits may overflow.
+func fitsJSONFloat(data []byte) bool {
+	data = bytes.TrimSpace(data)
+
+	if len(data) <= 308 && bytes.IndexAny(data, "eE") < 0 {
+		return true
+	}
+
+	_, err := strconv.ParseFloat(string(data), 64)
+
+	return err == nil
+}
+
+// scanJSONScalar recognises the null and boolean literals, numbers
+// and strings without escape sequences. It returns false for anything else.
+func scanJSONScalar(data []byte) (jsonKind, bool) {
+	switch {
+	case len(data) == 0:
+		return jsonNull, false
+	case string(data) == "null":
+		return jsonNull, true
+	case string(data) == "true" || string(data) == "false":
+		return jsonBool, true
+	case isJSONSimpleString(data):
+		return jsonString, true
+	case isJSONNumber(data):
+		return jsonNumber, true
+	}
+
+	return jsonNull, false
+}
+
+// isJSONSimpleString reports whether data is a JSON string without escape sequences,
+// whose content can be used as is.
+func isJSONSimpleString(data []byte) bool {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return false
+	}
+
+	for _, c := range data[1 : len(data)-1] {
+		if c < 0x20 || c == '"' || c == '\\' {
+			return false
+		}
+	}
+
+	return utf8.Valid(data)
+}
+
+// isJSONNumber reports whether data is a number following the JSON grammar.
+func isJSONNumber(data []byte) bool {
+	i := 0
+
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+
+	switch {
+	case i < len(data) && data[i] == '0':
+		i++
+	case i < len(data) && data[i] >= '1' && data[i] <= '9':
+		i = skipDigits(data, i+1)
+	default:
+		return false
+	}
+
+	if i < len(data) && data[i] == '.' {
+		j := skipDigits(data, i+1)
+		if j == i+1 {
+			return false
+		}
+
+		i = j
+	}
+
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		i++
+
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			i++
+		}
+
+		j := skipDigits(data, i)
+		if j == i {
+			return false
+		}
+
+		i = j
+	}
+
+	return i == len(data)
+}
+
+func skipDigits(data []byte, i int) int {
+	for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+		i++
+	}
+
+	return i
+}
@@ -0,0 +1,416 @@
+package std
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The legacy decoders are the previous implementations, decoding into an interface{} first.
+// They are kept to check the error behaviour is unchanged and to benchmark the decoders.
+
+func legacyUnmarshalInt(i *Int, data []byte) error {
+	var (
+		err error
+		v   interface{}
+	)
+
+	if err = json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Int: %w", string(data), err)
+	}
+
+	switch v.(type) {
+	case float64:
+		err = json.Unmarshal(data, &i.Data)
+	case map[string]interface{}:
+		i.Valid, err = unmarshalNullObject(data, "Int64", "null.Int", &i.Data)
+
+		return err
+	case nil:
+		i.Valid = false
+
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
+	}
+
+	i.Valid = err == nil
+
+	return err
+}
+
+func legacyUnmarshalUint(i *Uint, data []byte) error {
+	var (
+		err error
+		v   interface{}
+	)
+
+	if err = json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Uint: %w", string(data), err)
+	}
+
+	switch v.(type) {
+	case float64:
+		err = json.Unmarshal(data, &i.Data)
+	case map[string]interface{}:
+		i.Valid, err = unmarshalNullObject(data, "Int64", "null.Uint", &i.Data)
+
+		return err
+	case nil:
+		i.Valid = false
+
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint", reflect.TypeOf(v).Name())
+	}
+
+	i.Valid = err == nil
+
+	return err
+}
+
+func legacyUnmarshalFloat(f *Float, data []byte) error {
+	var (
+		err error
+		v   interface{}
+	)
+
+	if err = json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Float: %w", string(data), err)
+	}
+
+	switch x := v.(type) {
+	case float64:
+		f.Data = x
+	case map[string]interface{}:
+		f.Valid, err = unmarshalNullObject(data, "Float64", "null.Float", &f.Data)
+
+		return err
+	case nil:
+		f.Valid = false
+
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Float", reflect.TypeOf(v).Name())
+	}
+
+	f.Valid = err == nil
+
+	return err
+}
+
+func legacyUnmarshalBool(b *Bool, data []byte) error {
+	var (
+		err error
+		v   interface{}
+	)
+
+	if err = json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Bool: %w", string(data), err)
+	}
+
+	switch x := v.(type) {
+	case bool:
+		b.Data = x
+	case map[string]interface{}:
+		b.Valid, err = unmarshalNullObject(data, "Bool", "null.Bool", &b.Data)
+
+		return err
+	case nil:
+		b.Valid = false
+
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Bool", reflect.TypeOf(v).Name())
+	}
+
+	b.Valid = err == nil
+
+	return err
+}
+
+func legacyUnmarshalString(s *String, data []byte) error {
+	var (
+		err error
+		v   interface{}
+	)
+
+	if err = json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.String: %w", string(data), err)
+	}
+
+	switch x := v.(type) {
+	case string:
+		s.Data = x
+	case map[string]interface{}:
+		s.Valid, err = unmarshalNullObject(data, "String", "null.String", &s.Data)
+
+		return err
+	case nil:
+		s.Valid = false
+
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.String", reflect.TypeOf(v).Name())
+	}
+
+	s.Valid = err == nil
+
+	return err
+}
+
+func legacyUnmarshalTime(t *Time, data []byte) error {
+	var (
+		err error
+		v   interface{}
+	)
+
+	if err = json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("json: %w", err)
+	}
+
+	switch v.(type) {
+	case string:
+		err = t.Time.UnmarshalJSON(data)
+	case map[string]interface{}:
+		t.Valid, err = unmarshalNullObject(data, "Time", "null.Time", &t.Time)
+
+		return err
+	case nil:
+		t.Valid = false
+
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Time", reflect.TypeOf(v).Name())
+	}
+
+	t.Valid = err == nil
+
+	return err
+}
+
+var jsonInputs = []string{
+	`null`, `true`, `false`, `0`, `-0`, `12345`, `-12345`, `1.2345`, `-1.5e3`, `1E-2`, `1e400`,
+	`9223372036854775807`, `9223372036854775808`, `18446744073709551615`, `18446744073709551616`,
+	`"test"`, `""`, `"café \n"`, `"2012-12-21T21:21:21Z"`, `"2012-12-21T21:21:21Z"`, `"2012-12-21"`,
+	`{"Int64":12345,"Valid":true}`, `{"String":"test","Valid":true}`, `{"Float64":1.5,"Valid":true}`,
+	`{"Bool":true,"Valid":true}`, `{"Time":"2012-12-21T21:21:21Z","Valid":true}`, `{"hello": "world"}`,
+	`[]`, `[1]`, ` 12345 `, ` null`, `true `, ` "test"`, `01`, `+1`, `-`, `1.`, `.5`, `1e`, `nul`, `:)`,
+	`"test`, `"a" "b"`, "\"\x01\"", "\"\xff\"", ``,
+}
+
+func assertSameJSONDecoding(t *testing.T, input string, newErr, oldErr error, newValue, oldValue interface{}) {
+	t.Helper()
+
+	if oldErr == nil {
+		assert.NoError(t, newErr, input)
+	} else if assert.Error(t, newErr, input) {
+		assert.Equal(t, oldErr.Error(), newErr.Error(), input)
+	}
+
+	assert.Equal(t, oldValue, newValue, input)
+}
+
+func TestUnmarshalJSONUnchanged(t *testing.T) {
+	for _, input := range jsonInputs {
+		data := []byte(input)
+
+		var newInt, oldInt Int
+		assertSameJSONDecoding(t, input, newInt.UnmarshalJSON(data), legacyUnmarshalInt(&oldInt, data), newInt, oldInt)
+
+		var newUint, oldUint Uint
+		assertSameJSONDecoding(t, input, newUint.UnmarshalJSON(data), legacyUnmarshalUint(&oldUint, data), newUint, oldUint)
+
+		var newFloat, oldFloat Float
+		assertSameJSONDecoding(t, input, newFloat.UnmarshalJSON(data), legacyUnmarshalFloat(&oldFloat, data), newFloat, oldFloat)
+
+		var newBool, oldBool Bool
+		assertSameJSONDecoding(t, input, newBool.UnmarshalJSON(data), legacyUnmarshalBool(&oldBool, data), newBool, oldBool)
+
+		var newString, oldString String
+		assertSameJSONDecoding(t, input, newString.UnmarshalJSON(data), legacyUnmarshalString(&oldString, data), newString, oldString)
+
+		var newTime, oldTime Time
+		assertSameJSONDecoding(t, input, newTime.UnmarshalJSON(data), legacyUnmarshalTime(&oldTime, data), newTime, oldTime)
+	}
+}
+
+func TestIsJSONNumber(t *testing.T) {
+	for _, input := range []string{`0`, `-0`, `12345`, `-1.5`, `1e10`, `1E+10`, `1.5e-10`} {
+		assert.True(t, isJSONNumber([]byte(input)), input)
+	}
+
+	for _, input := range []string{``, `-`, `01`, `+1`, `1.`, `.5`, `1e`, `1e+`, `0x10`, `1 `, `NaN`} {
+		assert.False(t, isJSONNumber([]byte(input)), input)
+	}
+}
+
+type jsonRow struct {
+	String   String `json:"string"`
+	Int      Int    `json:"int"`
+	Uint     Uint   `json:"uint"`
+	Float    Float  `json:"float"`
+	Bool     Bool   `json:"bool"`
+	Time     Time   `json:"time"`
+	NullInt  Int    `json:"null_int"`
+	NullTime Time   `json:"null_time"`
+}
+
+type (
+	legacyString struct{ String }
+	legacyInt    struct{ Int }
+	legacyUint   struct{ Uint }
+	legacyFloat  struct{ Float }
+	legacyBool   struct{ Bool }
+	legacyTime   struct{ Time }
+)
+
+func (s *legacyString) UnmarshalJSON(data []byte) error {
+	return legacyUnmarshalString(&s.String, data)
+}
+func (i *legacyInt) UnmarshalJSON(data []byte) error   { return legacyUnmarshalInt(&i.Int, data) }
+func (i *legacyUint) UnmarshalJSON(data []byte) error  { return legacyUnmarshalUint(&i.Uint, data) }
+func (f *legacyFloat) UnmarshalJSON(data []byte) error { return legacyUnmarshalFloat(&f.Float, data) }
+func (b *legacyBool) UnmarshalJSON(data []byte) error  { return legacyUnmarshalBool(&b.Bool, data) }
+func (t *legacyTime) UnmarshalJSON(data []byte) error  { return legacyUnmarshalTime(&t.Time, data) }
+
+type legacyJSONRow struct {
+	String   legacyString `json:"string"`
+	Int      legacyInt    `json:"int"`
+	Uint     legacyUint   `json:"uint"`
+	Float    legacyFloat  `json:"float"`
+	Bool     legacyBool   `json:"bool"`
+	Time     legacyTime   `json:"time"`
+	NullInt  legacyInt    `json:"null_int"`
+	NullTime legacyTime   `json:"null_time"`
+}
+
+func largeJSONPayload(rows int) []byte {
+	payload := make([]jsonRow, rows)
+
+	for i := range payload {
+		payload[i] = jsonRow{
+			String: StringFrom("row " + strconv.Itoa(i)),
+			Int:    IntFrom(int64(i) * -1000),
+			Uint:   UintFrom(uint64(i) * 1000),
+			Float:  FloatFrom(float64(i) / 3),
+			Bool:   BoolFrom(i%2 == 0),
+			Time:   TimeFrom(timeValue),
+		}
+	}
+
+	data, _ := json.Marshal(payload)
+
+	return data
+}
+
+func TestLargeJSONPayload(t *testing.T) {
+	data := largeJSONPayload(100)
+
+	var rows []jsonRow
+	assert.NoError(t, json.Unmarshal(data, &rows))
+
+	var legacyRows []legacyJSONRow
+	assert.NoError(t, json.Unmarshal(data, &legacyRows))
+
+	for i, row := range rows {
+		assert.Equal(t, legacyRows[i].String.String, row.String)
+		assert.Equal(t, legacyRows[i].Int.Int, row.Int)
+		assert.Equal(t, legacyRows[i].Uint.Uint, row.Uint)
+		assert.Equal(t, legacyRows[i].Float.Float, row.Float)
+		assert.Equal(t, legacyRows[i].Bool.Bool, row.Bool)
+		assert.Equal(t, legacyRows[i].Time.Time, row.Time)
+		assert.False(t, row.NullInt.Valid)
+		assert.False(t, row.NullTime.Valid)
+	}
+}
+
+func BenchmarkUnmarshalJSONLargePayload(b *testing.B) {
+	data := largeJSONPayload(10000)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var rows []jsonRow
+
+		_ = json.Unmarshal(data, &rows)
+	}
+}
+
+func BenchmarkLegacyUnmarshalJSONLargePayload(b *testing.B) {
+	data := largeJSONPayload(10000)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var rows []legacyJSONRow
+
+		_ = json.Unmarshal(data, &rows)
+	}
+}
+
+func BenchmarkIntUnmarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var v Int
+
+		_ = v.UnmarshalJSON(intJSON)
+	}
+}
+
+func BenchmarkLegacyIntUnmarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var v Int
+
+		_ = legacyUnmarshalInt(&v, intJSON)
+	}
+}
+
+func BenchmarkStringUnmarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var v String
+
+		_ = v.UnmarshalJSON(stringJSON)
+	}
+}
+
+func BenchmarkLegacyStringUnmarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var v String
+
+		_ = legacyUnmarshalString(&v, stringJSON)
+	}
+}
+
+func BenchmarkTimeUnmarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var v Time
+
+		_ = v.UnmarshalJSON(timeJSON)
+	}
+}
+
+func BenchmarkLegacyTimeUnmarshalJSON(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var v Time
+
+		_ = legacyUnmarshalTime(&v, timeJSON)
+	}
+}
@@ -0,0 +1,345 @@
+//go:build go1.18
+
+package std
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+var (
+	_ sql.Scanner                = (*Prefix)(nil)
+	_ driver.Valuer              = Prefix{}
+	_ json.Marshaler             = Prefix{}
+	_ json.Unmarshaler           = (*Prefix)(nil)
+	_ encoding.TextMarshaler     = Prefix{}
+	_ encoding.TextUnmarshaler   = (*Prefix)(nil)
+	_ xml.Marshaler              = Prefix{}
+	_ xml.Unmarshaler            = (*Prefix)(nil)
+	_ xml.MarshalerAttr          = Prefix{}
+	_ xml.UnmarshalerAttr        = (*Prefix)(nil)
+	_ encoding.BinaryMarshaler   = Prefix{}
+	_ encoding.BinaryUnmarshaler = (*Prefix)(nil)
+	_ gob.GobEncoder             = Prefix{}
+	_ gob.GobDecoder             = (*Prefix)(nil)
+	_ yamlMarshaler              = Prefix{}
+	_ yamlUnmarshaler            = (*Prefix)(nil)
+	_ fmt.Stringer               = Prefix{}
+)
+
+// Prefix is a nullable netip.Prefix, mapping to a Postgres cidr or inet column.
+// It scans from and marshals to the canonical text form of the prefix,
+// an address without a mask is a single address prefix, like 192.0.2.1/32.
+// The address bits outside of the mask are kept, as in inet values, use Masked to clear them.
+type Prefix struct {
+	Data  netip.Prefix
+	Valid bool
+}
+
+// NewPrefix creates a new Prefix.
+func NewPrefix(p netip.Prefix, valid bool) Prefix {
+	return Prefix{
+		Data:  p,
+		Valid: valid,
+	}
+}
+
+// PrefixFrom creates a new Prefix that will be null if p is the zero netip.Prefix.
+func PrefixFrom(p netip.Prefix) Prefix {
+	return NewPrefix(p, p.IsValid())
+}
+
+// ParsePrefix parses s as a prefix, with or without a mask.
+// A blank string gives a null Prefix.
+func ParsePrefix(s string) (Prefix, error) {
+	var p Prefix
+
+	err := p.UnmarshalText([]byte(s))
+
+	return p, err
+}
+
+// parseIPPrefix parses a prefix, an address without a mask is a single address prefix.
+func parseIPPrefix(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		return netip.ParsePrefix(s)
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err // nolint: wrapcheck
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// Scan implements the Scanner interface.
+func (p *Prefix) Scan(value interface{}) error {
+	var err error
+
+	switch x := value.(type) {
+	case nil:
+		p.Data, p.Valid = netip.Prefix{}, false
+
+		return nil
+	case []byte:
+		p.Data, err = parseIPPrefix(string(x))
+	case string:
+		p.Data, err = parseIPPrefix(x)
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.Prefix: %v", value, value)
+	}
+
+	if err != nil {
+		p.Data = netip.Prefix{}
+	}
+
+	p.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (p Prefix) Value() (driver.Value, error) {
+	if !p.Valid {
+		return nil, nil
+	}
+
+	return p.Data.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (p *Prefix) UnmarshalJSON(data []byte) error {
+	var s String
+
+	if err := s.UnmarshalJSON(data); err != nil {
+		p.Data, p.Valid = netip.Prefix{}, false
+
+		return err
+	}
+
+	if !s.Valid {
+		p.Data, p.Valid = netip.Prefix{}, false
+
+		return nil
+	}
+
+	return p.Scan(s.Data)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Prefix is null.
+func (p Prefix) MarshalJSON() ([]byte, error) {
+	if !p.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(p.Data.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Prefix if the input is a blank string.
+func (p *Prefix) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		p.Data, p.Valid = netip.Prefix{}, false
+
+		return nil
+	}
+
+	return p.Scan(text)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string when this Prefix is null.
+func (p Prefix) MarshalText() ([]byte, error) {
+	if !p.Valid {
+		return []byte{}, nil
+	}
+
+	return p.Data.MarshalText()
+}
+
+// SetValid changes this Prefix's value and also sets it to be non-null.
+func (p *Prefix) SetValid(v netip.Prefix) {
+	p.Data = v
+	p.Valid = true
+}
+
+// Ptr returns a pointer to this Prefix's value, or a nil pointer if this Prefix is null.
+func (p Prefix) Ptr() *netip.Prefix {
+	if !p.Valid {
+		return nil
+	}
+
+	return &p.Data
+}
+
+// IsZero returns true for null Prefixes, for potential future omitempty support.
+func (p Prefix) IsZero() bool {
+	return !p.Valid
+}
+
+// String implements fmt.Stringer interface.
+// It returns a blank string if this Prefix is null.
+func (p Prefix) String() string {
+	if !p.Valid {
+		return ""
+	}
+
+	return p.Data.String()
+}
+
+// Equal reports whether p and o are both null or both valid with the same prefix.
+func (p Prefix) Equal(o Prefix) bool {
+	return p.Valid == o.Valid && (!p.Valid || p.Data == o.Data)
+}
+
+// Compare returns -1 if p is less than o, 0 if they are equal and +1 if p is greater than o,
+// ordered by address and then by prefix length. Null values are ordered according to nulls.
+func (p Prefix) Compare(o Prefix, nulls NullOrder) int {
+	if c, ok := compareNull(p.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	if c := p.Data.Addr().Compare(o.Data.Addr()); c != 0 {
+		return c
+	}
+
+	switch {
+	case p.Data.Bits() < o.Data.Bits():
+		return -1
+	case p.Data.Bits() > o.Data.Bits():
+		return 1
+	}
+
+	return 0
+}
+
+// ValueOr returns this Prefix's value, or def if this Prefix is null.
+func (p Prefix) ValueOr(def netip.Prefix) netip.Prefix {
+	if !p.Valid {
+		return def
+	}
+
+	return p.Data
+}
+
+// ValueOrZero returns this Prefix's value, or the zero value of netip.Prefix if this Prefix is null.
+func (p Prefix) ValueOrZero() netip.Prefix {
+	if !p.Valid {
+		return netip.Prefix{}
+	}
+
+	return p.Data
+}
+
+// OrElse returns this Prefix's value, or the result of fn if this Prefix is null.
+// fn is only called when this Prefix is null.
+func (p Prefix) OrElse(fn func() netip.Prefix) netip.Prefix {
+	if !p.Valid {
+		return fn()
+	}
+
+	return p.Data
+}
+
+// Get returns this Prefix's value and true, or the zero value of netip.Prefix and false if this Prefix is null.
+func (p Prefix) Get() (netip.Prefix, bool) {
+	return p.ValueOrZero(), p.Valid
+}
+
+// MustGet returns this Prefix's value, it panics if this Prefix is null.
+func (p Prefix) MustGet() netip.Prefix {
+	if !p.Valid {
+		panic("std: MustGet called on a null Prefix")
+	}
+
+	return p.Data
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Prefix is null.
+func (p Prefix) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, p.Valid, p.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Prefix, an empty element is a null Prefix.
+func (p *Prefix) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, p)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Prefix is null.
+func (p Prefix) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, p.Valid, p.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (p *Prefix) UnmarshalXMLAttr(attr xml.Attr) error {
+	return p.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (p Prefix) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(p.Valid, p.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (p *Prefix) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.Prefix", p)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (p Prefix) GobEncode() ([]byte, error) {
+	return p.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (p *Prefix) GobDecode(data []byte) error {
+	return p.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Prefix is null, its text form otherwise.
+func (p Prefix) MarshalYAML() (interface{}, error) {
+	if !p.Valid {
+		return nil, nil
+	}
+
+	return p.String(), nil
+}
+
+// unmarshalYAML decodes this Prefix with the unmarshal func of a YAML library.
+func (p *Prefix) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, "std.Prefix", p)
+}
+
+// Masked returns this Prefix with the address bits outside of the mask cleared, like a cidr value.
+func (p Prefix) Masked() Prefix {
+	if !p.Valid {
+		return p
+	}
+
+	return NewPrefix(p.Data.Masked(), true)
+}
+
+// Contains reports whether the network of this Prefix contains ip, like the >>= operator.
+// It is false if this Prefix or ip is null.
+func (p Prefix) Contains(ip IP) bool {
+	return p.Valid && ip.Valid && p.Data.Contains(ip.Data)
+}
+
+// ContainsPrefix reports whether the network of this Prefix contains the network of o, like the >>= operator.
+// It is false if one of them is null.
+func (p Prefix) ContainsPrefix(o Prefix) bool {
+	return p.Valid && o.Valid && p.Data.Bits() <= o.Data.Bits() && p.Data.Contains(o.Data.Addr())
+}
@@ -0,0 +1,139 @@
+//go:build go1.18
+
+package std
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixScan(t *testing.T) {
+	testCases := []struct {
+		src      interface{}
+		expected string
+	}{
+		{"192.0.2.0/24", "192.0.2.0/24"},
+		{[]byte("192.0.2.5/24"), "192.0.2.5/24"},
+		{"192.0.2.1", "192.0.2.1/32"},
+		{"2001:DB8::/32", "2001:db8::/32"},
+		{"2001:db8::1", "2001:db8::1/128"},
+	}
+
+	for _, tc := range testCases {
+		var p Prefix
+
+		assert.NoError(t, p.Scan(tc.src), tc.src)
+		assert.True(t, p.Valid)
+
+		v, err := p.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, v)
+	}
+
+	var p Prefix
+
+	assert.Error(t, p.Scan("192.0.2.0/33"))
+	assert.False(t, p.Valid)
+	assert.Error(t, p.Scan("x"))
+	assert.EqualError(t, p.Scan(int64(1)), "null: cannot scan type int64 into null.Prefix: 1")
+
+	assert.NoError(t, p.Scan(nil))
+	assert.False(t, p.Valid)
+
+	v, err := p.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestPrefixJSON(t *testing.T) {
+	var p Prefix
+
+	assert.NoError(t, json.Unmarshal([]byte(`"192.0.2.0/24"`), &p))
+	assert.Equal(t, netip.MustParsePrefix("192.0.2.0/24"), p.Data)
+
+	data, err := json.Marshal(p)
+	assert.NoError(t, err)
+	assert.Equal(t, `"192.0.2.0/24"`, string(data))
+
+	assert.NoError(t, json.Unmarshal(nullJSON, &p))
+	assert.True(t, p.IsZero())
+
+	data, err = json.Marshal(p)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`"x"`), &p))
+	assert.False(t, p.Valid)
+}
+
+func TestPrefixContains(t *testing.T) {
+	p, err := ParsePrefix("192.0.2.5/24")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "192.0.2.0/24", p.Masked().String())
+	assert.False(t, Prefix{}.Masked().Valid)
+
+	ip, _ := ParseIP("192.0.2.200")
+	assert.True(t, p.Contains(ip))
+
+	ip, _ = ParseIP("192.0.3.1")
+	assert.False(t, p.Contains(ip))
+
+	ip, _ = ParseIP("2001:db8::1")
+	assert.False(t, p.Contains(ip))
+	assert.False(t, p.Contains(IP{}))
+	assert.False(t, Prefix{}.Contains(ip))
+
+	sub, _ := ParsePrefix("192.0.2.128/25")
+	assert.True(t, p.ContainsPrefix(sub))
+	assert.False(t, sub.ContainsPrefix(p))
+	assert.True(t, p.ContainsPrefix(p))
+	assert.False(t, p.ContainsPrefix(Prefix{}))
+
+	assert.True(t, p.Equal(PrefixFrom(netip.MustParsePrefix("192.0.2.5/24"))))
+	assert.False(t, p.Equal(p.Masked()))
+	assert.Equal(t, netip.MustParsePrefix("192.0.2.5/24"), *p.Ptr())
+
+	empty, err := ParsePrefix("")
+	assert.NoError(t, err)
+	assert.Nil(t, empty.Ptr())
+	assert.Equal(t, "", empty.String())
+}
+
+func TestPrefixAccessors(t *testing.T) {
+	a, _ := ParsePrefix("192.0.2.0/24")
+	b, _ := ParsePrefix("192.0.2.0/25")
+	c, _ := ParsePrefix("192.0.3.0/24")
+
+	assert.Equal(t, -1, a.Compare(b, NullsFirst))
+	assert.Equal(t, 1, b.Compare(a, NullsFirst))
+	assert.Equal(t, -1, b.Compare(c, NullsFirst))
+	assert.Equal(t, 0, a.Compare(a, NullsFirst))
+	assert.Equal(t, -1, Prefix{}.Compare(a, NullsFirst))
+	assert.Equal(t, 1, Prefix{}.Compare(a, NullsLast))
+
+	assert.Equal(t, a.Data, a.ValueOr(b.Data))
+	assert.Equal(t, b.Data, Prefix{}.ValueOr(b.Data))
+	assert.Equal(t, netip.Prefix{}, Prefix{}.ValueOrZero())
+	assert.Equal(t, b.Data, Prefix{}.OrElse(func() netip.Prefix { return b.Data }))
+
+	v, ok := a.Get()
+	assert.True(t, ok)
+	assert.Equal(t, a.Data, v)
+
+	assert.Equal(t, a.Data, a.MustGet())
+	assert.Panics(t, func() {
+		Prefix{}.MustGet()
+	})
+}
+
+func TestPrefixEncodings(t *testing.T) {
+	p, _ := ParsePrefix("192.0.2.5/24")
+
+	for _, v := range []Prefix{p, {}} {
+		testTextEncodings(t, v, func() interface{} { return &Prefix{} })
+	}
+}
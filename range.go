@@ -0,0 +1,473 @@
+package std
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidRange is returned when a Postgres range literal is malformed.
+var ErrInvalidRange = errors.New("std: invalid range literal")
+
+// ErrRangeNotContiguous is returned by Union when the ranges neither overlap nor are adjacent.
+var ErrRangeNotContiguous = errors.New("std: result of range union would not be contiguous")
+
+// span is a range, as handled by the logic shared by the range types.
+type span struct {
+	// lower and upper are nil when the bound is infinite.
+	lower, upper       interface{}
+	lowerInc, upperInc bool
+	empty              bool
+}
+
+// rangeKind describes the values of a range type.
+type rangeKind struct {
+	// name is the name of the range type.
+	name string
+	// compare returns -1, 0 or +1 when a is less than, equal to or greater than b.
+	compare func(a, b interface{}) int
+	// next returns the value following v, or nil if v is the greatest value.
+	// It is nil for continuous types.
+	next func(v interface{}) interface{}
+	// parse parses a bound, nil for an infinite bound.
+	parse func(s string) (interface{}, error)
+	// format appends the text of a bound.
+	format func(b []byte, v interface{}) []byte
+}
+
+// canonical returns s with discrete bounds in the [) form, and empty if it contains no value.
+func (k *rangeKind) canonical(s span) span {
+	if s.empty {
+		return span{empty: true}
+	}
+
+	// Infinite bounds are exclusive
+	if s.lower == nil {
+		s.lowerInc = false
+	}
+
+	if s.upper == nil {
+		s.upperInc = false
+	}
+
+	if k.next != nil {
+		if s.lower != nil && !s.lowerInc {
+			// Nothing follows the greatest value
+			if s.lower = k.next(s.lower); s.lower == nil {
+				return span{empty: true}
+			}
+
+			s.lowerInc = true
+		}
+
+		if s.upper != nil && s.upperInc {
+			// The range is unbounded after the greatest value
+			s.upper, s.upperInc = k.next(s.upper), false
+		}
+	}
+
+	if s.lower != nil && s.upper != nil {
+		c := k.compare(s.lower, s.upper)
+		if c > 0 || (c == 0 && !(s.lowerInc && s.upperInc)) {
+			return span{empty: true}
+		}
+	}
+
+	return s
+}
+
+// compareLower compares the lower bounds of a and b.
+func (k *rangeKind) compareLower(a, b span) int {
+	switch {
+	case a.lower == nil && b.lower == nil:
+		return 0
+	case a.lower == nil:
+		return -1
+	case b.lower == nil:
+		return 1
+	}
+
+	if c := k.compare(a.lower, b.lower); c != 0 {
+		return c
+	}
+
+	switch {
+	case a.lowerInc == b.lowerInc:
+		return 0
+	case a.lowerInc:
+		return -1
+	}
+
+	return 1
+}
+
+// compareUpper compares the upper bounds of a and b.
+func (k *rangeKind) compareUpper(a, b span) int {
+	switch {
+	case a.upper == nil && b.upper == nil:
+		return 0
+	case a.upper == nil:
+		return 1
+	case b.upper == nil:
+		return -1
+	}
+
+	if c := k.compare(a.upper, b.upper); c != 0 {
+		return c
+	}
+
+	switch {
+	case a.upperInc == b.upperInc:
+		return 0
+	case a.upperInc:
+		return 1
+	}
+
+	return -1
+}
+
+// compareRanges orders a and b like Postgres does: the empty range first,
+// then by lower bound and by upper bound.
+func (k *rangeKind) compareRanges(a, b span) int {
+	a, b = k.canonical(a), k.canonical(b)
+
+	switch {
+	case a.empty && b.empty:
+		return 0
+	case a.empty:
+		return -1
+	case b.empty:
+		return 1
+	}
+
+	if c := k.compareLower(a, b); c != 0 {
+		return c
+	}
+
+	return k.compareUpper(a, b)
+}
+
+// endsBefore reports whether a ends before b starts, without a common value.
+func (k *rangeKind) endsBefore(a, b span) bool {
+	a, b = k.canonical(a), k.canonical(b)
+
+	if a.upper == nil || b.lower == nil {
+		return false
+	}
+
+	c := k.compare(a.upper, b.lower)
+	if c != 0 {
+		return c < 0
+	}
+
+	return !(a.upperInc && b.lowerInc)
+}
+
+func (k *rangeKind) contains(s span, v interface{}) bool {
+	s = k.canonical(s)
+
+	if s.empty {
+		return false
+	}
+
+	if s.lower != nil {
+		if c := k.compare(s.lower, v); c > 0 || (c == 0 && !s.lowerInc) {
+			return false
+		}
+	}
+
+	if s.upper != nil {
+		if c := k.compare(v, s.upper); c > 0 || (c == 0 && !s.upperInc) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (k *rangeKind) overlaps(a, b span) bool {
+	a, b = k.canonical(a), k.canonical(b)
+
+	return !a.empty && !b.empty && !k.endsBefore(a, b) && !k.endsBefore(b, a)
+}
+
+func (k *rangeKind) intersect(a, b span) span {
+	a, b = k.canonical(a), k.canonical(b)
+
+	if !k.overlaps(a, b) {
+		return span{empty: true}
+	}
+
+	s := a
+
+	if k.compareLower(b, a) > 0 {
+		s.lower, s.lowerInc = b.lower, b.lowerInc
+	}
+
+	if k.compareUpper(b, a) < 0 {
+		s.upper, s.upperInc = b.upper, b.upperInc
+	}
+
+	return k.canonical(s)
+}
+
+func (k *rangeKind) union(a, b span) (span, error) {
+	a, b = k.canonical(a), k.canonical(b)
+
+	switch {
+	case a.empty:
+		return b, nil
+	case b.empty:
+		return a, nil
+	}
+
+	// The ranges must overlap or be adjacent
+	if k.endsBefore(a, b) && !k.adjacent(a, b) || k.endsBefore(b, a) && !k.adjacent(b, a) {
+		return span{}, ErrRangeNotContiguous
+	}
+
+	s := a
+
+	if k.compareLower(b, a) < 0 {
+		s.lower, s.lowerInc = b.lower, b.lowerInc
+	}
+
+	if k.compareUpper(b, a) > 0 {
+		s.upper, s.upperInc = b.upper, b.upperInc
+	}
+
+	return k.canonical(s), nil
+}
+
+// adjacent reports whether a ends where b starts, with exactly one of the bounds inclusive.
+func (k *rangeKind) adjacent(a, b span) bool {
+	a, b = k.canonical(a), k.canonical(b)
+
+	return a.upper != nil && b.lower != nil && k.compare(a.upper, b.lower) == 0 && a.upperInc != b.lowerInc
+}
+
+// parseRange parses a Postgres range literal like [1,5), (,2020-01-01] or empty.
+func (k *rangeKind) parseRange(src []byte) (span, error) {
+	s := strings.TrimSpace(string(src))
+
+	if strings.EqualFold(s, "empty") {
+		return span{empty: true}, nil
+	}
+
+	if len(s) < 3 || (s[0] != '[' && s[0] != '(') || (s[len(s)-1] != ']' && s[len(s)-1] != ')') {
+		return span{}, fmt.Errorf("%w: %q", ErrInvalidRange, src)
+	}
+
+	lower, rest, err := parseRangeBound(s[1 : len(s)-1])
+	if err != nil || !strings.HasPrefix(rest, ",") {
+		return span{}, fmt.Errorf("%w: %q", ErrInvalidRange, src)
+	}
+
+	upper, rest, err := parseRangeBound(rest[1:])
+	if err != nil || rest != "" {
+		return span{}, fmt.Errorf("%w: %q", ErrInvalidRange, src)
+	}
+
+	r := span{lowerInc: s[0] == '[', upperInc: s[len(s)-1] == ']'}
+
+	// The spaces around the bounds are not significant for numbers, dates and times
+	if lower != nil {
+		if r.lower, err = k.parse(strings.TrimSpace(*lower)); err != nil {
+			return span{}, fmt.Errorf("%w: %q: %v", ErrInvalidRange, src, err)
+		}
+	}
+
+	if upper != nil {
+		if r.upper, err = k.parse(strings.TrimSpace(*upper)); err != nil {
+			return span{}, fmt.Errorf("%w: %q: %v", ErrInvalidRange, src, err)
+		}
+	}
+
+	return k.canonical(r), nil
+}
+
+// parseRangeBound parses a quoted or unquoted bound, nil if it is missing,
+// and returns the rest of s.
+func parseRangeBound(s string) (*string, string, error) {
+	var (
+		buf    strings.Builder
+		quoted bool
+		i      int
+	)
+
+	for ; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case c == '"' && i+1 < len(s) && s[i+1] == '"' && quoted:
+			// A doubled quote is a literal quote
+			buf.WriteByte('"')
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == '\\':
+			i++
+			if i == len(s) {
+				return nil, "", errors.New("unterminated escape")
+			}
+
+			buf.WriteByte(s[i])
+		case c == ',' && !quoted:
+			return rangeBound(&buf, i), s[i:], nil
+		default:
+			buf.WriteByte(c)
+		}
+	}
+
+	if quoted {
+		return nil, "", errors.New("unterminated quoted bound")
+	}
+
+	return rangeBound(&buf, i), "", nil
+}
+
+func rangeBound(buf *strings.Builder, n int) *string {
+	if n == 0 {
+		return nil
+	}
+
+	s := buf.String()
+
+	return &s
+}
+
+// appendRange appends the Postgres range literal of s to b.
+func (k *rangeKind) appendRange(b []byte, s span) []byte {
+	if s.empty {
+		return append(b, "empty"...)
+	}
+
+	if s.lowerInc {
+		b = append(b, '[')
+	} else {
+		b = append(b, '(')
+	}
+
+	if s.lower != nil {
+		b = appendRangeBound(b, k.format(nil, s.lower))
+	}
+
+	b = append(b, ',')
+
+	if s.upper != nil {
+		b = appendRangeBound(b, k.format(nil, s.upper))
+	}
+
+	if s.upperInc {
+		return append(b, ']')
+	}
+
+	return append(b, ')')
+}
+
+// appendRangeBound appends the bound v to b, quoted if needed.
+func appendRangeBound(b []byte, v []byte) []byte {
+	if len(v) > 0 && bytes.IndexAny(v, "\"\\,()[] \t\n\r") < 0 {
+		return append(b, v...)
+	}
+
+	b = append(b, '"')
+
+	for _, c := range v {
+		if c == '"' || c == '\\' {
+			b = append(b, '\\')
+		}
+
+		b = append(b, c)
+	}
+
+	return append(b, '"')
+}
+
+// scanRange parses the range literal value, it returns false if value is nil.
+func (k *rangeKind) scanRange(value interface{}) (span, bool, error) {
+	switch x := value.(type) {
+	case nil:
+		return span{}, false, nil
+	case []byte:
+		s, err := k.parseRange(x)
+
+		return s, err == nil, err
+	case string:
+		s, err := k.parseRange([]byte(x))
+
+		return s, err == nil, err
+	}
+
+	return span{}, false, fmt.Errorf("std: cannot scan type %T into std.%s: %v", value, k.name, value)
+}
+
+// rangeJSON is the JSON object of a range, with the bounds left to the bound type.
+// Missing and null bounds are infinite.
+type rangeJSON struct {
+	Lower    json.RawMessage `json:"lower"`
+	Upper    json.RawMessage `json:"upper"`
+	LowerInc bool            `json:"lower_inc"`
+	UpperInc bool            `json:"upper_inc"`
+	Empty    bool            `json:"empty"`
+}
+
+// unmarshalRangeJSON decodes the JSON object data, it returns false if data is null.
+func (k *rangeKind) unmarshalRangeJSON(data []byte) (rangeJSON, bool, error) {
+	var r rangeJSON
+
+	kind, err := jsonKindOf(data)
+	if err != nil {
+		return r, false, fmt.Errorf("json: cannot unmarshal %s into Go value of type std.%s: %w", string(data), k.name, err)
+	}
+
+	switch kind {
+	case jsonNull:
+		return r, false, nil
+	case jsonObject:
+		if err := json.Unmarshal(data, &r); err != nil {
+			return r, false, err // nolint: wrapcheck
+		}
+
+		return r, true, nil
+	}
+
+	return r, false, fmt.Errorf("json: cannot unmarshal %s into Go value of type std.%s", string(data), k.name)
+}
+
+// appendRangeJSON appends the JSON object of a range to b, with the JSON encoding of its bounds.
+func appendRangeJSON(b []byte, empty bool, lower, upper []byte, lowerInc, upperInc bool) []byte {
+	if empty {
+		return append(b, `{"empty":true}`...)
+	}
+
+	b = append(b, `{"lower":`...)
+	b = append(b, lower...)
+	b = append(b, `,"upper":`...)
+	b = append(b, upper...)
+	b = append(b, `,"lower_inc":`...)
+	b = appendJSONBool(b, lowerInc)
+	b = append(b, `,"upper_inc":`...)
+	b = appendJSONBool(b, upperInc)
+
+	return append(b, '}')
+}
+
+func appendJSONBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, "true"...)
+	}
+
+	return append(b, "false"...)
+}
+
+// unmarshalRangeBound decodes the JSON bound data into dest, a missing bound is infinite.
+func unmarshalRangeBound(data json.RawMessage, dest json.Unmarshaler) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	return dest.UnmarshalJSON(data)
+}
@@ -0,0 +1,67 @@
+package std
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRangeBound(t *testing.T) {
+	testCases := []struct {
+		src   string
+		bound interface{}
+		rest  string
+	}{
+		{``, nil, ``},
+		{`,5`, nil, `,5`},
+		{`1,5`, "1", `,5`},
+		{`"a,b",c`, "a,b", `,c`},
+		{`"a""b"`, `a"b`, ``},
+		{`"a\"b\\c"`, `a"b\c`, ``},
+		{`""`, "", ``},
+		{`a\,b`, "a,b", ``},
+	}
+
+	for _, tc := range testCases {
+		bound, rest, err := parseRangeBound(tc.src)
+		assert.NoError(t, err, tc.src)
+		assert.Equal(t, tc.rest, rest, tc.src)
+
+		if tc.bound == nil {
+			assert.Nil(t, bound, tc.src)
+		} else if assert.NotNil(t, bound, tc.src) {
+			assert.Equal(t, tc.bound, *bound, tc.src)
+		}
+	}
+
+	_, _, err := parseRangeBound(`"a`)
+	assert.Error(t, err)
+
+	_, _, err = parseRangeBound(`a\`)
+	assert.Error(t, err)
+}
+
+func TestParseRangeErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`[]`,
+		`[1]`,
+		`1,5`,
+		`[1,5`,
+		`{1,5}`,
+		`[1,5,6)`,
+		`[a,5)`,
+		`["1,5)`,
+	} {
+		_, err := ParseIntRange(src)
+		assert.True(t, errors.Is(err, ErrInvalidRange), "%s: %v", src, err)
+	}
+}
+
+func TestAppendRangeBound(t *testing.T) {
+	assert.Equal(t, `1`, string(appendRangeBound(nil, []byte("1"))))
+	assert.Equal(t, `""`, string(appendRangeBound(nil, nil)))
+	assert.Equal(t, `"a b"`, string(appendRangeBound(nil, []byte("a b"))))
+	assert.Equal(t, `"a,\"b\\"`, string(appendRangeBound(nil, []byte(`a,"b\`))))
+}
@@ -0,0 +1,264 @@
+package std
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// FloatToInt is the rule applied when a float is scanned into an Int or an Uint.
+type FloatToInt int
+
+const (
+	// FloatToIntStrict rejects floats that are not whole numbers, as database/sql does.
+	FloatToIntStrict FloatToInt = iota
+	// FloatToIntTruncate drops the fractional part of floats.
+	FloatToIntTruncate
+)
+
+// ScanInt returns a sql.Scanner storing into i, converting floats according to mode.
+// Decimal strings, as returned by most drivers for numeric columns, are converted as floats.
+func ScanInt(i *Int, mode FloatToInt) sql.Scanner {
+	return intScanner{dest: i, mode: mode}
+}
+
+// ScanUint returns a sql.Scanner storing into i, converting floats according to mode.
+// Decimal strings, as returned by most drivers for numeric columns, are converted as floats.
+func ScanUint(i *Uint, mode FloatToInt) sql.Scanner {
+	return uintScanner{dest: i, mode: mode}
+}
+
+type intScanner struct {
+	dest *Int
+	mode FloatToInt
+}
+
+func (s intScanner) Scan(value interface{}) error {
+	f, ok := scanFloatSource(value)
+	if !ok || s.mode != FloatToIntTruncate {
+		return s.dest.Scan(value)
+	}
+
+	i, err := floatToInt(math.Trunc(f))
+	if err == nil {
+		s.dest.Data = i
+	}
+
+	s.dest.Valid = err == nil
+
+	return err
+}
+
+type uintScanner struct {
+	dest *Uint
+	mode FloatToInt
+}
+
+func (s uintScanner) Scan(value interface{}) error {
+	f, ok := scanFloatSource(value)
+	if !ok || s.mode != FloatToIntTruncate {
+		return s.dest.Scan(value)
+	}
+
+	i, err := floatToUint(math.Trunc(f))
+	if err == nil {
+		s.dest.Data = i
+	}
+
+	s.dest.Valid = err == nil
+
+	return err
+}
+
+// scanFloatSource returns the value of a float64 source, or of a string source holding a number
+// which is not an integer literal.
+func scanFloatSource(src interface{}) (float64, bool) {
+	var s string
+
+	switch v := src.(type) {
+	case float64:
+		return v, true
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return 0, false
+	}
+
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+		return 0, false
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+
+	return f, err == nil
+}
+
+// The scan functions are the specialised versions of convertAssign for each destination type.
+// They handle every driver.Value source type without reflection,
+// and fall back to convertAssign for any other source.
+
+// scanInt64 copies to dest the value in src, converting it if possible.
+func scanInt64(dest *int64, src interface{}) error {
+	switch v := src.(type) {
+	case int64:
+		*dest = v
+
+		return nil
+	case float64, bool, string, []byte, time.Time:
+		s := driverString(src)
+
+		i64, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a int64: %w", src, s, strconvErr(err))
+		}
+
+		*dest = i64
+
+		return nil
+	}
+
+	return convertAssign(dest, src)
+}
+
+// scanUint64 copies to dest the value in src, converting it if possible.
+func scanUint64(dest *uint64, src interface{}) error {
+	switch v := src.(type) {
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a uint64: %w", src, strconv.FormatInt(v, 10), strconv.ErrSyntax)
+		}
+
+		*dest = uint64(v)
+
+		return nil
+	case float64, bool, string, []byte, time.Time:
+		s := driverString(src)
+
+		u64, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a uint64: %w", src, s, strconvErr(err))
+		}
+
+		*dest = u64
+
+		return nil
+	}
+
+	return convertAssign(dest, src)
+}
+
+// scanFloat64 copies to dest the value in src, converting it if possible.
+func scanFloat64(dest *float64, src interface{}) error {
+	switch v := src.(type) {
+	case float64:
+		*dest = v
+
+		return nil
+	case int64:
+		*dest = float64(v)
+
+		return nil
+	case bool, string, []byte, time.Time:
+		s := driverString(src)
+
+		f64, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a float64: %w", src, s, strconvErr(err))
+		}
+
+		*dest = f64
+
+		return nil
+	}
+
+	return convertAssign(dest, src)
+}
+
+// scanBool copies to dest the value in src, converting it if possible.
+func scanBool(dest *bool, src interface{}) error {
+	switch v := src.(type) {
+	case bool:
+		*dest = v
+
+		return nil
+	case int64:
+		if v != 0 && v != 1 {
+			return fmt.Errorf("sql/driver: couldn't convert %d into type bool", v)
+		}
+
+		*dest = v == 1
+
+		return nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("sql/driver: couldn't convert %q into type bool", v)
+		}
+
+		*dest = b
+
+		return nil
+	case []byte:
+		b, err := strconv.ParseBool(string(v))
+		if err != nil {
+			return fmt.Errorf("sql/driver: couldn't convert %q into type bool", v)
+		}
+
+		*dest = b
+
+		return nil
+	case float64, time.Time:
+		return fmt.Errorf("sql/driver: couldn't convert %v (%T) into type bool", src, src)
+	}
+
+	return convertAssign(dest, src)
+}
+
+// scanString copies to dest the value in src, converting it if possible.
+func scanString(dest *string, src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		*dest = v
+
+		return nil
+	case []byte:
+		*dest = string(v)
+
+		return nil
+	case int64, float64, bool:
+		*dest = driverString(src)
+
+		return nil
+	case time.Time:
+		*dest = v.Format(time.RFC3339Nano)
+
+		return nil
+	}
+
+	return convertAssign(dest, src)
+}
+
+// driverString returns the string representation of a driver.Value, as asString does.
+func driverString(src interface{}) string {
+	switch v := src.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.String()
+	}
+
+	return asString(src)
+}
@@ -0,0 +1,141 @@
+package std
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var scanSources = []interface{}{
+	int64(0), int64(1), int64(-12345), int64(9223372036854775807),
+	int64(-9223372036854775808), int64(9007199254740993),
+	float64(0), float64(1), float64(1.5), float64(-3), float64(1e20), float64(1e21), float64(-0.0),
+	true, false,
+	"12345", "-12345", "1.5", "true", "1", "test", "",
+	[]byte("12345"), []byte("-1"), []byte("1.5"), []byte("false"), []byte("test"), []byte(nil),
+	timeValue, time.Date(2012, 12, 21, 21, 21, 21, 123456789, time.FixedZone("CET", 3600)),
+	"18446744073709551615", "1e3",
+	12345, uint8(1), float32(1.5),
+}
+
+func assertSameScan(t *testing.T, src interface{}, newErr, oldErr error, newValue, oldValue interface{}) {
+	t.Helper()
+
+	if oldErr == nil {
+		assert.NoError(t, newErr, "%T(%v)", src, src)
+	} else if assert.Error(t, newErr, "%T(%v)", src, src) {
+		assert.Equal(t, oldErr.Error(), newErr.Error())
+	}
+
+	assert.Equal(t, oldValue, newValue, "%T(%v)", src, src)
+}
+
+func TestScanUnchanged(t *testing.T) {
+	for _, src := range scanSources {
+		var newInt, oldInt int64
+		assertSameScan(t, src, scanInt64(&newInt, src), convertAssign(&oldInt, src), newInt, oldInt)
+
+		var newUint, oldUint uint64
+		assertSameScan(t, src, scanUint64(&newUint, src), convertAssign(&oldUint, src), newUint, oldUint)
+
+		var newFloat, oldFloat float64
+		assertSameScan(t, src, scanFloat64(&newFloat, src), convertAssign(&oldFloat, src), newFloat, oldFloat)
+
+		var newBool, oldBool bool
+		assertSameScan(t, src, scanBool(&newBool, src), convertAssign(&oldBool, src), newBool, oldBool)
+
+		var newString, oldString string
+		assertSameScan(t, src, scanString(&newString, src), convertAssign(&oldString, src), newString, oldString)
+	}
+}
+
+func TestScanInt(t *testing.T) {
+	var i Int
+
+	assert.NoError(t, ScanInt(&i, FloatToIntTruncate).Scan(1.9))
+	assert.Equal(t, IntFrom(1), i)
+
+	assert.NoError(t, ScanInt(&i, FloatToIntTruncate).Scan(-1.9))
+	assert.Equal(t, IntFrom(-1), i)
+
+	assert.NoError(t, ScanInt(&i, FloatToIntTruncate).Scan([]byte("12.50")))
+	assert.Equal(t, IntFrom(12), i)
+
+	assert.NoError(t, ScanInt(&i, FloatToIntTruncate).Scan("12345"))
+	assert.Equal(t, IntFrom(12345), i)
+
+	assert.NoError(t, ScanInt(&i, FloatToIntTruncate).Scan(nil))
+	assert.False(t, i.Valid)
+
+	assert.ErrorIs(t, ScanInt(&i, FloatToIntTruncate).Scan(1e20), ErrLossyConversion)
+	assert.ErrorIs(t, ScanInt(&i, FloatToIntTruncate).Scan(math.NaN()), ErrLossyConversion)
+	assert.Error(t, ScanInt(&i, FloatToIntTruncate).Scan("test"))
+
+	assert.EqualError(t, ScanInt(&i, FloatToIntStrict).Scan(1.9), `converting driver.Value type float64 ("1.9") to a int64: invalid syntax`)
+	assert.NoError(t, ScanInt(&i, FloatToIntStrict).Scan(2.0))
+	assert.Equal(t, IntFrom(2), i)
+}
+
+func TestScanUint(t *testing.T) {
+	var i Uint
+
+	assert.NoError(t, ScanUint(&i, FloatToIntTruncate).Scan(1.9))
+	assert.Equal(t, UintFrom(1), i)
+
+	assert.NoError(t, ScanUint(&i, FloatToIntTruncate).Scan(-0.5))
+	assert.Equal(t, UintFrom(0), i)
+
+	assert.NoError(t, ScanUint(&i, FloatToIntTruncate).Scan("18446744073709551615"))
+	assert.Equal(t, UintFrom(math.MaxUint64), i)
+
+	assert.ErrorIs(t, ScanUint(&i, FloatToIntTruncate).Scan(-1.5), ErrLossyConversion)
+	assert.Error(t, ScanUint(&i, FloatToIntTruncate).Scan("-1"))
+
+	assert.EqualError(t, ScanUint(&i, FloatToIntStrict).Scan(1.9), `converting driver.Value type float64 ("1.9") to a uint64: invalid syntax`)
+}
+
+func benchmarkScan(b *testing.B, scan func(src interface{}) error, src interface{}) {
+	b.Helper()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = scan(src)
+	}
+}
+
+func BenchmarkScan(b *testing.B) {
+	var (
+		i int64
+		u uint64
+		f float64
+		v bool
+		s string
+	)
+
+	benchmarks := []struct {
+		name string
+		src  interface{}
+		scan func(src interface{}) error
+		old  func(src interface{}) error
+	}{
+		{"Int/int64", int64(12345), func(src interface{}) error { return scanInt64(&i, src) }, func(src interface{}) error { return convertAssign(&i, src) }},
+		{"Int/bytes", []byte("12345"), func(src interface{}) error { return scanInt64(&i, src) }, func(src interface{}) error { return convertAssign(&i, src) }},
+		{"Uint/int64", int64(12345), func(src interface{}) error { return scanUint64(&u, src) }, func(src interface{}) error { return convertAssign(&u, src) }},
+		{"Float/float64", float64(1.5), func(src interface{}) error { return scanFloat64(&f, src) }, func(src interface{}) error { return convertAssign(&f, src) }},
+		{"Float/int64", int64(12345), func(src interface{}) error { return scanFloat64(&f, src) }, func(src interface{}) error { return convertAssign(&f, src) }},
+		{"Bool/bool", true, func(src interface{}) error { return scanBool(&v, src) }, func(src interface{}) error { return convertAssign(&v, src) }},
+		{"Bool/int64", int64(1), func(src interface{}) error { return scanBool(&v, src) }, func(src interface{}) error { return convertAssign(&v, src) }},
+		{"Bool/bytes", []byte("true"), func(src interface{}) error { return scanBool(&v, src) }, func(src interface{}) error { return convertAssign(&v, src) }},
+		{"String/bytes", []byte("test"), func(src interface{}) error { return scanString(&s, src) }, func(src interface{}) error { return convertAssign(&s, src) }},
+		{"String/time", time.Now(), func(src interface{}) error { return scanString(&s, src) }, func(src interface{}) error { return convertAssign(&s, src) }},
+	}
+
+	for _, bb := range benchmarks {
+		bb := bb
+
+		b.Run(bb.name+"/scan", func(b *testing.B) { benchmarkScan(b, bb.scan, bb.src) })
+		b.Run(bb.name+"/convertAssign", func(b *testing.B) { benchmarkScan(b, bb.old, bb.src) })
+	}
+}
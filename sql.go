@@ -0,0 +1,123 @@
+package std
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// unmarshalNullObject decodes the JSON object form of the sql.NullXXX types,
+// e.g. {"String":"foo","Valid":true}, or of sql.Null[T], e.g. {"V":"foo","Valid":true}.
+// The value found under key (or "V") is decoded into dest only if the object is valid,
+// otherwise dest is reset to its zero value.
+func unmarshalNullObject(data []byte, key string, typ string, dest interface{}) (bool, error) {
+	valid, err := decodeNullObject(data, key, typ, dest)
+	if !valid || err != nil {
+		v := reflect.ValueOf(dest).Elem()
+		v.Set(reflect.Zero(v.Type()))
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func decodeNullObject(data []byte, key string, typ string, dest interface{}) (bool, error) {
+	var obj map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return false, fmt.Errorf("json: cannot unmarshal %s into Go value of type %s: %w", string(data), typ, err)
+	}
+
+	raw, ok := obj[key]
+	if !ok {
+		raw, ok = obj["V"]
+	}
+
+	var valid bool
+
+	if err := json.Unmarshal(obj["Valid"], &valid); !ok || err != nil {
+		return false, fmt.Errorf(`json: unmarshalling object into Go value of type %s requires key "%s" and key "Valid" to be of type bool`, typ, key)
+	}
+
+	if !valid {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(raw, dest); err != nil {
+		return false, fmt.Errorf("json: cannot unmarshal %s into Go value of type %s: %w", string(raw), typ, err)
+	}
+
+	return true, nil
+}
+
+// StringFromSQL creates a new String from a sql.NullString.
+func StringFromSQL(s sql.NullString) String {
+	return NewString(s.String, s.Valid)
+}
+
+// ToSQL converts this String to a sql.NullString.
+func (s String) ToSQL() sql.NullString {
+	return sql.NullString{String: s.Data, Valid: s.Valid}
+}
+
+// IntFromSQL creates a new Int from a sql.NullInt64.
+func IntFromSQL(i sql.NullInt64) Int {
+	return NewInt(i.Int64, i.Valid)
+}
+
+// ToSQL converts this Int to a sql.NullInt64.
+func (i Int) ToSQL() sql.NullInt64 {
+	return sql.NullInt64{Int64: i.Data, Valid: i.Valid}
+}
+
+// FloatFromSQL creates a new Float from a sql.NullFloat64.
+func FloatFromSQL(f sql.NullFloat64) Float {
+	return NewFloat(f.Float64, f.Valid)
+}
+
+// ToSQL converts this Float to a sql.NullFloat64.
+func (f Float) ToSQL() sql.NullFloat64 {
+	return sql.NullFloat64{Float64: f.Data, Valid: f.Valid}
+}
+
+// BoolFromSQL creates a new Bool from a sql.NullBool.
+func BoolFromSQL(b sql.NullBool) Bool {
+	return NewBool(b.Bool, b.Valid)
+}
+
+// ToSQL converts this Bool to a sql.NullBool.
+func (b Bool) ToSQL() sql.NullBool {
+	return sql.NullBool{Bool: b.Data, Valid: b.Valid}
+}
+
+// TimeFromSQL creates a new Time from a sql.NullTime.
+func TimeFromSQL(t sql.NullTime) Time {
+	return NewTime(t.Time, t.Valid)
+}
+
+// ToSQL converts this Time to a sql.NullTime.
+func (t Time) ToSQL() sql.NullTime {
+	return sql.NullTime{Time: t.Time, Valid: t.Valid}
+}
+
+// DateTimeFromSQL creates a new DateTime from a sql.NullTime.
+func DateTimeFromSQL(t sql.NullTime) DateTime {
+	return NewDateTime(t.Time, t.Valid)
+}
+
+// ToSQL converts this DateTime to a sql.NullTime.
+func (t DateTime) ToSQL() sql.NullTime {
+	return sql.NullTime{Time: t.Data, Valid: t.Valid}
+}
+
+// DateFromSQL creates a new Date from a sql.NullTime.
+func DateFromSQL(t sql.NullTime) Date {
+	return NewDate(t.Time, t.Valid)
+}
+
+// ToSQL converts this Date to a sql.NullTime.
+func (t Date) ToSQL() sql.NullTime {
+	return sql.NullTime{Time: t.Data, Valid: t.Valid}
+}
@@ -0,0 +1,88 @@
+//go:build go1.22
+
+package std
+
+import (
+	"database/sql"
+	"time"
+)
+
+// StringFromNull creates a new String from a sql.Null[string].
+func StringFromNull(s sql.Null[string]) String {
+	return NewString(s.V, s.Valid)
+}
+
+// ToNull converts this String to a sql.Null[string].
+func (s String) ToNull() sql.Null[string] {
+	return sql.Null[string]{V: s.Data, Valid: s.Valid}
+}
+
+// IntFromNull creates a new Int from a sql.Null[int64].
+func IntFromNull(i sql.Null[int64]) Int {
+	return NewInt(i.V, i.Valid)
+}
+
+// ToNull converts this Int to a sql.Null[int64].
+func (i Int) ToNull() sql.Null[int64] {
+	return sql.Null[int64]{V: i.Data, Valid: i.Valid}
+}
+
+// UintFromNull creates a new Uint from a sql.Null[uint64].
+func UintFromNull(i sql.Null[uint64]) Uint {
+	return NewUint(i.V, i.Valid)
+}
+
+// ToNull converts this Uint to a sql.Null[uint64].
+func (i Uint) ToNull() sql.Null[uint64] {
+	return sql.Null[uint64]{V: i.Data, Valid: i.Valid}
+}
+
+// FloatFromNull creates a new Float from a sql.Null[float64].
+func FloatFromNull(f sql.Null[float64]) Float {
+	return NewFloat(f.V, f.Valid)
+}
+
+// ToNull converts this Float to a sql.Null[float64].
+func (f Float) ToNull() sql.Null[float64] {
+	return sql.Null[float64]{V: f.Data, Valid: f.Valid}
+}
+
+// BoolFromNull creates a new Bool from a sql.Null[bool].
+func BoolFromNull(b sql.Null[bool]) Bool {
+	return NewBool(b.V, b.Valid)
+}
+
+// ToNull converts this Bool to a sql.Null[bool].
+func (b Bool) ToNull() sql.Null[bool] {
+	return sql.Null[bool]{V: b.Data, Valid: b.Valid}
+}
+
+// TimeFromNull creates a new Time from a sql.Null[time.Time].
+func TimeFromNull(t sql.Null[time.Time]) Time {
+	return NewTime(t.V, t.Valid)
+}
+
+// ToNull converts this Time to a sql.Null[time.Time].
+func (t Time) ToNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{V: t.Time, Valid: t.Valid}
+}
+
+// DateTimeFromNull creates a new DateTime from a sql.Null[time.Time].
+func DateTimeFromNull(t sql.Null[time.Time]) DateTime {
+	return NewDateTime(t.V, t.Valid)
+}
+
+// ToNull converts this DateTime to a sql.Null[time.Time].
+func (t DateTime) ToNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{V: t.Data, Valid: t.Valid}
+}
+
+// DateFromNull creates a new Date from a sql.Null[time.Time].
+func DateFromNull(t sql.Null[time.Time]) Date {
+	return NewDate(t.V, t.Valid)
+}
+
+// ToNull converts this Date to a sql.Null[time.Time].
+func (t Date) ToNull() sql.Null[time.Time] {
+	return sql.Null[time.Time]{V: t.Data, Valid: t.Valid}
+}
@@ -0,0 +1,62 @@
+//go:build go1.22
+
+package std
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUintSQL(t *testing.T) {
+	assert.Equal(t, UintFrom(math.MaxUint64), UintFromNull(sql.Null[uint64]{V: math.MaxUint64, Valid: true}))
+	assert.False(t, UintFromNull(sql.Null[uint64]{}).Valid)
+	assert.Equal(t, sql.Null[uint64]{V: 12345, Valid: true}, UintFrom(12345).ToNull())
+	assert.Equal(t, sql.Null[uint64]{}, Uint{}.ToNull())
+}
+
+func TestUintScanSQLCompatibility(t *testing.T) {
+	for _, src := range append([]interface{}{nil}, scanSources...) {
+		var (
+			i  Uint
+			ni sql.Null[uint64]
+		)
+
+		assertSameSQLScan(t, src, i.Scan(src), ni.Scan(src), i.ToNull(), ni)
+	}
+}
+
+func TestSQLNull(t *testing.T) {
+	assert.Equal(t, StringFrom("test"), StringFromNull(StringFrom("test").ToNull()))
+	assert.Equal(t, IntFrom(12345), IntFromNull(IntFrom(12345).ToNull()))
+	assert.Equal(t, UintFrom(12345), UintFromNull(UintFrom(12345).ToNull()))
+	assert.Equal(t, FloatFrom(1.2345), FloatFromNull(FloatFrom(1.2345).ToNull()))
+	assert.Equal(t, BoolFrom(true), BoolFromNull(BoolFrom(true).ToNull()))
+	assert.Equal(t, TimeFrom(timeValue), TimeFromNull(TimeFrom(timeValue).ToNull()))
+	assert.Equal(t, DateTimeFrom(timeValue), DateTimeFromNull(DateTimeFrom(timeValue).ToNull()))
+	assert.Equal(t, DateFrom(dateValue), DateFromNull(DateFrom(dateValue).ToNull()))
+
+	assert.Equal(t, sql.Null[string]{}, String{}.ToNull())
+	assert.Equal(t, sql.Null[time.Time]{}, Date{}.ToNull())
+	assert.False(t, IntFromNull(sql.Null[int64]{V: 12345}).Valid)
+}
+
+func TestUnmarshalSQLNullGenericJSON(t *testing.T) {
+	data, err := json.Marshal(sql.Null[int64]{V: 12345, Valid: true})
+	assert.NoError(t, err)
+
+	var i Int
+	assert.NoError(t, json.Unmarshal(data, &i))
+	assert.Equal(t, IntFrom(12345), i)
+
+	data, err = json.Marshal(sql.Null[time.Time]{V: timeValue, Valid: true})
+	assert.NoError(t, err)
+
+	var ti Time
+	assert.NoError(t, json.Unmarshal(data, &ti))
+	assert.Equal(t, TimeFrom(timeValue), ti)
+}
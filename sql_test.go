@@ -0,0 +1,209 @@
+package std
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringSQL(t *testing.T) {
+	assert.Equal(t, StringFrom("test"), StringFromSQL(sql.NullString{String: "test", Valid: true}))
+	assert.False(t, StringFromSQL(sql.NullString{}).Valid)
+	assert.Equal(t, sql.NullString{String: "test", Valid: true}, StringFrom("test").ToSQL())
+	assert.Equal(t, sql.NullString{}, String{}.ToSQL())
+}
+
+func TestIntSQL(t *testing.T) {
+	assert.Equal(t, IntFrom(12345), IntFromSQL(sql.NullInt64{Int64: 12345, Valid: true}))
+	assert.False(t, IntFromSQL(sql.NullInt64{}).Valid)
+	assert.Equal(t, sql.NullInt64{Int64: 12345, Valid: true}, IntFrom(12345).ToSQL())
+	assert.Equal(t, sql.NullInt64{}, Int{}.ToSQL())
+}
+
+func TestFloatSQL(t *testing.T) {
+	assert.Equal(t, FloatFrom(1.2345), FloatFromSQL(sql.NullFloat64{Float64: 1.2345, Valid: true}))
+	assert.False(t, FloatFromSQL(sql.NullFloat64{}).Valid)
+	assert.Equal(t, sql.NullFloat64{Float64: 1.2345, Valid: true}, FloatFrom(1.2345).ToSQL())
+	assert.Equal(t, sql.NullFloat64{}, Float{}.ToSQL())
+}
+
+func TestBoolSQL(t *testing.T) {
+	assert.Equal(t, BoolFrom(true), BoolFromSQL(sql.NullBool{Bool: true, Valid: true}))
+	assert.False(t, BoolFromSQL(sql.NullBool{}).Valid)
+	assert.Equal(t, sql.NullBool{Bool: true, Valid: true}, BoolFrom(true).ToSQL())
+	assert.Equal(t, sql.NullBool{}, Bool{}.ToSQL())
+}
+
+func TestTimeSQL(t *testing.T) {
+	nt := sql.NullTime{Time: timeValue, Valid: true}
+
+	assert.Equal(t, TimeFrom(timeValue), TimeFromSQL(nt))
+	assert.False(t, TimeFromSQL(sql.NullTime{}).Valid)
+	assert.Equal(t, nt, TimeFrom(timeValue).ToSQL())
+	assert.Equal(t, sql.NullTime{}, Time{}.ToSQL())
+
+	assert.Equal(t, DateTimeFrom(timeValue), DateTimeFromSQL(nt))
+	assert.False(t, DateTimeFromSQL(sql.NullTime{}).Valid)
+	assert.Equal(t, nt, DateTimeFrom(timeValue).ToSQL())
+	assert.Equal(t, sql.NullTime{}, DateTime{}.ToSQL())
+
+	assert.Equal(t, NewDate(timeValue, true), DateFromSQL(nt))
+	assert.False(t, DateFromSQL(sql.NullTime{}).Valid)
+	assert.Equal(t, nt, NewDate(timeValue, true).ToSQL())
+	assert.Equal(t, sql.NullTime{}, Date{}.ToSQL())
+}
+
+func assertSameSQLScan(t *testing.T, src interface{}, stdErr, sqlErr error, stdValue, sqlValue interface{}) {
+	t.Helper()
+
+	if sqlErr == nil {
+		assert.NoError(t, stdErr, "%T(%v)", src, src)
+	} else if assert.Error(t, stdErr, "%T(%v)", src, src) {
+		assert.Equal(t, sqlErr.Error(), stdErr.Error())
+	}
+
+	assert.Equal(t, sqlValue, stdValue, "%T(%v)", src, src)
+}
+
+func TestScanSQLCompatibility(t *testing.T) {
+	for _, src := range append([]interface{}{nil}, scanSources...) {
+		var (
+			s   String
+			ns  sql.NullString
+			i   Int
+			ni  sql.NullInt64
+			f   Float
+			nf  sql.NullFloat64
+			b   Bool
+			nb  sql.NullBool
+			ti  Time
+			nti sql.NullTime
+		)
+
+		assertSameSQLScan(t, src, s.Scan(src), ns.Scan(src), s.ToSQL(), ns)
+		assertSameSQLScan(t, src, i.Scan(src), ni.Scan(src), i.ToSQL(), ni)
+		assertSameSQLScan(t, src, f.Scan(src), nf.Scan(src), f.ToSQL(), nf)
+		assertSameSQLScan(t, src, b.Scan(src), nb.Scan(src), b.ToSQL(), nb)
+
+		if _, ok := src.(time.Time); ok || src == nil {
+			assertSameSQLScan(t, src, ti.Scan(src), nti.Scan(src), ti.ToSQL(), nti)
+		}
+	}
+}
+
+func TestUnmarshalSQLNullJSON(t *testing.T) {
+	data, err := json.Marshal(sql.NullString{String: "test", Valid: true})
+	assert.NoError(t, err)
+
+	var s String
+	assert.NoError(t, json.Unmarshal(data, &s))
+	assert.Equal(t, StringFrom("test"), s)
+
+	data, err = json.Marshal(sql.NullInt64{Int64: 12345, Valid: true})
+	assert.NoError(t, err)
+
+	var i Int
+	assert.NoError(t, json.Unmarshal(data, &i))
+	assert.Equal(t, IntFrom(12345), i)
+
+	var u Uint
+	assert.NoError(t, json.Unmarshal(data, &u))
+	assert.Equal(t, UintFrom(12345), u)
+
+	var f Float
+	assert.NoError(t, json.Unmarshal(nullFloatJSON, &f))
+	assert.Equal(t, FloatFrom(1.2345), f)
+
+	data, err = json.Marshal(sql.NullBool{Bool: true, Valid: true})
+	assert.NoError(t, err)
+
+	var b Bool
+	assert.NoError(t, json.Unmarshal(data, &b))
+	assert.Equal(t, BoolFrom(true), b)
+
+	data, err = json.Marshal(sql.NullTime{Time: timeValue, Valid: true})
+	assert.NoError(t, err)
+
+	var dt DateTime
+	assert.NoError(t, json.Unmarshal(data, &dt))
+	assert.Equal(t, DateTimeFrom(timeValue), dt)
+
+	var d Date
+	assert.NoError(t, json.Unmarshal(data, &d))
+	assert.Equal(t, NewDate(timeValue, true), d)
+}
+
+func TestUnmarshalSQLNullJSONNull(t *testing.T) {
+	var s String
+	assert.NoError(t, json.Unmarshal([]byte(`{"String":"","Valid":false}`), &s))
+	assert.False(t, s.Valid)
+
+	var i Int
+	assert.NoError(t, json.Unmarshal([]byte(`{"Int64":0,"Valid":false}`), &i))
+	assert.False(t, i.Valid)
+
+	var b Bool
+	assert.NoError(t, json.Unmarshal([]byte(`{"Bool":false,"Valid":false}`), &b))
+	assert.False(t, b.Valid)
+
+	var d Date
+	assert.NoError(t, json.Unmarshal(nullObject, &d))
+	assert.False(t, d.Valid)
+}
+
+func TestUnmarshalSQLNullJSONResetsValue(t *testing.T) {
+	s := StringFrom("test")
+	assert.NoError(t, json.Unmarshal([]byte(`{"String":"other","Valid":false}`), &s))
+	assert.Equal(t, String{}, s)
+
+	i := IntFrom(12345)
+	assert.NoError(t, json.Unmarshal([]byte(`{"Int64":1,"Valid":false}`), &i))
+	assert.Equal(t, Int{}, i)
+
+	u := UintFrom(12345)
+	assert.Error(t, json.Unmarshal([]byte(`{"V":"test","Valid":true}`), &u))
+	assert.Equal(t, Uint{}, u)
+
+	f := FloatFrom(1.5)
+	assert.NoError(t, json.Unmarshal([]byte(`{"Float64":2.5,"Valid":false}`), &f))
+	assert.Equal(t, Float{}, f)
+
+	b := BoolFrom(true)
+	assert.NoError(t, json.Unmarshal([]byte(`{"Bool":true,"Valid":false}`), &b))
+	assert.Equal(t, Bool{}, b)
+
+	tm := TimeFrom(timeValue)
+	assert.NoError(t, json.Unmarshal(nullObject, &tm))
+	assert.Equal(t, Time{}, tm)
+}
+
+func TestUnmarshalSQLNullJSONGeneric(t *testing.T) {
+	var s String
+	assert.NoError(t, json.Unmarshal([]byte(`{"V":"test","Valid":true}`), &s))
+	assert.Equal(t, StringFrom("test"), s)
+
+	var u Uint
+	assert.NoError(t, json.Unmarshal([]byte(`{"V":18446744073709551615,"Valid":true}`), &u))
+	assert.Equal(t, UintFrom(18446744073709551615), u)
+}
+
+func TestUnmarshalSQLNullJSONInvalid(t *testing.T) {
+	var s String
+	assert.Error(t, json.Unmarshal(badObject, &s))
+	assert.False(t, s.Valid)
+
+	var i Int
+	assert.Error(t, json.Unmarshal([]byte(`{"Int64":"test","Valid":true}`), &i))
+	assert.False(t, i.Valid)
+
+	var f Float
+	assert.Error(t, json.Unmarshal([]byte(`{"Float64":1.5,"Valid":"yes"}`), &f))
+	assert.False(t, f.Valid)
+
+	var dt DateTime
+	assert.Error(t, dt.UnmarshalJSON([]byte(`{"Time":`)))
+	assert.False(t, dt.Valid)
+}
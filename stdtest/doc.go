@@ -0,0 +1,3 @@
+// Package stdtest provides helpers to test code using nullable values with database/sql,
+// without a real database.
+package stdtest
@@ -0,0 +1,244 @@
+package stdtest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ErrNoRows is returned by Query when no rows are configured for a query,
+// neither for the query itself nor as default.
+var ErrNoRows = errors.New("stdtest: no rows configured for query")
+
+// Rows is a result set returned by the Driver.
+type Rows struct {
+	Columns []string
+	Values  [][]driver.Value
+}
+
+// Call is a statement executed through the Driver.
+type Call struct {
+	Query string
+	// Args are the arguments of the statement, as converted by database/sql,
+	// i.e. the result of their driver.Valuer implementation.
+	Args []driver.Value
+}
+
+// Driver is an in-memory database/sql driver.
+// It returns configured rows to queries and records the arguments of every statement.
+// A Driver is safe for concurrent use.
+type Driver struct {
+	mu    sync.Mutex
+	rows  map[string]Rows
+	calls []Call
+}
+
+// NewDriver creates a new Driver without any rows.
+func NewDriver() *Driver {
+	return &Driver{
+		rows: make(map[string]Rows),
+	}
+}
+
+// Register creates a new Driver and registers it with database/sql under name,
+// so it can be opened with sql.Open(name, "").
+// It panics if a driver is already registered under name, as sql.Register does.
+func Register(name string) *Driver {
+	d := NewDriver()
+
+	sql.Register(name, d)
+
+	return d
+}
+
+// DB returns a new sql.DB using the driver.
+func (d *Driver) DB() *sql.DB {
+	return sql.OpenDB(connector{driver: d})
+}
+
+// SetRows sets the rows returned to query.
+// The rows set for the empty query are returned to queries without rows of their own.
+func (d *Driver) SetRows(query string, columns []string, values ...[]driver.Value) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rows[query] = Rows{
+		Columns: columns,
+		Values:  values,
+	}
+}
+
+// Calls returns the statements executed and queried so far.
+func (d *Driver) Calls() []Call {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	calls := make([]Call, len(d.calls))
+	copy(calls, d.calls)
+
+	return calls
+}
+
+// LastArgs returns the arguments of the last statement, or nil if none was executed.
+func (d *Driver) LastArgs() []driver.Value {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.calls) == 0 {
+		return nil
+	}
+
+	return d.calls[len(d.calls)-1].Args
+}
+
+// Reset forgets the configured rows and the recorded statements.
+func (d *Driver) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.rows = make(map[string]Rows)
+	d.calls = nil
+}
+
+// Open implements driver.Driver.
+func (d *Driver) Open(name string) (driver.Conn, error) {
+	return &conn{driver: d}, nil
+}
+
+func (d *Driver) record(query string, args []driver.Value) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.calls = append(d.calls, Call{
+		Query: query,
+		Args:  cloneValues(args),
+	})
+}
+
+func (d *Driver) lookup(query string) (Rows, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	rows, ok := d.rows[query]
+	if !ok {
+		rows, ok = d.rows[""]
+	}
+
+	return rows, ok
+}
+
+type connector struct {
+	driver *Driver
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open("")
+}
+
+func (c connector) Driver() driver.Driver {
+	return c.driver
+}
+
+type conn struct {
+	driver *Driver
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return &stmt{conn: c, query: query}, nil
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return tx{}, nil
+}
+
+type tx struct{}
+
+func (tx) Commit() error {
+	return nil
+}
+
+func (tx) Rollback() error {
+	return nil
+}
+
+type stmt struct {
+	conn  *conn
+	query string
+}
+
+func (s *stmt) Close() error {
+	return nil
+}
+
+func (s *stmt) NumInput() int {
+	return -1
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.record(s.query, args)
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.conn.driver.record(s.query, args)
+
+	r, ok := s.conn.driver.lookup(s.query)
+	if !ok {
+		return nil, ErrNoRows
+	}
+
+	return &rows{columns: r.Columns, values: r.Values}, nil
+}
+
+type rows struct {
+	columns []string
+	values  [][]driver.Value
+	pos     int
+}
+
+func (r *rows) Columns() []string {
+	return r.columns
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.values) {
+		return io.EOF
+	}
+
+	copy(dest, cloneValues(r.values[r.pos]))
+	r.pos++
+
+	return nil
+}
+
+// cloneValues copies values and the byte slices they hold,
+// so the driver and its users never share memory.
+func cloneValues(values []driver.Value) []driver.Value {
+	if values == nil {
+		return nil
+	}
+
+	c := make([]driver.Value, len(values))
+
+	for i, v := range values {
+		if b, ok := v.([]byte); ok && b != nil {
+			v = append([]byte(nil), b...)
+		}
+
+		c[i] = v
+	}
+
+	return c
+}
@@ -0,0 +1,146 @@
+package stdtest_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	std "github.com/euskadi31/go-std"
+	"github.com/euskadi31/go-std/stdtest"
+	"github.com/stretchr/testify/assert"
+)
+
+var timeValue = time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+
+func TestDriverQuery(t *testing.T) {
+	d := stdtest.NewDriver()
+	db := d.DB()
+
+	defer db.Close()
+
+	d.SetRows("SELECT", []string{"string", "int", "float", "bool", "time", "text_time"},
+		[]driver.Value{[]byte("test"), int64(12345), []byte("1.2345"), int64(1), timeValue, "2012-12-21T21:21:21Z"},
+		[]driver.Value{nil, nil, nil, nil, nil, nil},
+	)
+
+	rows, err := db.Query("SELECT", std.IntFrom(42))
+	assert.NoError(t, err)
+
+	defer rows.Close()
+
+	var (
+		s  std.String
+		i  std.Int
+		f  std.Float
+		b  std.Bool
+		ti std.Time
+		tt std.String
+	)
+
+	assert.True(t, rows.Next())
+	assert.NoError(t, rows.Scan(&s, &i, &f, &b, &ti, &tt))
+	assert.Equal(t, std.StringFrom("test"), s)
+	assert.Equal(t, std.IntFrom(12345), i)
+	assert.Equal(t, std.FloatFrom(1.2345), f)
+	assert.Equal(t, std.BoolFrom(true), b)
+	assert.Equal(t, std.TimeFrom(timeValue), ti)
+	assert.Equal(t, std.StringFrom("2012-12-21T21:21:21Z"), tt)
+
+	assert.True(t, rows.Next())
+	assert.NoError(t, rows.Scan(&s, &i, &f, &b, &ti, &tt))
+	assert.False(t, s.Valid)
+	assert.False(t, i.Valid)
+	assert.False(t, f.Valid)
+	assert.False(t, b.Valid)
+	assert.False(t, ti.Valid)
+	assert.False(t, tt.Valid)
+
+	assert.False(t, rows.Next())
+	assert.NoError(t, rows.Err())
+
+	assert.Equal(t, []stdtest.Call{{Query: "SELECT", Args: []driver.Value{int64(42)}}}, d.Calls())
+}
+
+func TestDriverDefaultRows(t *testing.T) {
+	d := stdtest.NewDriver()
+	db := d.DB()
+
+	defer db.Close()
+
+	var i std.Int
+
+	assert.ErrorIs(t, db.QueryRow("SELECT").Scan(&i), stdtest.ErrNoRows)
+
+	d.SetRows("", []string{"int"}, []driver.Value{int64(12345)})
+
+	assert.NoError(t, db.QueryRow("SELECT").Scan(&i))
+	assert.Equal(t, std.IntFrom(12345), i)
+
+	d.SetRows("SELECT", []string{"int"})
+
+	assert.Equal(t, sql.ErrNoRows, db.QueryRow("SELECT").Scan(&i))
+
+	d.Reset()
+
+	assert.Empty(t, d.Calls())
+	assert.Nil(t, d.LastArgs())
+	assert.ErrorIs(t, db.QueryRow("SELECT").Scan(&i), stdtest.ErrNoRows)
+}
+
+func TestDriverExec(t *testing.T) {
+	d := stdtest.NewDriver()
+	db := d.DB()
+
+	defer db.Close()
+
+	res, err := db.Exec("INSERT", std.StringFrom("test"), std.String{}, std.TimeFrom(timeValue), []byte("data"))
+	assert.NoError(t, err)
+
+	n, err := res.RowsAffected()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	assert.Equal(t, []driver.Value{"test", nil, timeValue, []byte("data")}, d.LastArgs())
+
+	_, err = db.Exec("INSERT", std.UintFrom(1<<63))
+	assert.Error(t, err)
+	assert.Len(t, d.Calls(), 1)
+}
+
+func TestDriverTx(t *testing.T) {
+	d := stdtest.NewDriver()
+	db := d.DB()
+
+	defer db.Close()
+
+	tx, err := db.Begin()
+	assert.NoError(t, err)
+
+	_, err = tx.Exec("UPDATE", std.BoolFrom(true))
+	assert.NoError(t, err)
+	assert.NoError(t, tx.Commit())
+
+	tx, err = db.Begin()
+	assert.NoError(t, err)
+	assert.NoError(t, tx.Rollback())
+
+	assert.Equal(t, []driver.Value{true}, d.LastArgs())
+}
+
+func TestRegister(t *testing.T) {
+	d := stdtest.Register("stdtest")
+
+	db, err := sql.Open("stdtest", "")
+	assert.NoError(t, err)
+
+	defer db.Close()
+
+	_, err = db.Exec("DELETE", std.FloatFrom(1.5))
+	assert.NoError(t, err)
+	assert.Equal(t, []driver.Value{1.5}, d.LastArgs())
+
+	assert.Panics(t, func() {
+		stdtest.Register("stdtest")
+	})
+}
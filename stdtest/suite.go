@@ -0,0 +1,306 @@
+package stdtest
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Nullable is the interface implemented by pointers to nullable types following the std pattern.
+// They must also have a SetValid method taking a value of the underlying type,
+// and a Ptr method returning a pointer to it.
+type Nullable interface {
+	sql.Scanner
+	driver.Valuer
+	json.Marshaler
+	json.Unmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	IsZero() bool
+}
+
+// ScanCase is the expected result of scanning Src.
+type ScanCase struct {
+	Src driver.Value
+	// Want is the value returned by Value after a successful Scan.
+	Want driver.Value
+	// Err is true when Scan must fail.
+	Err bool
+}
+
+// Factory describes the nullable type run through RunNullableSuite.
+type Factory struct {
+	// New returns a pointer to a new null value.
+	New func() Nullable
+	// Value is a value of the underlying type, passed to SetValid.
+	// It must survive JSON and text round-trips.
+	Value interface{}
+	// Scan are the expected results of Scan, in addition to the checks made
+	// for every driver.Value type. At least one Scan must succeed, so a type
+	// failing to scan any driver.Value type needs a successful case here.
+	Scan []ScanCase
+}
+
+// scanSources are values of every driver.Value type.
+var scanSources = []driver.Value{
+	int64(12345),
+	float64(1.5),
+	true,
+	[]byte("12345"),
+	"12345",
+	time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC),
+}
+
+// RunNullableSuite checks the nullable type made by factory behaves like the std types:
+// null handling of Scan and Value, JSON and text round-trips, SetValid, Ptr and IsZero.
+func RunNullableSuite(t *testing.T, factory Factory) {
+	t.Helper()
+
+	if factory.New == nil {
+		t.Fatal("stdtest: Factory.New is nil")
+	}
+
+	setValid := func(t *testing.T) Nullable {
+		t.Helper()
+
+		n := factory.New()
+
+		m := reflect.ValueOf(n).MethodByName("SetValid")
+		if !m.IsValid() || m.Type().NumIn() != 1 || !reflect.TypeOf(factory.Value).AssignableTo(m.Type().In(0)) {
+			t.Fatalf("%T has no SetValid(%T) method", n, factory.Value)
+		}
+
+		m.Call([]reflect.Value{reflect.ValueOf(factory.Value)})
+
+		return n
+	}
+
+	t.Run("Null", func(t *testing.T) {
+		testNull(t, factory.New())
+	})
+
+	t.Run("SetValid", func(t *testing.T) {
+		testValid(t, setValid(t), factory.Value)
+	})
+
+	t.Run("Ptr", func(t *testing.T) {
+		testPtr(t, factory.New(), setValid(t), factory.Value)
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		testScan(t, factory)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		testJSON(t, factory.New, setValid(t))
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		testText(t, factory.New, setValid(t))
+	})
+}
+
+func testNull(t *testing.T, n Nullable) {
+	t.Helper()
+
+	if !n.IsZero() {
+		t.Errorf("new %T: IsZero() = false, want true", n)
+	}
+
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("new %T: Value() = %v, %v, want nil, nil", n, v, err)
+	}
+
+	if data, err := n.MarshalJSON(); err != nil || string(data) != "null" {
+		t.Errorf("new %T: MarshalJSON() = %s, %v, want null, nil", n, data, err)
+	}
+}
+
+func testValid(t *testing.T, n Nullable, value interface{}) {
+	t.Helper()
+
+	if n.IsZero() {
+		t.Errorf("%T.SetValid(%v): IsZero() = true, want false", n, value)
+	}
+
+	v, err := n.Value()
+	if err != nil || v == nil || !driver.IsValue(v) {
+		t.Errorf("%T.SetValid(%v): Value() = %#v, %v, want a non-nil driver.Value", n, value, v, err)
+	}
+}
+
+func testPtr(t *testing.T, null, valid Nullable, value interface{}) {
+	t.Helper()
+
+	ptr := func(n Nullable) reflect.Value {
+		m := reflect.ValueOf(n).MethodByName("Ptr")
+		if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 || m.Type().Out(0).Kind() != reflect.Ptr {
+			t.Fatalf("%T has no Ptr() method returning a pointer", n)
+		}
+
+		return m.Call(nil)[0]
+	}
+
+	if p := ptr(null); !p.IsNil() {
+		t.Errorf("new %T: Ptr() = %v, want nil", null, p.Interface())
+	}
+
+	p := ptr(valid)
+	if p.IsNil() {
+		t.Fatalf("%T.SetValid(%v): Ptr() = nil, want a pointer", valid, value)
+	}
+
+	if got := p.Elem().Interface(); !equal(got, value) {
+		t.Errorf("%T.SetValid(%v): *Ptr() = %v, want %v", valid, value, got, value)
+	}
+}
+
+func testScan(t *testing.T, factory Factory) {
+	t.Helper()
+
+	// A type failing every Scan would pass the checks below without scanning anything
+	scanned := false
+
+	for _, src := range scanSources {
+		n := factory.New()
+
+		if err := n.Scan(src); err != nil {
+			if v, verr := n.Value(); !n.IsZero() || verr != nil || v != nil {
+				t.Errorf("%T.Scan(%T) failed with %v but the value is not null: %v", n, src, err, v)
+			}
+
+			continue
+		}
+
+		v, err := n.Value()
+		if err != nil || v == nil || n.IsZero() {
+			t.Errorf("%T.Scan(%T) succeeded but the value is null: %v, %v", n, src, v, err)
+
+			continue
+		}
+
+		scanned = true
+
+		// Scanning the value returned by Value gives back the same value
+		again := factory.New()
+		if err := again.Scan(v); err != nil {
+			t.Errorf("%T.Scan(%#v) of its own Value failed: %v", again, v, err)
+		} else if w, _ := again.Value(); !equal(w, v) {
+			t.Errorf("%T.Scan(%#v) of its own Value: Value() = %#v", again, v, w)
+		}
+
+		// Scanning nil resets the value to null
+		if err := n.Scan(nil); err != nil {
+			t.Errorf("%T.Scan(nil) failed: %v", n, err)
+		}
+
+		testNull(t, n)
+	}
+
+	for _, c := range factory.Scan {
+		n := factory.New()
+
+		err := n.Scan(c.Src)
+
+		switch {
+		case c.Err && err == nil:
+			t.Errorf("%T.Scan(%#v) succeeded, want an error", n, c.Src)
+		case !c.Err && err != nil:
+			t.Errorf("%T.Scan(%#v) failed: %v", n, c.Src, err)
+		case !c.Err:
+			if v, _ := n.Value(); !equal(v, c.Want) {
+				t.Errorf("%T.Scan(%#v): Value() = %#v, want %#v", n, c.Src, v, c.Want)
+			}
+
+			scanned = true
+		}
+	}
+
+	if !scanned {
+		t.Errorf("%T.Scan failed for every driver.Value type, add a successful case to Factory.Scan", factory.New())
+	}
+}
+
+func testJSON(t *testing.T, newNullable func() Nullable, valid Nullable) {
+	t.Helper()
+
+	data, err := json.Marshal(valid)
+	if err != nil {
+		t.Fatalf("json.Marshal(%T) failed: %v", valid, err)
+	}
+
+	n := newNullable()
+	if err := json.Unmarshal(data, n); err != nil {
+		t.Fatalf("json.Unmarshal(%s) into %T failed: %v", data, n, err)
+	}
+
+	assertSameValue(t, "JSON", n, valid)
+
+	if again, _ := json.Marshal(n); !bytes.Equal(again, data) {
+		t.Errorf("%T JSON round-trip: got %s, want %s", n, again, data)
+	}
+
+	if err := json.Unmarshal([]byte("null"), n); err != nil {
+		t.Errorf("json.Unmarshal(null) into %T failed: %v", n, err)
+	}
+
+	testNull(t, n)
+
+	if err := json.Unmarshal([]byte("[]"), newNullable()); err == nil {
+		t.Errorf("json.Unmarshal([]) into %T succeeded, want an error", n)
+	}
+}
+
+func testText(t *testing.T, newNullable func() Nullable, valid Nullable) {
+	t.Helper()
+
+	text, err := valid.MarshalText()
+	if err != nil {
+		t.Fatalf("%T.MarshalText() failed: %v", valid, err)
+	}
+
+	n := newNullable()
+	if err := n.UnmarshalText(text); err != nil {
+		t.Fatalf("%T.UnmarshalText(%q) failed: %v", n, text, err)
+	}
+
+	assertSameValue(t, "text", n, valid)
+
+	null, err := newNullable().MarshalText()
+	if err != nil {
+		t.Fatalf("%T.MarshalText() of null failed: %v", n, err)
+	}
+
+	if err := n.UnmarshalText(null); err != nil {
+		t.Errorf("%T.UnmarshalText(%q) failed: %v", n, null, err)
+	}
+
+	testNull(t, n)
+}
+
+func assertSameValue(t *testing.T, name string, got, want Nullable) {
+	t.Helper()
+
+	gv, gerr := got.Value()
+	wv, werr := want.Value()
+
+	if gerr != nil || werr != nil || !equal(gv, wv) {
+		t.Errorf("%T %s round-trip: Value() = %#v, %v, want %#v, %v", got, name, gv, gerr, wv, werr)
+	}
+}
+
+// equal reports whether a and b are deeply equal, comparing times as instants.
+func equal(a, b interface{}) bool {
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+
+		return ok && at.Equal(bt)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
@@ -4,7 +4,9 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
-	"reflect"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // String is a nullable string. It supports SQL and JSON serialization.
@@ -44,13 +46,14 @@ func (s *String) Scan(value interface{}) error {
 		return nil
 	}
 
-	s.Valid = true
+	err := scanString(&s.Data, value)
+	s.Valid = err == nil
 
-	return convertAssign(&s.Data, value)
+	return err
 }
 
 // Value implements the driver Valuer interface.
-func (s *String) Value() (driver.Value, error) {
+func (s String) Value() (driver.Value, error) {
 	if !s.Valid {
 		return nil, nil
 	}
@@ -62,24 +65,31 @@ func (s *String) Value() (driver.Value, error) {
 // It supports string and null input. Blank string input does not produce a null String.
 // It also supports unmarshalling a sql.NullString.
 func (s *String) UnmarshalJSON(data []byte) error {
-	var (
-		err error
-		v   interface{}
-	)
+	if isJSONSimpleString(data) {
+		s.Data, s.Valid = string(data[1:len(data)-1]), true
 
-	if err = json.Unmarshal(data, &v); err != nil {
+		return nil
+	}
+
+	kind, err := jsonKindOf(data)
+	if err != nil {
 		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.String: %w", string(data), err)
 	}
 
-	switch x := v.(type) {
-	case string:
-		s.Data = x
-	case nil:
+	switch kind {
+	case jsonString:
+		// Strings with escape sequences are left to encoding/json
+		err = json.Unmarshal(data, &s.Data)
+	case jsonObject:
+		s.Valid, err = unmarshalNullObject(data, "String", "null.String", &s.Data)
+
+		return err
+	case jsonNull:
 		s.Valid = false
 
 		return nil
 	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.String", reflect.TypeOf(v).Name())
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.String", kind)
 	}
 
 	s.Valid = err == nil
@@ -90,21 +100,33 @@ func (s *String) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this String is null.
 func (s String) MarshalJSON() ([]byte, error) {
+	return s.AppendJSON(make([]byte, 0, len(s.Data)+2))
+}
+
+// AppendJSON appends the JSON encoding of this String to b, null if this String is null.
+// The string is escaped like encoding/json does.
+func (s String) AppendJSON(b []byte) ([]byte, error) {
 	if !s.Valid {
-		return []byte("null"), nil
+		return append(b, nullType...), nil
 	}
 
-	return json.Marshal(s.Data) // nolint: wrapcheck
+	return appendJSONString(b, s.Data), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string when this String is null.
 func (s String) MarshalText() ([]byte, error) {
+	return s.AppendText(make([]byte, 0, len(s.Data)))
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if this String is null.
+func (s String) AppendText(b []byte) ([]byte, error) {
 	if !s.Valid {
-		return []byte{}, nil
+		return b, nil
 	}
 
-	return []byte(s.Data), nil
+	return append(b, s.Data...), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -140,3 +162,195 @@ func (s String) IsZero() bool {
 func (s String) String() string {
 	return s.Data
 }
+
+// Equal reports whether s and o are both null or both valid with the same value.
+func (s String) Equal(o String) bool {
+	return s.Valid == o.Valid && (!s.Valid || s.Data == o.Data)
+}
+
+// Compare returns -1 if s is lexicographically less than o, 0 if they are equal
+// and +1 if s is greater than o. Null values are ordered according to nulls.
+func (s String) Compare(o String, nulls NullOrder) int {
+	if c, ok := compareNull(s.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return strings.Compare(s.Data, o.Data)
+}
+
+// ValueOr returns this String's value, or def if this String is null.
+func (s String) ValueOr(def string) string {
+	if !s.Valid {
+		return def
+	}
+
+	return s.Data
+}
+
+// ValueOrZero returns this String's value, or the zero value of string if this String is null.
+func (s String) ValueOrZero() string {
+	if !s.Valid {
+		return ""
+	}
+
+	return s.Data
+}
+
+// OrElse returns this String's value, or the result of fn if this String is null.
+// fn is only called when this String is null.
+func (s String) OrElse(fn func() string) string {
+	if !s.Valid {
+		return fn()
+	}
+
+	return s.Data
+}
+
+// Get returns this String's value and true, or the zero value of string and false if this String is null.
+func (s String) Get() (string, bool) {
+	return s.ValueOrZero(), s.Valid
+}
+
+// MustGet returns this String's value, it panics if this String is null.
+func (s String) MustGet() string {
+	if !s.Valid {
+		panic("std: MustGet called on a null String")
+	}
+
+	return s.Data
+}
+
+// Map returns a String holding the result of fn applied to this String's value,
+// or a null String if this String is null. fn is only called when this String is valid.
+func (s String) Map(fn func(string) string) String {
+	if !s.Valid {
+		return String{}
+	}
+
+	return NewString(fn(s.Data), true)
+}
+
+// FlatMap returns the result of fn applied to this String's value,
+// or a null String if this String is null. fn is only called when this String is valid.
+func (s String) FlatMap(fn func(string) String) String {
+	if !s.Valid {
+		return String{}
+	}
+
+	return fn(s.Data)
+}
+
+// Filter returns this String if it is valid and its value satisfies fn,
+// otherwise it returns a null String.
+func (s String) Filter(fn func(string) bool) String {
+	if !s.Valid || !fn(s.Data) {
+		return String{}
+	}
+
+	return s
+}
+
+// ParseInt parses this String as a base 10 integer.
+// A null String gives a null Int.
+func (s String) ParseInt() (Int, error) {
+	if !s.Valid {
+		return Int{}, nil
+	}
+
+	i, err := strconv.ParseInt(s.Data, 10, 64)
+	if err != nil {
+		return Int{}, err // nolint: wrapcheck
+	}
+
+	return IntFrom(i), nil
+}
+
+// ParseUint parses this String as a base 10 unsigned integer.
+// A null String gives a null Uint.
+func (s String) ParseUint() (Uint, error) {
+	if !s.Valid {
+		return Uint{}, nil
+	}
+
+	i, err := strconv.ParseUint(s.Data, 10, 64)
+	if err != nil {
+		return Uint{}, err // nolint: wrapcheck
+	}
+
+	return UintFrom(i), nil
+}
+
+// ParseFloat parses this String as a floating-point number.
+// A null String gives a null Float.
+func (s String) ParseFloat() (Float, error) {
+	if !s.Valid {
+		return Float{}, nil
+	}
+
+	f, err := strconv.ParseFloat(s.Data, 64)
+	if err != nil {
+		return Float{}, err // nolint: wrapcheck
+	}
+
+	return FloatFrom(f), nil
+}
+
+// ParseBool parses this String as a boolean, it accepts the values accepted by strconv.ParseBool.
+// A null String gives a null Bool.
+func (s String) ParseBool() (Bool, error) {
+	if !s.Valid {
+		return Bool{}, nil
+	}
+
+	b, err := strconv.ParseBool(s.Data)
+	if err != nil {
+		return Bool{}, err // nolint: wrapcheck
+	}
+
+	return BoolFrom(b), nil
+}
+
+// ParseTime parses this String as a RFC 3339 time.
+// A null String gives a null Time.
+func (s String) ParseTime() (Time, error) {
+	if !s.Valid {
+		return Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s.Data)
+	if err != nil {
+		return Time{}, err // nolint: wrapcheck
+	}
+
+	return TimeFrom(t), nil
+}
+
+// ParseDateTime parses this String as an ISO8601 date-time.
+// A null String gives a null DateTime.
+func (s String) ParseDateTime() (DateTime, error) {
+	if !s.Valid {
+		return DateTime{}, nil
+	}
+
+	t, err := time.Parse(dateTimeFormat, s.Data)
+	if err != nil {
+		return DateTime{}, err // nolint: wrapcheck
+	}
+
+	return NewDateTime(t, true), nil
+}
+
+// ParseDate parses this String as an ISO8601 (yyyy-mm-dd) date.
+// A null String gives a null Date.
+func (s String) ParseDate() (Date, error) {
+	if !s.Valid {
+		return Date{}, nil
+	}
+
+	t, err := time.Parse(dateFormat, s.Data)
+	if err != nil {
+		return Date{}, err // nolint: wrapcheck
+	}
+
+	return NewDate(t, true), nil
+}
@@ -0,0 +1,509 @@
+package std
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// StringMap is a nullable map[string]String, mapping to a Postgres hstore column.
+// The values of its keys can be null.
+// It scans from the hstore literal syntax and JSON objects, writes the hstore literal syntax,
+// and marshals to a JSON object with sorted keys, or null.
+// Use JSONStringMap for JSON object columns.
+type StringMap struct {
+	Data  map[string]String
+	Valid bool
+}
+
+// NewStringMap creates a new StringMap.
+func NewStringMap(m map[string]String, valid bool) StringMap {
+	return StringMap{
+		Data:  m,
+		Valid: valid,
+	}
+}
+
+// StringMapFrom creates a new StringMap that will always be valid.
+func StringMapFrom(m map[string]String) StringMap {
+	return NewStringMap(m, true)
+}
+
+// Scan implements the Scanner interface.
+// It supports hstore literals and JSON objects whose values are strings or null.
+func (m *StringMap) Scan(value interface{}) error {
+	var (
+		data map[string]String
+		err  error
+	)
+
+	switch x := value.(type) {
+	case nil:
+		m.Data, m.Valid = nil, false
+
+		return nil
+	case []byte:
+		data, err = parseStringMap(x)
+	case string:
+		data, err = parseStringMap([]byte(x))
+	default:
+		err = fmt.Errorf("std: cannot scan type %T into std.StringMap: %v", value, value)
+	}
+
+	if err != nil {
+		m.Data, m.Valid = nil, false
+
+		return err
+	}
+
+	m.Data, m.Valid = data, true
+
+	return nil
+}
+
+// parseStringMap parses a JSON object, or an hstore literal.
+func parseStringMap(src []byte) (map[string]String, error) {
+	if trimmed := bytes.TrimLeft(src, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '{' {
+		var data map[string]String
+
+		if err := json.Unmarshal(trimmed, &data); err != nil {
+			return nil, err // nolint: wrapcheck
+		}
+
+		return data, nil
+	}
+
+	return parseHstore(src)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the hstore literal, or nil if this StringMap is null.
+func (m StringMap) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+
+	return string(appendHstore(nil, m.Data)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports object and null input, the values of the object must be strings or null.
+func (m *StringMap) UnmarshalJSON(data []byte) error {
+	kind, err := jsonKindOf(data)
+	if err != nil {
+		return fmt.Errorf("json: cannot unmarshal %s into Go value of type std.StringMap: %w", string(data), err)
+	}
+
+	switch kind {
+	case jsonNull:
+		m.Data, m.Valid = nil, false
+
+		return nil
+	case jsonObject:
+		var d map[string]String
+
+		if err := json.Unmarshal(data, &d); err != nil {
+			m.Data, m.Valid = nil, false
+
+			return err // nolint: wrapcheck
+		}
+
+		m.Data, m.Valid = d, true
+
+		return nil
+	}
+
+	m.Data, m.Valid = nil, false
+
+	return fmt.Errorf("json: cannot unmarshal %s into Go value of type std.StringMap", string(data))
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this StringMap is null, and the keys are sorted.
+func (m StringMap) MarshalJSON() ([]byte, error) {
+	return m.AppendJSON(nil)
+}
+
+// AppendJSON appends the JSON encoding of this StringMap to b, null if this StringMap is null.
+// The keys are sorted, so the encoding is deterministic.
+func (m StringMap) AppendJSON(b []byte) ([]byte, error) {
+	if !m.Valid {
+		return append(b, nullType...), nil
+	}
+
+	b = append(b, '{')
+
+	for i, key := range sortedKeys(m.Data) {
+		if i > 0 {
+			b = append(b, ',')
+		}
+
+		b = appendJSONString(b, key)
+		b = append(b, ':')
+		b, _ = m.Data[key].AppendJSON(b)
+	}
+
+	return append(b, '}'), nil
+}
+
+// SetValid changes this StringMap's value and also sets it to be non-null.
+func (m *StringMap) SetValid(v map[string]String) {
+	m.Data = v
+	m.Valid = true
+}
+
+// IsZero returns true for null StringMap, for potential future omitempty support.
+func (m StringMap) IsZero() bool {
+	return !m.Valid
+}
+
+// Equal reports whether m and o are both null or both valid with the same keys and values.
+func (m StringMap) Equal(o StringMap) bool {
+	return m.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if m is less than o, 0 if they are equal and +1 if m is greater than o,
+// comparing the keys and their values in key order, then the number of keys.
+// Null values, and null values of keys, are ordered according to nulls.
+func (m StringMap) Compare(o StringMap, nulls NullOrder) int {
+	if c, ok := compareNull(m.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	keys, oKeys := sortedKeys(m.Data), sortedKeys(o.Data)
+
+	for k := 0; k < len(keys) && k < len(oKeys); k++ {
+		if c := strings.Compare(keys[k], oKeys[k]); c != 0 {
+			return c
+		}
+
+		if c := m.Data[keys[k]].Compare(o.Data[oKeys[k]], nulls); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(keys) < len(oKeys):
+		return -1
+	case len(keys) > len(oKeys):
+		return 1
+	}
+
+	return 0
+}
+
+// ValueOr returns this StringMap's map, or def if this StringMap is null.
+func (m StringMap) ValueOr(def map[string]String) map[string]String {
+	if !m.Valid {
+		return def
+	}
+
+	return m.Data
+}
+
+// ValueOrZero returns this StringMap's map, or nil if this StringMap is null.
+func (m StringMap) ValueOrZero() map[string]String {
+	if !m.Valid {
+		return nil
+	}
+
+	return m.Data
+}
+
+// OrElse returns this StringMap's map, or the result of fn if this StringMap is null.
+// fn is only called when this StringMap is null.
+func (m StringMap) OrElse(fn func() map[string]String) map[string]String {
+	if !m.Valid {
+		return fn()
+	}
+
+	return m.Data
+}
+
+// Get returns this StringMap's map and true, or nil and false if this StringMap is null.
+func (m StringMap) Get() (map[string]String, bool) {
+	return m.ValueOrZero(), m.Valid
+}
+
+// MustGet returns this StringMap's map, it panics if this StringMap is null.
+func (m StringMap) MustGet() map[string]String {
+	if !m.Valid {
+		panic("std: MustGet called on a null StringMap")
+	}
+
+	return m.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the JSON object with sorted keys, so that an empty map is not a blank string,
+// or a blank string when this StringMap is null.
+func (m StringMap) MarshalText() ([]byte, error) {
+	if !m.Valid {
+		return []byte{}, nil
+	}
+
+	return m.AppendJSON(nil)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports JSON objects and hstore literals, a blank string is a null StringMap.
+func (m *StringMap) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		m.Data, m.Valid = nil, false
+
+		return nil
+	}
+
+	return m.Scan(text)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the JSON object with sorted keys, or a blank string if this StringMap is null.
+func (m StringMap) String() string {
+	text, _ := m.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this StringMap is null, the JSON object otherwise.
+func (m StringMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, m.Valid, m.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null StringMap, an empty element is a null StringMap.
+func (m *StringMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, m)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this StringMap is null.
+func (m StringMap) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, m.Valid, m.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (m *StringMap) UnmarshalXMLAttr(attr xml.Attr) error {
+	return m.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m StringMap) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(m.Valid, m.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *StringMap) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.StringMap", m)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (m StringMap) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (m *StringMap) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this StringMap is null, a mapping otherwise.
+func (m StringMap) MarshalYAML() (interface{}, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+
+	if m.Data == nil {
+		return map[string]String{}, nil
+	}
+
+	return m.Data, nil
+}
+
+// unmarshalYAML decodes this StringMap with the unmarshal func of a YAML library.
+func (m *StringMap) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	m.Data, m.Valid = nil, false
+
+	if v == nil {
+		return nil
+	}
+
+	var d map[string]String
+
+	if err := unmarshal(&d); err != nil {
+		return err
+	}
+
+	m.Data, m.Valid = d, true
+
+	return nil
+}
+
+// JSONStringMap is a StringMap mapping to a Postgres json or jsonb object column:
+// it writes a JSON object instead of an hstore literal.
+type JSONStringMap StringMap
+
+// Scan implements the Scanner interface.
+// It supports JSON objects whose values are strings or null, and hstore literals.
+func (m *JSONStringMap) Scan(value interface{}) error {
+	return (*StringMap)(m).Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+// It returns the JSON object, or nil if this JSONStringMap is null.
+func (m JSONStringMap) Value() (driver.Value, error) {
+	if !m.Valid {
+		return nil, nil
+	}
+
+	b, err := StringMap(m).AppendJSON(nil)
+
+	return string(b), err
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports object and null input, the values of the object must be strings or null.
+func (m *JSONStringMap) UnmarshalJSON(data []byte) error {
+	return (*StringMap)(m).UnmarshalJSON(data)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this JSONStringMap is null, and the keys are sorted.
+func (m JSONStringMap) MarshalJSON() ([]byte, error) {
+	return StringMap(m).MarshalJSON()
+}
+
+// SetValid changes this JSONStringMap's value and also sets it to be non-null.
+func (m *JSONStringMap) SetValid(v map[string]String) {
+	m.Data = v
+	m.Valid = true
+}
+
+// IsZero returns true for null JSONStringMap, for potential future omitempty support.
+func (m JSONStringMap) IsZero() bool {
+	return !m.Valid
+}
+
+// Equal reports whether m and o are both null or both valid with the same keys and values.
+func (m JSONStringMap) Equal(o JSONStringMap) bool {
+	return StringMap(m).Equal(StringMap(o))
+}
+
+// Compare returns -1 if m is less than o, 0 if they are equal and +1 if m is greater than o,
+// like StringMap.Compare.
+func (m JSONStringMap) Compare(o JSONStringMap, nulls NullOrder) int {
+	return StringMap(m).Compare(StringMap(o), nulls)
+}
+
+// ValueOr returns this JSONStringMap's map, or def if this JSONStringMap is null.
+func (m JSONStringMap) ValueOr(def map[string]String) map[string]String {
+	return StringMap(m).ValueOr(def)
+}
+
+// ValueOrZero returns this JSONStringMap's map, or nil if this JSONStringMap is null.
+func (m JSONStringMap) ValueOrZero() map[string]String {
+	return StringMap(m).ValueOrZero()
+}
+
+// OrElse returns this JSONStringMap's map, or the result of fn if this JSONStringMap is null.
+// fn is only called when this JSONStringMap is null.
+func (m JSONStringMap) OrElse(fn func() map[string]String) map[string]String {
+	return StringMap(m).OrElse(fn)
+}
+
+// Get returns this JSONStringMap's map and true, or nil and false if this JSONStringMap is null.
+func (m JSONStringMap) Get() (map[string]String, bool) {
+	return StringMap(m).Get()
+}
+
+// MustGet returns this JSONStringMap's map, it panics if this JSONStringMap is null.
+func (m JSONStringMap) MustGet() map[string]String {
+	if !m.Valid {
+		panic("std: MustGet called on a null JSONStringMap")
+	}
+
+	return m.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the JSON object with sorted keys, or a blank string when this JSONStringMap is null.
+func (m JSONStringMap) MarshalText() ([]byte, error) {
+	return StringMap(m).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It supports JSON objects and hstore literals, a blank string is a null JSONStringMap.
+func (m *JSONStringMap) UnmarshalText(text []byte) error {
+	return (*StringMap)(m).UnmarshalText(text)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the JSON object with sorted keys, or a blank string if this JSONStringMap is null.
+func (m JSONStringMap) String() string {
+	return StringMap(m).String()
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this JSONStringMap is null, the JSON object otherwise.
+func (m JSONStringMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return StringMap(m).MarshalXML(e, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null JSONStringMap, an empty element is a null JSONStringMap.
+func (m *JSONStringMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return (*StringMap)(m).UnmarshalXML(d, start)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this JSONStringMap is null.
+func (m JSONStringMap) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return StringMap(m).MarshalXMLAttr(name)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (m *JSONStringMap) UnmarshalXMLAttr(attr xml.Attr) error {
+	return (*StringMap)(m).UnmarshalXMLAttr(attr)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m JSONStringMap) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(m.Valid, m.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (m *JSONStringMap) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.JSONStringMap", m)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (m JSONStringMap) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (m *JSONStringMap) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this JSONStringMap is null, a mapping otherwise.
+func (m JSONStringMap) MarshalYAML() (interface{}, error) {
+	return StringMap(m).MarshalYAML()
+}
+
+// unmarshalYAML decodes this JSONStringMap with the unmarshal func of a YAML library.
+func (m *JSONStringMap) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return (*StringMap)(m).unmarshalYAML(unmarshal)
+}
@@ -0,0 +1,224 @@
+package std
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHstore(t *testing.T) {
+	testCases := []struct {
+		src      string
+		expected map[string]String
+	}{
+		{``, map[string]String{}},
+		{`  `, map[string]String{}},
+		{`"a"=>"1"`, map[string]String{"a": StringFrom("1")}},
+		{`"a"=>"1", "b"=>NULL`, map[string]String{"a": StringFrom("1"), "b": {}}},
+		{`a=>1,b => null , c=>"NULL"`, map[string]String{"a": StringFrom("1"), "b": {}, "c": StringFrom("NULL")}},
+		{`"a b"=>"c, d", "e\"f"=>"g\\h"`, map[string]String{"a b": StringFrom("c, d"), `e"f`: StringFrom(`g\h`)}},
+		{`""=>""`, map[string]String{"": StringFrom("")}},
+		{`a\ b=>c\,d`, map[string]String{"a b": StringFrom("c,d")}},
+	}
+
+	for _, tc := range testCases {
+		m, err := parseHstore([]byte(tc.src))
+		assert.NoError(t, err, tc.src)
+		assert.Equal(t, tc.expected, m, tc.src)
+	}
+}
+
+func TestParseHstoreErrors(t *testing.T) {
+	for _, src := range []string{
+		`a`,
+		`a=>`,
+		`=>b`,
+		`NULL=>b`,
+		`"a"=>"b",`,
+		`"a"=>"b" "c"=>"d"`,
+		`"a=>"b"`,
+		`"a"=>"b\`,
+		`"a">"b"`,
+	} {
+		_, err := parseHstore([]byte(src))
+		assert.True(t, errors.Is(err, ErrInvalidHstore), "%s: %v", src, err)
+	}
+}
+
+func TestStringMapScan(t *testing.T) {
+	var m StringMap
+
+	assert.NoError(t, m.Scan([]byte(`"a"=>"1", "b"=>NULL`)))
+	assert.Equal(t, StringMapFrom(map[string]String{"a": StringFrom("1"), "b": {}}), m)
+
+	assert.NoError(t, m.Scan(` {"b":null,"a":"1"}`))
+	assert.Equal(t, StringMapFrom(map[string]String{"a": StringFrom("1"), "b": {}}), m)
+
+	assert.NoError(t, m.Scan(``))
+	assert.True(t, m.Valid)
+	assert.Empty(t, m.Data)
+
+	assert.NoError(t, m.Scan(nil))
+	assert.False(t, m.Valid)
+	assert.Nil(t, m.Data)
+
+	assert.Error(t, m.Scan(`{"a":1}`))
+	assert.False(t, m.Valid)
+
+	assert.Error(t, m.Scan(`a`))
+	assert.EqualError(t, m.Scan(int64(1)), "std: cannot scan type int64 into std.StringMap: 1")
+}
+
+func TestStringMapValue(t *testing.T) {
+	v, err := StringMap{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = StringMapFrom(nil).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, ``, v)
+
+	m := StringMapFrom(map[string]String{"b": {}, "a": StringFrom("1"), `c"d`: StringFrom(`e\f`)})
+
+	v, err = m.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `"a"=>"1", "b"=>NULL, "c\"d"=>"e\\f"`, v)
+
+	var s StringMap
+
+	assert.NoError(t, s.Scan(v))
+	assert.Equal(t, m, s)
+}
+
+func TestStringMapJSON(t *testing.T) {
+	m := StringMapFrom(map[string]String{"c": StringFrom("3"), "a": StringFrom("1"), "b": {}, "é\n": StringFrom("<")})
+
+	// The keys are sorted
+	for i := 0; i < 10; i++ {
+		data, err := json.Marshal(m)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":"1","b":null,"c":"3","é\n":"\u003c"}`, string(data))
+	}
+
+	var s StringMap
+
+	data, _ := json.Marshal(m)
+	assert.NoError(t, json.Unmarshal(data, &s))
+	assert.Equal(t, m, s)
+
+	assert.NoError(t, json.Unmarshal(nullJSON, &s))
+	assert.False(t, s.Valid)
+	assert.True(t, s.IsZero())
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	s.SetValid(nil)
+	assert.False(t, s.IsZero())
+
+	data, err = json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`["a"]`), &s))
+	assert.False(t, s.Valid)
+	assert.Error(t, json.Unmarshal([]byte(`{"a":true}`), &s))
+	assert.Error(t, json.Unmarshal(invalidJSON, &s))
+}
+
+func TestJSONStringMap(t *testing.T) {
+	m := JSONStringMap(StringMapFrom(map[string]String{"b": {}, "a": StringFrom("1")}))
+
+	v, err := m.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":"1","b":null}`, v)
+
+	var s JSONStringMap
+
+	assert.NoError(t, s.Scan(v))
+	assert.Equal(t, m, s)
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":"1","b":null}`, string(data))
+
+	assert.NoError(t, json.Unmarshal(nullJSON, &s))
+	assert.True(t, s.IsZero())
+
+	v, err = s.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	s.SetValid(map[string]String{})
+
+	v, err = s.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, v)
+}
+
+func TestStringMapAccessors(t *testing.T) {
+	a := StringMapFrom(map[string]String{"a": StringFrom("1"), "b": {}})
+	b := StringMapFrom(map[string]String{"a": StringFrom("1"), "b": StringFrom("2")})
+
+	assert.Equal(t, -1, a.Compare(b, NullsFirst))
+	assert.Equal(t, 1, a.Compare(b, NullsLast))
+	assert.Equal(t, 1, a.Compare(StringMapFrom(map[string]String{"a": StringFrom("1")}), NullsFirst))
+	assert.Equal(t, -1, a.Compare(StringMapFrom(map[string]String{"c": {}}), NullsFirst))
+	assert.Equal(t, -1, StringMap{}.Compare(a, NullsFirst))
+	assert.Equal(t, 0, StringMap{}.Compare(StringMap{}, NullsFirst))
+
+	assert.True(t, a.Equal(StringMapFrom(map[string]String{"a": StringFrom("1"), "b": {}})))
+	assert.False(t, a.Equal(b))
+	assert.True(t, StringMapFrom(nil).Equal(StringMapFrom(map[string]String{})))
+	assert.False(t, StringMapFrom(nil).Equal(StringMap{}))
+
+	assert.Equal(t, a.Data, a.ValueOr(b.Data))
+	assert.Equal(t, b.Data, StringMap{}.ValueOr(b.Data))
+	assert.Nil(t, StringMap{}.ValueOrZero())
+	assert.Equal(t, b.Data, StringMap{}.OrElse(func() map[string]String { return b.Data }))
+
+	v, ok := a.Get()
+	assert.True(t, ok)
+	assert.Equal(t, a.Data, v)
+
+	assert.Equal(t, a.Data, a.MustGet())
+	assert.Panics(t, func() {
+		StringMap{}.MustGet()
+	})
+
+	assert.Equal(t, `{"a":"1","b":null}`, a.String())
+	assert.Equal(t, `{}`, StringMapFrom(nil).String())
+	assert.Equal(t, ``, StringMap{}.String())
+
+	var m StringMap
+
+	assert.NoError(t, m.UnmarshalText([]byte(`"a"=>"1"`)))
+	assert.Equal(t, map[string]String{"a": StringFrom("1")}, m.Data)
+
+	j := JSONStringMap(a)
+	assert.True(t, j.Equal(JSONStringMap(a)))
+	assert.Equal(t, -1, j.Compare(JSONStringMap(b), NullsFirst))
+	assert.Equal(t, a.Data, j.MustGet())
+	assert.Equal(t, a.String(), j.String())
+	assert.Panics(t, func() {
+		JSONStringMap{}.MustGet()
+	})
+}
+
+func TestStringMapEncodings(t *testing.T) {
+	for _, v := range []StringMap{
+		StringMapFrom(map[string]String{"a": StringFrom("1"), "b c": {}}),
+		StringMapFrom(map[string]String{}),
+		{},
+	} {
+		testTextEncodings(t, v, func() interface{} { return &StringMap{} })
+		testTextEncodings(t, JSONStringMap(v), func() interface{} { return &JSONStringMap{} })
+	}
+
+	y, err := StringMapFrom(nil).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]String{}, y)
+}
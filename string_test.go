@@ -2,6 +2,7 @@ package std
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -186,3 +187,189 @@ func assertJSONEquals(t *testing.T, data []byte, cmp string, from string) {
 		t.Errorf("bad %s data: %s ≠ %s\n", from, data, cmp)
 	}
 }
+
+func TestStringEqual(t *testing.T) {
+	assert.True(t, StringFrom("test").Equal(StringFrom("test")))
+	assert.True(t, String{}.Equal(NewString("test", false)))
+	assert.False(t, StringFrom("test").Equal(StringFrom("")))
+	assert.False(t, StringFrom("").Equal(String{}))
+}
+
+func TestStringCompare(t *testing.T) {
+	assert.Equal(t, -1, StringFrom("a").Compare(StringFrom("b"), NullsFirst))
+	assert.Equal(t, 1, StringFrom("b").Compare(StringFrom("a"), NullsFirst))
+	assert.Equal(t, 0, StringFrom("a").Compare(StringFrom("a"), NullsFirst))
+	assert.Equal(t, -1, String{}.Compare(StringFrom(""), NullsFirst))
+	assert.Equal(t, 1, String{}.Compare(StringFrom(""), NullsLast))
+}
+
+func TestStringValueOr(t *testing.T) {
+	valid := StringFrom("test")
+	assert.Equal(t, "test", valid.ValueOr("default"))
+	assert.Equal(t, "test", valid.ValueOrZero())
+
+	null := String{}
+	assert.Equal(t, "default", null.ValueOr("default"))
+	assert.Equal(t, "", null.ValueOrZero())
+}
+
+func TestStringOrElse(t *testing.T) {
+	called := false
+	fallback := func() string {
+		called = true
+
+		return "default"
+	}
+
+	valid := StringFrom("test")
+	assert.Equal(t, "test", valid.OrElse(fallback))
+	assert.False(t, called)
+
+	null := String{}
+	assert.Equal(t, "default", null.OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestStringGet(t *testing.T) {
+	v, ok := StringFrom("test").Get()
+	assert.True(t, ok)
+	assert.Equal(t, "test", v)
+
+	v, ok = String{}.Get()
+	assert.False(t, ok)
+	assert.Equal(t, "", v)
+}
+
+func TestStringMustGet(t *testing.T) {
+	assert.Equal(t, "test", StringFrom("test").MustGet())
+
+	assert.Panics(t, func() {
+		String{}.MustGet()
+	})
+}
+
+func TestStringMap(t *testing.T) {
+	mapped := StringFrom("test").Map(strings.ToUpper)
+	assert.True(t, mapped.Valid)
+	assert.Equal(t, "TEST", mapped.ValueOrZero())
+
+	called := false
+	null := String{}.Map(func(v string) string {
+		called = true
+
+		return v
+	})
+	assert.False(t, null.Valid)
+	assert.False(t, called)
+}
+
+func TestStringFlatMap(t *testing.T) {
+	toNull := func(v string) String {
+		return String{}
+	}
+
+	assert.False(t, StringFrom("test").FlatMap(toNull).Valid)
+	assert.Equal(t, StringFrom("test"), StringFrom("test").FlatMap(func(v string) String {
+		return StringFrom(v)
+	}))
+	assert.False(t, String{}.FlatMap(func(v string) String {
+		return StringFrom("test")
+	}).Valid)
+}
+
+func TestStringFilter(t *testing.T) {
+	assert.Equal(t, StringFrom("test"), StringFrom("test").Filter(func(v string) bool { return v != "" }))
+	assert.False(t, StringFrom("test").Filter(func(v string) bool { return false }).Valid)
+	assert.False(t, String{}.Filter(func(v string) bool { return true }).Valid)
+}
+
+func TestStringParseInt(t *testing.T) {
+	i, err := StringFrom("12345").ParseInt()
+	assert.NoError(t, err)
+	assert.Equal(t, IntFrom(12345), i)
+
+	i, err = String{}.ParseInt()
+	assert.NoError(t, err)
+	assert.False(t, i.Valid)
+
+	_, err = StringFrom("test").ParseInt()
+	assert.Error(t, err)
+}
+
+func TestStringParseUint(t *testing.T) {
+	u, err := StringFrom("12345").ParseUint()
+	assert.NoError(t, err)
+	assert.Equal(t, UintFrom(12345), u)
+
+	u, err = String{}.ParseUint()
+	assert.NoError(t, err)
+	assert.False(t, u.Valid)
+
+	_, err = StringFrom("-1").ParseUint()
+	assert.Error(t, err)
+}
+
+func TestStringParseFloat(t *testing.T) {
+	f, err := StringFrom("1.2345").ParseFloat()
+	assert.NoError(t, err)
+	assert.Equal(t, FloatFrom(1.2345), f)
+
+	f, err = String{}.ParseFloat()
+	assert.NoError(t, err)
+	assert.False(t, f.Valid)
+
+	_, err = StringFrom("test").ParseFloat()
+	assert.Error(t, err)
+}
+
+func TestStringParseBool(t *testing.T) {
+	b, err := StringFrom("true").ParseBool()
+	assert.NoError(t, err)
+	assert.Equal(t, BoolFrom(true), b)
+
+	b, err = String{}.ParseBool()
+	assert.NoError(t, err)
+	assert.False(t, b.Valid)
+
+	_, err = StringFrom("test").ParseBool()
+	assert.Error(t, err)
+}
+
+func TestStringParseTime(t *testing.T) {
+	ti, err := StringFrom(timeString).ParseTime()
+	assert.NoError(t, err)
+	assert.Equal(t, TimeFrom(timeValue), ti)
+
+	ti, err = String{}.ParseTime()
+	assert.NoError(t, err)
+	assert.False(t, ti.Valid)
+
+	_, err = StringFrom("test").ParseTime()
+	assert.Error(t, err)
+}
+
+func TestStringParseDateTime(t *testing.T) {
+	dt, err := StringFrom(dateTimeString).ParseDateTime()
+	assert.NoError(t, err)
+	assert.True(t, dateTimeValue.Equal(dt.Data))
+
+	dt, err = String{}.ParseDateTime()
+	assert.NoError(t, err)
+	assert.False(t, dt.Valid)
+
+	_, err = StringFrom("test").ParseDateTime()
+	assert.Error(t, err)
+}
+
+func TestStringParseDate(t *testing.T) {
+	d, err := StringFrom(dateString).ParseDate()
+	assert.NoError(t, err)
+	assert.Equal(t, DateFrom(dateValue), d)
+
+	d, err = String{}.ParseDate()
+	assert.NoError(t, err)
+	assert.False(t, d.Valid)
+
+	_, err = StringFrom("test").ParseDate()
+	assert.Error(t, err)
+}
@@ -0,0 +1,519 @@
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"strings"
+)
+
+// Strings is a nullable []string, mapping to a Postgres text[] column.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+// The elements can not be NULL, use NullStrings for arrays with NULL elements.
+type Strings struct {
+	Data  []string
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewStrings creates a new Strings.
+func NewStrings(data []string, valid bool) Strings {
+	return Strings{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// StringsFrom creates a new Strings that will always be valid.
+func StringsFrom(data []string) Strings {
+	return NewStrings(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal, a NULL element is an error.
+func (s *Strings) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "Strings")
+	if err == nil && valid {
+		err = s.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		s.Data, s.Dims, s.Valid = nil, nil, false
+
+		return err
+	}
+
+	s.Dims, s.Valid = dims, true
+
+	return nil
+}
+
+func (s *Strings) fromArray(elems []arrayElement) error {
+	data := make([]string, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			return errArrayNull("Strings")
+		}
+
+		data[k] = e.text
+	}
+
+	s.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this Strings is null.
+func (s Strings) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, s.Dims, len(s.Data), "Strings", func(buf []byte, k int) []byte {
+		return appendArrayElement(buf, s.Data[k])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input.
+func (s *Strings) UnmarshalJSON(data []byte) error {
+	var d []string
+
+	dims, valid, err := unmarshalJSONArray(data, "Strings", &d)
+	if err != nil || !valid {
+		s.Data, s.Dims, s.Valid = nil, nil, false
+
+		return err
+	}
+
+	s.Data, s.Dims, s.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Strings is null, and an empty array if it is valid without elements.
+func (s Strings) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(s.Data, len(s.Data), s.Dims, "Strings")
+}
+
+// SetValid changes this Strings's value and also sets it to be non-null.
+func (s *Strings) SetValid(v []string) {
+	s.Data = v
+	s.Dims = nil
+	s.Valid = true
+}
+
+// IsZero returns true for null Strings, for potential future omitempty support.
+func (s Strings) IsZero() bool {
+	return !s.Valid
+}
+
+// Equal reports whether s and o are both null or both valid with the same elements and dimensions.
+func (s Strings) Equal(o Strings) bool {
+	return s.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if s is less than o, 0 if they are equal and +1 if s is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+func (s Strings) Compare(o Strings, nulls NullOrder) int {
+	if c, ok := compareNull(s.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(s.Data), len(o.Data), s.Dims, o.Dims, func(k int) int {
+		return strings.Compare(s.Data[k], o.Data[k])
+	})
+}
+
+// ValueOr returns this Strings's elements, or def if this Strings is null.
+func (s Strings) ValueOr(def []string) []string {
+	if !s.Valid {
+		return def
+	}
+
+	return s.Data
+}
+
+// ValueOrZero returns this Strings's elements, or nil if this Strings is null.
+func (s Strings) ValueOrZero() []string {
+	if !s.Valid {
+		return nil
+	}
+
+	return s.Data
+}
+
+// OrElse returns this Strings's elements, or the result of fn if this Strings is null.
+// fn is only called when this Strings is null.
+func (s Strings) OrElse(fn func() []string) []string {
+	if !s.Valid {
+		return fn()
+	}
+
+	return s.Data
+}
+
+// Get returns this Strings's elements and true, or nil and false if this Strings is null.
+func (s Strings) Get() ([]string, bool) {
+	return s.ValueOrZero(), s.Valid
+}
+
+// MustGet returns this Strings's elements, it panics if this Strings is null.
+func (s Strings) MustGet() []string {
+	if !s.Valid {
+		panic("std: MustGet called on a null Strings")
+	}
+
+	return s.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this Strings is null.
+func (s Strings) MarshalText() ([]byte, error) {
+	return marshalArrayText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null Strings.
+func (s *Strings) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, s)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this Strings is null.
+func (s Strings) String() string {
+	text, _ := s.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Strings is null, the array literal otherwise.
+func (s Strings) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, s.Valid, s.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Strings, an empty element is a null Strings.
+func (s *Strings) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Strings is null.
+func (s Strings) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, s.Valid, s.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (s *Strings) UnmarshalXMLAttr(attr xml.Attr) error {
+	return s.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Strings) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(s.Valid, s.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Strings) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.Strings", s)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s Strings) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *Strings) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Strings is null, a sequence otherwise.
+func (s Strings) MarshalYAML() (interface{}, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(s.Data, len(s.Data), s.Dims, "Strings")
+}
+
+// unmarshalYAML decodes this Strings with the unmarshal func of a YAML library.
+func (s *Strings) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.Strings", s)
+}
+
+// NullStrings is a nullable []String, mapping to a Postgres text[] column with NULL elements.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+type NullStrings struct {
+	Data  []String
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewNullStrings creates a new NullStrings.
+func NewNullStrings(data []String, valid bool) NullStrings {
+	return NullStrings{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullStringsFrom creates a new NullStrings that will always be valid.
+func NullStringsFrom(data []String) NullStrings {
+	return NewNullStrings(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal.
+func (s *NullStrings) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "NullStrings")
+	if err == nil && valid {
+		err = s.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		s.Data, s.Dims, s.Valid = nil, nil, false
+
+		return err
+	}
+
+	s.Dims, s.Valid = dims, true
+
+	return nil
+}
+
+func (s *NullStrings) fromArray(elems []arrayElement) error {
+	data := make([]String, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			continue
+		}
+
+		data[k].Valid = true
+
+		data[k].Data = e.text
+	}
+
+	s.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this NullStrings is null.
+func (s NullStrings) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, s.Dims, len(s.Data), "NullStrings", func(buf []byte, k int) []byte {
+		if !s.Data[k].Valid {
+			return append(buf, "NULL"...)
+		}
+
+		return appendArrayElement(buf, s.Data[k].Data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input, the elements can be null.
+func (s *NullStrings) UnmarshalJSON(data []byte) error {
+	var d []String
+
+	dims, valid, err := unmarshalJSONArray(data, "NullStrings", &d)
+	if err != nil || !valid {
+		s.Data, s.Dims, s.Valid = nil, nil, false
+
+		return err
+	}
+
+	s.Data, s.Dims, s.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullStrings is null, and an empty array if it is valid without elements.
+func (s NullStrings) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(s.Data, len(s.Data), s.Dims, "NullStrings")
+}
+
+// SetValid changes this NullStrings's value and also sets it to be non-null.
+func (s *NullStrings) SetValid(v []String) {
+	s.Data = v
+	s.Dims = nil
+	s.Valid = true
+}
+
+// IsZero returns true for null NullStrings, for potential future omitempty support.
+func (s NullStrings) IsZero() bool {
+	return !s.Valid
+}
+
+// Equal reports whether s and o are both null or both valid with the same elements and dimensions.
+func (s NullStrings) Equal(o NullStrings) bool {
+	return s.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if s is less than o, 0 if they are equal and +1 if s is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+// Null elements are ordered according to nulls too.
+func (s NullStrings) Compare(o NullStrings, nulls NullOrder) int {
+	if c, ok := compareNull(s.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(s.Data), len(o.Data), s.Dims, o.Dims, func(k int) int {
+		return s.Data[k].Compare(o.Data[k], nulls)
+	})
+}
+
+// ValueOr returns this NullStrings's elements, or def if this NullStrings is null.
+func (s NullStrings) ValueOr(def []String) []String {
+	if !s.Valid {
+		return def
+	}
+
+	return s.Data
+}
+
+// ValueOrZero returns this NullStrings's elements, or nil if this NullStrings is null.
+func (s NullStrings) ValueOrZero() []String {
+	if !s.Valid {
+		return nil
+	}
+
+	return s.Data
+}
+
+// OrElse returns this NullStrings's elements, or the result of fn if this NullStrings is null.
+// fn is only called when this NullStrings is null.
+func (s NullStrings) OrElse(fn func() []String) []String {
+	if !s.Valid {
+		return fn()
+	}
+
+	return s.Data
+}
+
+// Get returns this NullStrings's elements and true, or nil and false if this NullStrings is null.
+func (s NullStrings) Get() ([]String, bool) {
+	return s.ValueOrZero(), s.Valid
+}
+
+// MustGet returns this NullStrings's elements, it panics if this NullStrings is null.
+func (s NullStrings) MustGet() []String {
+	if !s.Valid {
+		panic("std: MustGet called on a null NullStrings")
+	}
+
+	return s.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this NullStrings is null.
+func (s NullStrings) MarshalText() ([]byte, error) {
+	return marshalArrayText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null NullStrings.
+func (s *NullStrings) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, s)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this NullStrings is null.
+func (s NullStrings) String() string {
+	text, _ := s.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this NullStrings is null, the array literal otherwise.
+func (s NullStrings) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, s.Valid, s.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null NullStrings, an empty element is a null NullStrings.
+func (s *NullStrings) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, s)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this NullStrings is null.
+func (s NullStrings) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, s.Valid, s.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (s *NullStrings) UnmarshalXMLAttr(attr xml.Attr) error {
+	return s.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s NullStrings) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(s.Valid, s.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *NullStrings) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.NullStrings", s)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s NullStrings) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (s *NullStrings) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this NullStrings is null, a sequence otherwise.
+func (s NullStrings) MarshalYAML() (interface{}, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(s.Data, len(s.Data), s.Dims, "NullStrings")
+}
+
+// unmarshalYAML decodes this NullStrings with the unmarshal func of a YAML library.
+func (s *NullStrings) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.NullStrings", s)
+}
@@ -0,0 +1,190 @@
+package std
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringsScan(t *testing.T) {
+	var s Strings
+
+	assert.NoError(t, s.Scan([]byte(`{a,"b c",""}`)))
+	assert.True(t, s.Valid)
+	assert.Equal(t, []string{"a", "b c", ""}, s.Data)
+
+	assert.NoError(t, s.Scan(`{}`))
+	assert.True(t, s.Valid)
+	assert.Equal(t, []string{}, s.Data)
+
+	assert.NoError(t, s.Scan(nil))
+	assert.False(t, s.Valid)
+	assert.Nil(t, s.Data)
+
+	assert.EqualError(t, s.Scan(`{a,NULL}`), "std: cannot scan a NULL element into std.Strings, use std.NullStrings")
+	assert.False(t, s.Valid)
+
+	assert.Error(t, s.Scan(`{{a},b}`))
+	assert.Error(t, s.Scan(int64(1)))
+}
+
+func TestStringsValue(t *testing.T) {
+	v, err := Strings{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = StringsFrom(nil).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, v)
+
+	v, err = StringsFrom([]string{"a", "b c", "", "NULL", `"{x}"`}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{a,"b c","","NULL","\"{x}\""}`, v)
+
+	var s Strings
+
+	assert.NoError(t, s.Scan(v))
+	assert.Equal(t, []string{"a", "b c", "", "NULL", `"{x}"`}, s.Data)
+}
+
+func TestStringsJSON(t *testing.T) {
+	var s Strings
+
+	assert.NoError(t, json.Unmarshal([]byte(`["a","b"]`), &s))
+	assert.True(t, s.Valid)
+	assert.Equal(t, []string{"a", "b"}, s.Data)
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `["a","b"]`, string(data))
+
+	assert.NoError(t, json.Unmarshal(nullJSON, &s))
+	assert.False(t, s.Valid)
+	assert.True(t, s.IsZero())
+
+	data, err = json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	data, err = json.Marshal(StringsFrom(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`"a"`), &s))
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &s))
+	assert.Error(t, json.Unmarshal(invalidJSON, &s))
+
+	s.SetValid([]string{"c"})
+	assert.False(t, s.IsZero())
+	assert.Equal(t, []string{"c"}, s.Data)
+}
+
+func TestNullStrings(t *testing.T) {
+	var s NullStrings
+
+	assert.NoError(t, s.Scan(`{a,NULL,"NULL"}`))
+	assert.True(t, s.Valid)
+	assert.Equal(t, []String{StringFrom("a"), {}, StringFrom("NULL")}, s.Data)
+
+	v, err := s.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{a,NULL,"NULL"}`, v)
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.Equal(t, `["a",null,"NULL"]`, string(data))
+
+	var u NullStrings
+
+	assert.NoError(t, json.Unmarshal(data, &u))
+	assert.Equal(t, s, u)
+
+	assert.NoError(t, u.Scan(nil))
+	assert.True(t, u.IsZero())
+
+	v, err = u.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	assert.NoError(t, json.Unmarshal(nullJSON, &u))
+	assert.False(t, u.Valid)
+
+	u.SetValid(nil)
+
+	data, err = json.Marshal(u)
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+}
+
+func TestStringsAccessors(t *testing.T) {
+	a := StringsFrom([]string{"a", "b"})
+	b := StringsFrom([]string{"a", "c"})
+
+	assert.Equal(t, -1, a.Compare(b, NullsFirst))
+	assert.Equal(t, 1, b.Compare(a, NullsFirst))
+	assert.Equal(t, 1, a.Compare(StringsFrom([]string{"a"}), NullsFirst))
+	assert.Equal(t, -1, Strings{}.Compare(a, NullsFirst))
+	assert.Equal(t, 1, Strings{}.Compare(a, NullsLast))
+
+	assert.True(t, a.Equal(StringsFrom([]string{"a", "b"})))
+	assert.False(t, a.Equal(Strings{Data: []string{"a", "b"}, Valid: true, Dims: []int{1, 2}}))
+	assert.False(t, a.Equal(Strings{}))
+	assert.True(t, Strings{}.Equal(Strings{}))
+
+	assert.Equal(t, a.Data, a.ValueOr(b.Data))
+	assert.Equal(t, b.Data, Strings{}.ValueOr(b.Data))
+	assert.Nil(t, Strings{}.ValueOrZero())
+	assert.Equal(t, b.Data, Strings{}.OrElse(func() []string { return b.Data }))
+
+	v, ok := a.Get()
+	assert.True(t, ok)
+	assert.Equal(t, a.Data, v)
+
+	_, ok = Strings{}.Get()
+	assert.False(t, ok)
+
+	assert.Equal(t, a.Data, a.MustGet())
+	assert.Panics(t, func() {
+		Strings{}.MustGet()
+	})
+
+	assert.Equal(t, `{a,b}`, a.String())
+	assert.Equal(t, ``, Strings{}.String())
+	assert.Equal(t, `{}`, StringsFrom(nil).String())
+
+	var s Strings
+
+	assert.NoError(t, s.UnmarshalText([]byte(`{"a b",c}`)))
+	assert.Equal(t, []string{"a b", "c"}, s.Data)
+
+	assert.NoError(t, s.UnmarshalText(nil))
+	assert.False(t, s.Valid)
+
+	n := NullStringsFrom([]String{StringFrom("a"), {}})
+
+	assert.Equal(t, 1, n.Compare(NullStringsFrom([]String{StringFrom("a"), StringFrom("b")}), NullsLast))
+	assert.Equal(t, -1, n.Compare(NullStringsFrom([]String{StringFrom("a"), StringFrom("b")}), NullsFirst))
+	assert.Equal(t, `{a,NULL}`, n.String())
+}
+
+func TestStringsEncodings(t *testing.T) {
+	for _, v := range []Strings{
+		StringsFrom([]string{"a", "b c", ""}),
+		{Data: []string{"a", "b", "c", "d"}, Valid: true, Dims: []int{2, 2}},
+		{},
+	} {
+		testTextEncodings(t, v, func() interface{} { return &Strings{} })
+	}
+
+	for _, v := range []NullStrings{NullStringsFrom([]String{StringFrom("a"), {}}), {}} {
+		testTextEncodings(t, v, func() interface{} { return &NullStrings{} })
+	}
+
+	y, err := Strings{Data: []string{"a", "b", "c", "d"}, Valid: true, Dims: []int{2, 2}}.MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{[]interface{}{"a", "b"}, []interface{}{"c", "d"}}, y)
+
+	_, err = Strings{Data: []string{"a"}, Valid: true, Dims: []int{2, 2}}.MarshalYAML()
+	assert.Error(t, err)
+}
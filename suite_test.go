@@ -0,0 +1,96 @@
+package std
+
+import (
+	"testing"
+
+	"github.com/euskadi31/go-std/stdtest"
+)
+
+func TestNullableSuite(t *testing.T) {
+	t.Run("Bool", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &Bool{} },
+			Value: true,
+			Scan: []stdtest.ScanCase{
+				{Src: int64(1), Want: true},
+				{Src: "false", Want: false},
+				{Src: float64(1), Err: true},
+			},
+		})
+	})
+
+	t.Run("Float", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &Float{} },
+			Value: 1.2345,
+			Scan: []stdtest.ScanCase{
+				{Src: int64(12345), Want: float64(12345)},
+				{Src: []byte("1.5"), Want: 1.5},
+				{Src: "test", Err: true},
+			},
+		})
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &Int{} },
+			Value: int64(12345),
+			Scan: []stdtest.ScanCase{
+				{Src: []byte("-12345"), Want: int64(-12345)},
+				{Src: float64(1.5), Err: true},
+			},
+		})
+	})
+
+	t.Run("Uint", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &Uint{} },
+			Value: uint64(12345),
+			Scan: []stdtest.ScanCase{
+				{Src: "12345", Want: int64(12345)},
+				{Src: int64(-1), Err: true},
+			},
+		})
+	})
+
+	t.Run("String", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &String{} },
+			Value: "test",
+			Scan: []stdtest.ScanCase{
+				{Src: []byte("test"), Want: "test"},
+				{Src: int64(12345), Want: "12345"},
+				{Src: timeValue, Want: timeString},
+			},
+		})
+	})
+
+	timeCases := []stdtest.ScanCase{
+		{Src: timeValue, Want: timeValue},
+		{Src: timeString, Err: true},
+	}
+
+	t.Run("Time", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &Time{} },
+			Value: timeValue,
+			Scan:  timeCases,
+		})
+	})
+
+	t.Run("DateTime", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &DateTime{} },
+			Value: dateTimeValue,
+			Scan:  timeCases,
+		})
+	})
+
+	t.Run("Date", func(t *testing.T) {
+		stdtest.RunNullableSuite(t, stdtest.Factory{
+			New:   func() stdtest.Nullable { return &Date{} },
+			Value: dateValue,
+			Scan:  timeCases,
+		})
+	})
+}
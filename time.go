@@ -2,9 +2,7 @@ package std
 
 import (
 	"database/sql/driver"
-	"encoding/json"
 	"fmt"
-	"reflect"
 	"time"
 )
 
@@ -69,47 +67,47 @@ func TimeFromPtr(t *time.Time) Time {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this time is null.
 func (t Time) MarshalJSON() ([]byte, error) {
+	return t.AppendJSON(make([]byte, 0, len(time.RFC3339Nano)+2))
+}
+
+// AppendJSON appends the JSON encoding of this Time to b, null if this Time is null.
+func (t Time) AppendJSON(b []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte("null"), nil
+		return append(b, nullType...), nil
+	}
+
+	b = append(b, '"')
+
+	b, err := appendTime(b, t.Time)
+	if err != nil {
+		return b, err
 	}
 
-	return t.Time.MarshalJSON() // nolint: wrapcheck
+	return append(b, '"'), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports string, object (e.g. pq.NullTime and friends)
+// It supports string, object (e.g. sql.NullTime and friends)
 // and null input.
 func (t *Time) UnmarshalJSON(data []byte) error {
-	var (
-		err error
-		v   interface{}
-	)
-
-	if err = json.Unmarshal(data, &v); err != nil {
+	kind, err := jsonKindOf(data)
+	if err != nil {
 		return fmt.Errorf("json: %w", err)
 	}
 
-	switch x := v.(type) {
-	case string:
+	switch kind {
+	case jsonString:
 		err = t.Time.UnmarshalJSON(data)
-	case map[string]interface{}:
-		ti, tiOK := x["Time"].(string)
-		valid, validOK := x["Valid"].(bool)
-
-		if !tiOK || !validOK {
-			return fmt.Errorf(`json: unmarshalling object into Go value of type null.Time requires key "Time" to be of type string and key "Valid" to be of type bool; found %T and %T, respectively`, x["Time"], x["Valid"])
-		}
+	case jsonObject:
+		t.Valid, err = unmarshalNullObject(data, "Time", "null.Time", &t.Time)
 
-		err = t.Time.UnmarshalText([]byte(ti))
-		t.Valid = valid
-
-		return err // nolint: wrapcheck
-	case nil:
+		return err
+	case jsonNull:
 		t.Valid = false
 
 		return nil
 	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Time", reflect.TypeOf(v).Name())
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Time", kind)
 	}
 
 	t.Valid = err == nil
@@ -119,11 +117,17 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 
 // MarshalText implements TextMarshaler.
 func (t Time) MarshalText() ([]byte, error) {
+	return t.AppendText(make([]byte, 0, len(time.RFC3339Nano)))
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends null if this Time is null.
+func (t Time) AppendText(b []byte) ([]byte, error) {
 	if !t.Valid {
-		return []byte("null"), nil
+		return append(b, nullType...), nil
 	}
 
-	return t.Time.MarshalText() // nolint: wrapcheck
+	return appendTime(b, t.Time)
 }
 
 // UnmarshalText implements TextUnmarshaler.
@@ -159,3 +163,119 @@ func (t Time) Ptr() *time.Time {
 
 	return &t.Time
 }
+
+// IsZero returns true for null Times, for potential future omitempty support.
+// A non-null Time with a zero value will not be considered zero.
+func (t Time) IsZero() bool {
+	return !t.Valid
+}
+
+// String implements fmt.Stringer interface.
+func (t Time) String() string {
+	if !t.Valid {
+		return ""
+	}
+
+	return t.Time.Format(time.RFC3339Nano)
+}
+
+// Equal reports whether t and o are both null or both valid with the same time instant.
+func (t Time) Equal(o Time) bool {
+	return t.Valid == o.Valid && (!t.Valid || t.Time.Equal(o.Time))
+}
+
+// Compare returns -1 if t is before o, 0 if they are the same time instant and +1 if t is after o.
+// Null values are ordered according to nulls.
+func (t Time) Compare(o Time, nulls NullOrder) int {
+	if c, ok := compareNull(t.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareTime(t.Time, o.Time)
+}
+
+// ValueOr returns this Time's value, or def if this Time is null.
+func (t Time) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+
+	return t.Time
+}
+
+// ValueOrZero returns this Time's value, or the zero value of time.Time if this Time is null.
+func (t Time) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Time
+}
+
+// OrElse returns this Time's value, or the result of fn if this Time is null.
+// fn is only called when this Time is null.
+func (t Time) OrElse(fn func() time.Time) time.Time {
+	if !t.Valid {
+		return fn()
+	}
+
+	return t.Time
+}
+
+// Get returns this Time's value and true, or the zero value of time.Time and false if this Time is null.
+func (t Time) Get() (time.Time, bool) {
+	return t.ValueOrZero(), t.Valid
+}
+
+// MustGet returns this Time's value, it panics if this Time is null.
+func (t Time) MustGet() time.Time {
+	if !t.Valid {
+		panic("std: MustGet called on a null Time")
+	}
+
+	return t.Time
+}
+
+// Map returns a Time holding the result of fn applied to this Time's value,
+// or a null Time if this Time is null. fn is only called when this Time is valid.
+func (t Time) Map(fn func(time.Time) time.Time) Time {
+	if !t.Valid {
+		return Time{}
+	}
+
+	return NewTime(fn(t.Time), true)
+}
+
+// FlatMap returns the result of fn applied to this Time's value,
+// or a null Time if this Time is null. fn is only called when this Time is valid.
+func (t Time) FlatMap(fn func(time.Time) Time) Time {
+	if !t.Valid {
+		return Time{}
+	}
+
+	return fn(t.Time)
+}
+
+// Filter returns this Time if it is valid and its value satisfies fn,
+// otherwise it returns a null Time.
+func (t Time) Filter(fn func(time.Time) bool) Time {
+	if !t.Valid || !fn(t.Time) {
+		return Time{}
+	}
+
+	return t
+}
+
+// ToDateTime converts this Time to a DateTime holding the same instant.
+func (t Time) ToDateTime() DateTime {
+	return NewDateTime(t.Time, t.Valid)
+}
+
+// ToDate converts this Time to a Date, dropping the time of day in the location of this Time.
+func (t Time) ToDate() Date {
+	if !t.Valid {
+		return Date{}
+	}
+
+	return NewDate(truncateDay(t.Time, t.Time.Location()), true)
+}
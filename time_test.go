@@ -178,3 +178,132 @@ func assertNullTime(t *testing.T, ti Time, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestTimeIsZero(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	assert.False(t, ti.IsZero())
+
+	zero := TimeFrom(time.Time{})
+	assert.False(t, zero.IsZero())
+
+	null := Time{}
+	assert.True(t, null.IsZero())
+}
+
+func TestTimeString(t *testing.T) {
+	ti := TimeFrom(timeValue)
+	assert.Equal(t, timeString, ti.String())
+
+	null := Time{}
+	assert.Equal(t, "", null.String())
+}
+
+func TestTimeEqual(t *testing.T) {
+	paris := time.FixedZone("Europe/Paris", 3600)
+
+	assert.True(t, TimeFrom(timeValue).Equal(TimeFrom(timeValue)))
+	assert.True(t, TimeFrom(timeValue).Equal(TimeFrom(timeValue.In(paris))))
+	assert.True(t, Time{}.Equal(NewTime(timeValue, false)))
+	assert.False(t, TimeFrom(timeValue).Equal(TimeFrom(timeValue.Add(time.Hour))))
+	assert.False(t, TimeFrom(timeValue).Equal(Time{}))
+}
+
+func TestTimeCompare(t *testing.T) {
+	later := timeValue.Add(time.Hour)
+
+	assert.Equal(t, -1, TimeFrom(timeValue).Compare(TimeFrom(later), NullsFirst))
+	assert.Equal(t, 1, TimeFrom(later).Compare(TimeFrom(timeValue), NullsFirst))
+	assert.Equal(t, 0, TimeFrom(timeValue).Compare(TimeFrom(timeValue.UTC()), NullsFirst))
+	assert.Equal(t, -1, Time{}.Compare(TimeFrom(timeValue), NullsFirst))
+	assert.Equal(t, 1, Time{}.Compare(TimeFrom(timeValue), NullsLast))
+}
+
+func TestTimeValueOr(t *testing.T) {
+	valid := TimeFrom(timeValue)
+	assert.Equal(t, timeValue, valid.ValueOr(timeValue.Add(time.Hour)))
+	assert.Equal(t, timeValue, valid.ValueOrZero())
+
+	null := Time{}
+	assert.Equal(t, timeValue.Add(time.Hour), null.ValueOr(timeValue.Add(time.Hour)))
+	assert.Equal(t, time.Time{}, null.ValueOrZero())
+}
+
+func TestTimeOrElse(t *testing.T) {
+	called := false
+	fallback := func() time.Time {
+		called = true
+
+		return timeValue.Add(time.Hour)
+	}
+
+	valid := TimeFrom(timeValue)
+	assert.Equal(t, timeValue, valid.OrElse(fallback))
+	assert.False(t, called)
+
+	null := Time{}
+	assert.Equal(t, timeValue.Add(time.Hour), null.OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestTimeGet(t *testing.T) {
+	v, ok := TimeFrom(timeValue).Get()
+	assert.True(t, ok)
+	assert.Equal(t, timeValue, v)
+
+	v, ok = Time{}.Get()
+	assert.False(t, ok)
+	assert.Equal(t, time.Time{}, v)
+}
+
+func TestTimeMustGet(t *testing.T) {
+	assert.Equal(t, timeValue, TimeFrom(timeValue).MustGet())
+
+	assert.Panics(t, func() {
+		Time{}.MustGet()
+	})
+}
+
+func TestTimeMap(t *testing.T) {
+	mapped := TimeFrom(timeValue).Map(func(v time.Time) time.Time { return v.Add(time.Hour) })
+	assert.True(t, mapped.Valid)
+	assert.Equal(t, timeValue.Add(time.Hour), mapped.ValueOrZero())
+
+	called := false
+	null := Time{}.Map(func(v time.Time) time.Time {
+		called = true
+
+		return v
+	})
+	assert.False(t, null.Valid)
+	assert.False(t, called)
+}
+
+func TestTimeFlatMap(t *testing.T) {
+	toNull := func(v time.Time) Time {
+		return Time{}
+	}
+
+	assert.False(t, TimeFrom(timeValue).FlatMap(toNull).Valid)
+	assert.Equal(t, TimeFrom(timeValue), TimeFrom(timeValue).FlatMap(func(v time.Time) Time {
+		return TimeFrom(v)
+	}))
+	assert.False(t, Time{}.FlatMap(func(v time.Time) Time {
+		return TimeFrom(timeValue)
+	}).Valid)
+}
+
+func TestTimeFilter(t *testing.T) {
+	assert.Equal(t, TimeFrom(timeValue), TimeFrom(timeValue).Filter(func(v time.Time) bool { return !v.IsZero() }))
+	assert.False(t, TimeFrom(timeValue).Filter(func(v time.Time) bool { return false }).Valid)
+	assert.False(t, Time{}.Filter(func(v time.Time) bool { return true }).Valid)
+}
+
+func TestTimeToDateTime(t *testing.T) {
+	assert.Equal(t, DateTimeFrom(timeValue), TimeFrom(timeValue).ToDateTime())
+	assert.False(t, Time{}.ToDateTime().Valid)
+}
+
+func TestTimeToDate(t *testing.T) {
+	assert.Equal(t, DateFrom(dateValue), TimeFrom(timeValue).ToDate())
+	assert.False(t, Time{}.ToDate().Valid)
+}
@@ -0,0 +1,527 @@
+package std
+
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"time"
+)
+
+// Times is a nullable []time.Time, mapping to a Postgres timestamptz[] column.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+// The elements can not be NULL, use NullTimes for arrays with NULL elements.
+type Times struct {
+	Data  []time.Time
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewTimes creates a new Times.
+func NewTimes(data []time.Time, valid bool) Times {
+	return Times{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// TimesFrom creates a new Times that will always be valid.
+func TimesFrom(data []time.Time) Times {
+	return NewTimes(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal, a NULL element is an error.
+func (t *Times) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "Times")
+	if err == nil && valid {
+		err = t.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		t.Data, t.Dims, t.Valid = nil, nil, false
+
+		return err
+	}
+
+	t.Dims, t.Valid = dims, true
+
+	return nil
+}
+
+func (t *Times) fromArray(elems []arrayElement) error {
+	var err error
+
+	data := make([]time.Time, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			return errArrayNull("Times")
+		}
+
+		if data[k], err = parseArrayTime(e.text); err != nil {
+			return errArrayElement(e.text, "Times", err)
+		}
+	}
+
+	t.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this Times is null.
+func (t Times) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, t.Dims, len(t.Data), "Times", func(buf []byte, k int) []byte {
+		return t.Data[k].AppendFormat(buf, time.RFC3339Nano)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input.
+func (t *Times) UnmarshalJSON(data []byte) error {
+	var d []time.Time
+
+	dims, valid, err := unmarshalJSONArray(data, "Times", &d)
+	if err != nil || !valid {
+		t.Data, t.Dims, t.Valid = nil, nil, false
+
+		return err
+	}
+
+	t.Data, t.Dims, t.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Times is null, and an empty array if it is valid without elements.
+func (t Times) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(t.Data, len(t.Data), t.Dims, "Times")
+}
+
+// SetValid changes this Times's value and also sets it to be non-null.
+func (t *Times) SetValid(v []time.Time) {
+	t.Data = v
+	t.Dims = nil
+	t.Valid = true
+}
+
+// IsZero returns true for null Times, for potential future omitempty support.
+func (t Times) IsZero() bool {
+	return !t.Valid
+}
+
+// Equal reports whether t and o are both null or both valid with the same elements and dimensions.
+func (t Times) Equal(o Times) bool {
+	return t.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if t is less than o, 0 if they are equal and +1 if t is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+func (t Times) Compare(o Times, nulls NullOrder) int {
+	if c, ok := compareNull(t.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(t.Data), len(o.Data), t.Dims, o.Dims, func(k int) int {
+		return TimeFrom(t.Data[k]).Compare(TimeFrom(o.Data[k]), nulls)
+	})
+}
+
+// ValueOr returns this Times's elements, or def if this Times is null.
+func (t Times) ValueOr(def []time.Time) []time.Time {
+	if !t.Valid {
+		return def
+	}
+
+	return t.Data
+}
+
+// ValueOrZero returns this Times's elements, or nil if this Times is null.
+func (t Times) ValueOrZero() []time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return t.Data
+}
+
+// OrElse returns this Times's elements, or the result of fn if this Times is null.
+// fn is only called when this Times is null.
+func (t Times) OrElse(fn func() []time.Time) []time.Time {
+	if !t.Valid {
+		return fn()
+	}
+
+	return t.Data
+}
+
+// Get returns this Times's elements and true, or nil and false if this Times is null.
+func (t Times) Get() ([]time.Time, bool) {
+	return t.ValueOrZero(), t.Valid
+}
+
+// MustGet returns this Times's elements, it panics if this Times is null.
+func (t Times) MustGet() []time.Time {
+	if !t.Valid {
+		panic("std: MustGet called on a null Times")
+	}
+
+	return t.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this Times is null.
+func (t Times) MarshalText() ([]byte, error) {
+	return marshalArrayText(t)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null Times.
+func (t *Times) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, t)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this Times is null.
+func (t Times) String() string {
+	text, _ := t.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Times is null, the array literal otherwise.
+func (t Times) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Times, an empty element is a null Times.
+func (t *Times) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, t)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Times is null.
+func (t Times) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *Times) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t Times) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(t.Valid, t.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *Times) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.Times", t)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (t Times) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (t *Times) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Times is null, a sequence otherwise.
+func (t Times) MarshalYAML() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(t.Data, len(t.Data), t.Dims, "Times")
+}
+
+// unmarshalYAML decodes this Times with the unmarshal func of a YAML library.
+func (t *Times) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.Times", t)
+}
+
+// NullTimes is a nullable []Time, mapping to a Postgres timestamptz[] column with NULL elements.
+// It scans from and writes the Postgres array literal syntax, and marshals to a JSON array or null.
+type NullTimes struct {
+	Data  []Time
+	Valid bool
+	// Dims are the dimensions of a multi-dimensional array, whose elements are stored in Data
+	// in row-major order. They are nil for one-dimensional arrays.
+	Dims []int
+}
+
+// NewNullTimes creates a new NullTimes.
+func NewNullTimes(data []Time, valid bool) NullTimes {
+	return NullTimes{
+		Data:  data,
+		Valid: valid,
+	}
+}
+
+// NullTimesFrom creates a new NullTimes that will always be valid.
+func NullTimesFrom(data []Time) NullTimes {
+	return NewNullTimes(data, true)
+}
+
+// Scan implements the Scanner interface.
+// It parses an array literal.
+func (t *NullTimes) Scan(value interface{}) error {
+	elems, dims, valid, err := scanArray(value, "NullTimes")
+	if err == nil && valid {
+		err = t.fromArray(elems)
+	}
+
+	if err != nil || !valid {
+		t.Data, t.Dims, t.Valid = nil, nil, false
+
+		return err
+	}
+
+	t.Dims, t.Valid = dims, true
+
+	return nil
+}
+
+func (t *NullTimes) fromArray(elems []arrayElement) error {
+	var err error
+
+	data := make([]Time, len(elems))
+
+	for k, e := range elems {
+		if e.null {
+			continue
+		}
+
+		data[k].Valid = true
+
+		if data[k].Time, err = parseArrayTime(e.text); err != nil {
+			return errArrayElement(e.text, "NullTimes", err)
+		}
+	}
+
+	t.Data = data
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+// It returns the array literal, or nil if this NullTimes is null.
+func (t NullTimes) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	text, err := appendArray(nil, t.Dims, len(t.Data), "NullTimes", func(buf []byte, k int) []byte {
+		if !t.Data[k].Valid {
+			return append(buf, "NULL"...)
+		}
+
+		return t.Data[k].Time.AppendFormat(buf, time.RFC3339Nano)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports array and null input, the elements can be null.
+func (t *NullTimes) UnmarshalJSON(data []byte) error {
+	var d []Time
+
+	dims, valid, err := unmarshalJSONArray(data, "NullTimes", &d)
+	if err != nil || !valid {
+		t.Data, t.Dims, t.Valid = nil, nil, false
+
+		return err
+	}
+
+	t.Data, t.Dims, t.Valid = d, dims, true
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this NullTimes is null, and an empty array if it is valid without elements.
+func (t NullTimes) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+
+	return marshalJSONArray(t.Data, len(t.Data), t.Dims, "NullTimes")
+}
+
+// SetValid changes this NullTimes's value and also sets it to be non-null.
+func (t *NullTimes) SetValid(v []Time) {
+	t.Data = v
+	t.Dims = nil
+	t.Valid = true
+}
+
+// IsZero returns true for null NullTimes, for potential future omitempty support.
+func (t NullTimes) IsZero() bool {
+	return !t.Valid
+}
+
+// Equal reports whether t and o are both null or both valid with the same elements and dimensions.
+func (t NullTimes) Equal(o NullTimes) bool {
+	return t.Compare(o, NullsFirst) == 0
+}
+
+// Compare returns -1 if t is less than o, 0 if they are equal and +1 if t is greater than o,
+// comparing the elements in order, then the number of elements and the dimensions, like Postgres does.
+// Null values are ordered according to nulls.
+// Null elements are ordered according to nulls too.
+func (t NullTimes) Compare(o NullTimes, nulls NullOrder) int {
+	if c, ok := compareNull(t.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return compareArrays(len(t.Data), len(o.Data), t.Dims, o.Dims, func(k int) int {
+		return t.Data[k].Compare(o.Data[k], nulls)
+	})
+}
+
+// ValueOr returns this NullTimes's elements, or def if this NullTimes is null.
+func (t NullTimes) ValueOr(def []Time) []Time {
+	if !t.Valid {
+		return def
+	}
+
+	return t.Data
+}
+
+// ValueOrZero returns this NullTimes's elements, or nil if this NullTimes is null.
+func (t NullTimes) ValueOrZero() []Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return t.Data
+}
+
+// OrElse returns this NullTimes's elements, or the result of fn if this NullTimes is null.
+// fn is only called when this NullTimes is null.
+func (t NullTimes) OrElse(fn func() []Time) []Time {
+	if !t.Valid {
+		return fn()
+	}
+
+	return t.Data
+}
+
+// Get returns this NullTimes's elements and true, or nil and false if this NullTimes is null.
+func (t NullTimes) Get() ([]Time, bool) {
+	return t.ValueOrZero(), t.Valid
+}
+
+// MustGet returns this NullTimes's elements, it panics if this NullTimes is null.
+func (t NullTimes) MustGet() []Time {
+	if !t.Valid {
+		panic("std: MustGet called on a null NullTimes")
+	}
+
+	return t.Data
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the array literal, or a blank string when this NullTimes is null.
+func (t NullTimes) MarshalText() ([]byte, error) {
+	return marshalArrayText(t)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It parses an array literal, a blank string is a null NullTimes.
+func (t *NullTimes) UnmarshalText(text []byte) error {
+	return unmarshalArrayText(text, t)
+}
+
+// String implements fmt.Stringer interface.
+// It returns the array literal, or a blank string if this NullTimes is null.
+func (t NullTimes) String() string {
+	text, _ := t.MarshalText()
+
+	return string(text)
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this NullTimes is null, the array literal otherwise.
+func (t NullTimes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null NullTimes, an empty element is a null NullTimes.
+func (t *NullTimes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, t)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this NullTimes is null.
+func (t NullTimes) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *NullTimes) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (t NullTimes) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(t.Valid, t.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *NullTimes) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.NullTimes", t)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (t NullTimes) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (t *NullTimes) GobDecode(data []byte) error {
+	return t.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this NullTimes is null, a sequence otherwise.
+func (t NullTimes) MarshalYAML() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	return marshalYAMLArray(t.Data, len(t.Data), t.Dims, "NullTimes")
+}
+
+// unmarshalYAML decodes this NullTimes with the unmarshal func of a YAML library.
+func (t *NullTimes) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLCollection(unmarshal, "std.NullTimes", t)
+}
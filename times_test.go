@@ -0,0 +1,85 @@
+package std
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimes(t *testing.T) {
+	var ts Times
+
+	assert.NoError(t, ts.Scan([]byte(`{"2012-12-21 21:21:21+00","2012-12-21 22:21:21.5+01:00","2012-12-21 21:21:21","2012-12-21T21:21:21Z"}`)))
+	assert.True(t, ts.Valid)
+	assert.Len(t, ts.Data, 4)
+
+	expected := time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+
+	assert.True(t, expected.Equal(ts.Data[0]))
+	assert.True(t, expected.Add(500*time.Millisecond).Equal(ts.Data[1]))
+	assert.True(t, expected.Equal(ts.Data[2]))
+	assert.True(t, expected.Equal(ts.Data[3]))
+
+	v, err := TimesFrom([]time.Time{expected}).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{2012-12-21T21:21:21Z}`, v)
+
+	assert.Error(t, ts.Scan(`{2012-12-21}`))
+	assert.False(t, ts.Valid)
+
+	data, err := json.Marshal(TimesFrom([]time.Time{expected}))
+	assert.NoError(t, err)
+	assert.Equal(t, `["2012-12-21T21:21:21Z"]`, string(data))
+
+	assert.NoError(t, json.Unmarshal(data, &ts))
+	assert.True(t, expected.Equal(ts.Data[0]))
+}
+
+func TestNullTimes(t *testing.T) {
+	var ts NullTimes
+
+	assert.NoError(t, ts.Scan(`{NULL,"2012-12-21 21:21:21+00"}`))
+	assert.False(t, ts.Data[0].Valid)
+	assert.True(t, ts.Data[1].Valid)
+
+	v, err := ts.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{NULL,2012-12-21T21:21:21Z}`, v)
+
+	data, err := json.Marshal(ts)
+	assert.NoError(t, err)
+	assert.Equal(t, `[null,"2012-12-21T21:21:21Z"]`, string(data))
+}
+
+func TestTimesAccessors(t *testing.T) {
+	t1 := time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	a := TimesFrom([]time.Time{t1, t2})
+
+	assert.True(t, a.Equal(TimesFrom([]time.Time{t1.In(time.FixedZone("", 3600)), t2})))
+	assert.Equal(t, 1, a.Compare(TimesFrom([]time.Time{t1, t1}), NullsFirst))
+
+	n := NullTimesFrom([]Time{{}, TimeFrom(t1)})
+	assert.Equal(t, 1, n.Compare(NullTimesFrom([]Time{TimeFrom(t1)}), NullsLast))
+}
+
+func TestTimesEncodings(t *testing.T) {
+	var ts Times
+
+	assert.NoError(t, ts.Scan(`{"2012-12-21 21:21:21+00","2012-12-22 21:21:21.5+00"}`))
+
+	for _, v := range []Times{ts, {}} {
+		testTextEncodings(t, v, func() interface{} { return &Times{} })
+	}
+
+	var ns NullTimes
+
+	assert.NoError(t, ns.Scan(`{"2012-12-21 21:21:21+00",NULL}`))
+
+	for _, v := range []NullTimes{ns, {}} {
+		testTextEncodings(t, v, func() interface{} { return &NullTimes{} })
+	}
+}
@@ -3,8 +3,9 @@ package std
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"reflect"
+	"math"
 	"strconv"
 )
 
@@ -46,49 +47,60 @@ func (i *Uint) Scan(value interface{}) error {
 		return nil
 	}
 
-	i.Valid = true
+	err := scanUint64(&i.Data, value)
+	i.Valid = err == nil
 
-	return convertAssign(&i.Data, value)
+	return err
 }
 
 // Value implements the driver Valuer interface.
+// The value is returned as an int64, uint64 is not a driver.Value:
+// like database/sql, it fails if the value has the high bit set.
 func (i Uint) Value() (driver.Value, error) {
 	if !i.Valid {
 		return nil, nil
 	}
 
-	return i.Data, nil
+	if i.Data > math.MaxInt64 {
+		return nil, errors.New("uint64 values with high bit set are not supported")
+	}
+
+	return int64(i.Data), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will not be considered a null Uint.
-// It also supports unmarshalling a sql.NullInt64.
+// It also supports unmarshalling a sql.NullInt64 or a sql.Null[uint64].
 func (i *Uint) UnmarshalJSON(data []byte) error {
-	var (
-		err error
-		v   interface{}
-	)
-
-	if err = json.Unmarshal(data, &v); err != nil {
+	kind, err := jsonKindOf(data)
+	if err != nil {
 		return fmt.Errorf("json: cannot unmarshal %s into Go value of type null.Uint: %w", string(data), err)
 	}
 
-	switch v.(type) {
-	case float64:
-		// Unmarshal again, directly to int64, to avoid intermediate float64
-		err = json.Unmarshal(data, &i.Data)
-	case nil:
+	switch kind {
+	case jsonNumber:
+		if n, perr := strconv.ParseUint(string(data), 10, 64); perr == nil {
+			i.Data = n
+		} else {
+			// Let encoding/json report non-integer, negative and out of range numbers
+			err = json.Unmarshal(data, &i.Data)
+		}
+	case jsonObject:
+		i.Valid, err = unmarshalNullObject(data, "Int64", "null.Uint", &i.Data)
+
+		return err
+	case jsonNull:
 		i.Valid = false
 
 		return nil
 	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint", reflect.TypeOf(v).Name())
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint", kind)
 	}
 
 	i.Valid = err == nil
 
-	return err
+	return err // nolint: wrapcheck
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -114,21 +126,32 @@ func (i *Uint) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 // It will encode null if this Uint is null.
 func (i Uint) MarshalJSON() ([]byte, error) {
+	return i.AppendJSON(make([]byte, 0, 20))
+}
+
+// AppendJSON appends the JSON encoding of this Uint to b, null if this Uint is null.
+func (i Uint) AppendJSON(b []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte("null"), nil
+		return append(b, nullType...), nil
 	}
 
-	return []byte(strconv.FormatUint(i.Data, 10)), nil
+	return strconv.AppendUint(b, i.Data, 10), nil
 }
 
 // MarshalText implements encoding.TextMarshaler.
 // It will encode a blank string if this Uint is null.
 func (i Uint) MarshalText() ([]byte, error) {
+	return i.AppendText(make([]byte, 0, 20))
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends nothing if this Uint is null.
+func (i Uint) AppendText(b []byte) ([]byte, error) {
 	if !i.Valid {
-		return []byte{}, nil
+		return b, nil
 	}
 
-	return []byte(strconv.FormatUint(i.Data, 10)), nil
+	return strconv.AppendUint(b, i.Data, 10), nil
 }
 
 // SetValid changes this Uint's value and also sets it to be non-null.
@@ -160,3 +183,137 @@ func (i Uint) String() string {
 
 	return strconv.FormatUint(i.Data, 10)
 }
+
+// Equal reports whether i and o are both null or both valid with the same value.
+func (i Uint) Equal(o Uint) bool {
+	return i.Valid == o.Valid && (!i.Valid || i.Data == o.Data)
+}
+
+// Compare returns -1 if i is less than o, 0 if they are equal and +1 if i is greater than o.
+// Null values are ordered according to nulls.
+func (i Uint) Compare(o Uint, nulls NullOrder) int {
+	if c, ok := compareNull(i.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	switch {
+	case i.Data < o.Data:
+		return -1
+	case i.Data > o.Data:
+		return 1
+	}
+
+	return 0
+}
+
+// ValueOr returns this Uint's value, or def if this Uint is null.
+func (i Uint) ValueOr(def uint64) uint64 {
+	if !i.Valid {
+		return def
+	}
+
+	return i.Data
+}
+
+// ValueOrZero returns this Uint's value, or the zero value of uint64 if this Uint is null.
+func (i Uint) ValueOrZero() uint64 {
+	if !i.Valid {
+		return 0
+	}
+
+	return i.Data
+}
+
+// OrElse returns this Uint's value, or the result of fn if this Uint is null.
+// fn is only called when this Uint is null.
+func (i Uint) OrElse(fn func() uint64) uint64 {
+	if !i.Valid {
+		return fn()
+	}
+
+	return i.Data
+}
+
+// Get returns this Uint's value and true, or the zero value of uint64 and false if this Uint is null.
+func (i Uint) Get() (uint64, bool) {
+	return i.ValueOrZero(), i.Valid
+}
+
+// MustGet returns this Uint's value, it panics if this Uint is null.
+func (i Uint) MustGet() uint64 {
+	if !i.Valid {
+		panic("std: MustGet called on a null Uint")
+	}
+
+	return i.Data
+}
+
+// Map returns a Uint holding the result of fn applied to this Uint's value,
+// or a null Uint if this Uint is null. fn is only called when this Uint is valid.
+func (i Uint) Map(fn func(uint64) uint64) Uint {
+	if !i.Valid {
+		return Uint{}
+	}
+
+	return NewUint(fn(i.Data), true)
+}
+
+// FlatMap returns the result of fn applied to this Uint's value,
+// or a null Uint if this Uint is null. fn is only called when this Uint is valid.
+func (i Uint) FlatMap(fn func(uint64) Uint) Uint {
+	if !i.Valid {
+		return Uint{}
+	}
+
+	return fn(i.Data)
+}
+
+// Filter returns this Uint if it is valid and its value satisfies fn,
+// otherwise it returns a null Uint.
+func (i Uint) Filter(fn func(uint64) bool) Uint {
+	if !i.Valid || !fn(i.Data) {
+		return Uint{}
+	}
+
+	return i
+}
+
+// ToFloat converts this Uint to a Float.
+// It returns ErrLossyConversion if the value cannot be represented exactly by a float64.
+func (i Uint) ToFloat() (Float, error) {
+	if !i.Valid {
+		return Float{}, nil
+	}
+
+	f := float64(i.Data)
+
+	// MaxUint64 rounds to 2^64, which is out of the uint64 range
+	if v, err := floatToUint(f); err != nil || v != i.Data {
+		return Float{}, lossyErr(i.Data, "float64")
+	}
+
+	return FloatFrom(f), nil
+}
+
+// ToInt converts this Uint to an Int.
+// It returns ErrLossyConversion if the value overflows an int64.
+func (i Uint) ToInt() (Int, error) {
+	if !i.Valid {
+		return Int{}, nil
+	}
+
+	if i.Data > math.MaxInt64 {
+		return Int{}, lossyErr(i.Data, "int64")
+	}
+
+	return IntFrom(int64(i.Data)), nil
+}
+
+// ToString converts this Uint to a String holding its decimal representation.
+func (i Uint) ToString() String {
+	if !i.Valid {
+		return String{}
+	}
+
+	return StringFrom(strconv.FormatUint(i.Data, 10))
+}
@@ -187,7 +187,10 @@ func TestUintValue(t *testing.T) {
 
 	v, err := i.Value()
 	assert.NoError(t, err)
-	assert.Equal(t, uint64(12345), v.(uint64))
+	assert.Equal(t, int64(12345), v.(int64))
+
+	_, err = UintFrom(math.MaxUint64).Value()
+	assert.EqualError(t, err, "uint64 values with high bit set are not supported")
 
 	null := Uint{}
 
@@ -210,3 +213,136 @@ func assertNullUint(t *testing.T, i Uint, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestUintEqual(t *testing.T) {
+	assert.True(t, UintFrom(12345).Equal(UintFrom(12345)))
+	assert.True(t, Uint{}.Equal(NewUint(12345, false)))
+	assert.False(t, UintFrom(12345).Equal(UintFrom(0)))
+	assert.False(t, UintFrom(0).Equal(Uint{}))
+}
+
+func TestUintCompare(t *testing.T) {
+	assert.Equal(t, -1, UintFrom(1).Compare(UintFrom(2), NullsFirst))
+	assert.Equal(t, 1, UintFrom(2).Compare(UintFrom(1), NullsFirst))
+	assert.Equal(t, 0, UintFrom(2).Compare(UintFrom(2), NullsFirst))
+	assert.Equal(t, -1, Uint{}.Compare(UintFrom(1), NullsFirst))
+	assert.Equal(t, 1, Uint{}.Compare(UintFrom(1), NullsLast))
+}
+
+func TestUintValueOr(t *testing.T) {
+	valid := UintFrom(uint64(12345))
+	assert.Equal(t, uint64(12345), valid.ValueOr(uint64(42)))
+	assert.Equal(t, uint64(12345), valid.ValueOrZero())
+
+	null := Uint{}
+	assert.Equal(t, uint64(42), null.ValueOr(uint64(42)))
+	assert.Equal(t, uint64(0), null.ValueOrZero())
+}
+
+func TestUintOrElse(t *testing.T) {
+	called := false
+	fallback := func() uint64 {
+		called = true
+
+		return uint64(42)
+	}
+
+	valid := UintFrom(uint64(12345))
+	assert.Equal(t, uint64(12345), valid.OrElse(fallback))
+	assert.False(t, called)
+
+	null := Uint{}
+	assert.Equal(t, uint64(42), null.OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestUintGet(t *testing.T) {
+	v, ok := UintFrom(uint64(12345)).Get()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(12345), v)
+
+	v, ok = Uint{}.Get()
+	assert.False(t, ok)
+	assert.Equal(t, uint64(0), v)
+}
+
+func TestUintMustGet(t *testing.T) {
+	assert.Equal(t, uint64(12345), UintFrom(uint64(12345)).MustGet())
+
+	assert.Panics(t, func() {
+		Uint{}.MustGet()
+	})
+}
+
+func TestUintMap(t *testing.T) {
+	mapped := UintFrom(uint64(12345)).Map(func(v uint64) uint64 { return v * 2 })
+	assert.True(t, mapped.Valid)
+	assert.Equal(t, uint64(24690), mapped.ValueOrZero())
+
+	called := false
+	null := Uint{}.Map(func(v uint64) uint64 {
+		called = true
+
+		return v
+	})
+	assert.False(t, null.Valid)
+	assert.False(t, called)
+}
+
+func TestUintFlatMap(t *testing.T) {
+	toNull := func(v uint64) Uint {
+		return Uint{}
+	}
+
+	assert.False(t, UintFrom(uint64(12345)).FlatMap(toNull).Valid)
+	assert.Equal(t, UintFrom(uint64(12345)), UintFrom(uint64(12345)).FlatMap(func(v uint64) Uint {
+		return UintFrom(v)
+	}))
+	assert.False(t, Uint{}.FlatMap(func(v uint64) Uint {
+		return UintFrom(uint64(12345))
+	}).Valid)
+}
+
+func TestUintFilter(t *testing.T) {
+	assert.Equal(t, UintFrom(uint64(12345)), UintFrom(uint64(12345)).Filter(func(v uint64) bool { return v > 0 }))
+	assert.False(t, UintFrom(uint64(12345)).Filter(func(v uint64) bool { return false }).Valid)
+	assert.False(t, Uint{}.Filter(func(v uint64) bool { return true }).Valid)
+}
+
+func TestUintToFloat(t *testing.T) {
+	f, err := UintFrom(12345).ToFloat()
+	assert.NoError(t, err)
+	assert.Equal(t, FloatFrom(12345), f)
+
+	f, err = Uint{}.ToFloat()
+	assert.NoError(t, err)
+	assert.False(t, f.Valid)
+
+	f, err = UintFrom(1 << 63).ToFloat()
+	assert.NoError(t, err)
+	assert.Equal(t, FloatFrom(1<<63), f)
+
+	_, err = UintFrom(math.MaxUint64).ToFloat()
+	assert.ErrorIs(t, err, ErrLossyConversion)
+
+	_, err = UintFrom(1<<53 + 1).ToFloat()
+	assert.ErrorIs(t, err, ErrLossyConversion)
+}
+
+func TestUintToInt(t *testing.T) {
+	i, err := UintFrom(12345).ToInt()
+	assert.NoError(t, err)
+	assert.Equal(t, IntFrom(12345), i)
+
+	i, err = Uint{}.ToInt()
+	assert.NoError(t, err)
+	assert.False(t, i.Valid)
+
+	_, err = UintFrom(math.MaxInt64 + 1).ToInt()
+	assert.ErrorIs(t, err, ErrLossyConversion)
+}
+
+func TestUintToString(t *testing.T) {
+	assert.Equal(t, StringFrom("12345"), UintFrom(12345).ToString())
+	assert.False(t, Uint{}.ToString().Valid)
+}
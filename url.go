@@ -0,0 +1,337 @@
+package std
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrURLScheme is returned when the scheme of a URL is not allowed.
+var ErrURLScheme = errors.New("std: URL scheme not allowed")
+
+// defaultURLSchemes are the schemes accepted by URL when scanning and unmarshalling.
+var defaultURLSchemes = []string{"http", "https"}
+
+// URL is a nullable *url.URL. It supports SQL and JSON serialization.
+// It only accepts absolute http and https URLs and normalises them with a lower case scheme and host.
+// Use ParseURL or ScanURL to accept other schemes.
+type URL struct {
+	Data  *url.URL
+	Valid bool
+}
+
+// NewURL creates a new URL.
+func NewURL(u *url.URL, valid bool) URL {
+	return URL{
+		Data:  u,
+		Valid: valid,
+	}
+}
+
+// URLFrom creates a new URL that will be null if u is nil.
+func URLFrom(u *url.URL) URL {
+	return NewURL(u, u != nil)
+}
+
+// ParseURL parses s as an absolute URL whose scheme is in schemes, http or https if schemes is empty.
+// A blank string gives a null URL.
+func ParseURL(s string, schemes ...string) (URL, error) {
+	if s == "" {
+		return URL{}, nil
+	}
+
+	if len(schemes) == 0 {
+		schemes = defaultURLSchemes
+	}
+
+	u, err := parseURL(s, schemes)
+	if err != nil {
+		return URL{}, err
+	}
+
+	return URLFrom(u), nil
+}
+
+// parseURL parses and normalises an absolute URL whose scheme is in schemes.
+func parseURL(s string, schemes []string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err // nolint: wrapcheck
+	}
+
+	if !u.IsAbs() || (u.Host == "" && u.Opaque == "") {
+		return nil, fmt.Errorf("std: %q is not an absolute URL", s)
+	}
+
+	if !URLFrom(u).HasScheme(schemes...) {
+		return nil, fmt.Errorf("%w: %q", ErrURLScheme, u.Scheme)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+
+	return u, nil
+}
+
+// ScanURL returns a sql.Scanner storing into u, accepting the URLs whose scheme is in schemes,
+// http or https if schemes is empty.
+func ScanURL(u *URL, schemes ...string) sql.Scanner {
+	if len(schemes) == 0 {
+		schemes = defaultURLSchemes
+	}
+
+	return urlScanner{dest: u, schemes: schemes}
+}
+
+type urlScanner struct {
+	dest    *URL
+	schemes []string
+}
+
+func (s urlScanner) Scan(value interface{}) error {
+	return s.dest.scan(value, s.schemes)
+}
+
+// Scan implements the Scanner interface.
+func (u *URL) Scan(value interface{}) error {
+	return u.scan(value, defaultURLSchemes)
+}
+
+func (u *URL) scan(value interface{}, schemes []string) error {
+	var err error
+
+	switch x := value.(type) {
+	case nil:
+		u.Data, u.Valid = nil, false
+
+		return nil
+	case []byte:
+		u.Data, err = parseURL(string(x), schemes)
+	case string:
+		u.Data, err = parseURL(x, schemes)
+	default:
+		err = fmt.Errorf("null: cannot scan type %T into null.URL: %v", value, value)
+	}
+
+	if err != nil {
+		u.Data = nil
+	}
+
+	u.Valid = err == nil
+
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (u URL) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+
+	return u.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input.
+func (u *URL) UnmarshalJSON(data []byte) error {
+	var s String
+
+	if err := s.UnmarshalJSON(data); err != nil {
+		u.Data, u.Valid = nil, false
+
+		return err
+	}
+
+	if !s.Valid {
+		u.Data, u.Valid = nil, false
+
+		return nil
+	}
+
+	return u.Scan(s.Data)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this URL is null.
+func (u URL) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(u.String())
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null URL if the input is a blank string.
+func (u *URL) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		u.Data, u.Valid = nil, false
+
+		return nil
+	}
+
+	return u.Scan(text)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string when this URL is null.
+func (u URL) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// SetValid changes this URL's value and also sets it to be non-null, unless v is nil.
+func (u *URL) SetValid(v *url.URL) {
+	u.Data = v
+	u.Valid = v != nil
+}
+
+// IsZero returns true for null URLs, for potential future omitempty support.
+func (u URL) IsZero() bool {
+	return !u.Valid
+}
+
+// String implements fmt.Stringer interface.
+// It returns a blank string if this URL is null.
+func (u URL) String() string {
+	if !u.Valid || u.Data == nil {
+		return ""
+	}
+
+	return u.Data.String()
+}
+
+// Equal reports whether u and o are both null or both valid with the same URL.
+func (u URL) Equal(o URL) bool {
+	return u.Valid == o.Valid && u.String() == o.String()
+}
+
+// Compare returns -1 if u is less than o, 0 if they are equal and +1 if u is greater than o,
+// comparing their text forms. Null values are ordered according to nulls.
+func (u URL) Compare(o URL, nulls NullOrder) int {
+	if c, ok := compareNull(u.Valid, o.Valid, nulls); ok {
+		return c
+	}
+
+	return strings.Compare(u.String(), o.String())
+}
+
+// ValueOr returns this URL's value, or def if this URL is null.
+func (u URL) ValueOr(def *url.URL) *url.URL {
+	if !u.Valid {
+		return def
+	}
+
+	return u.Data
+}
+
+// ValueOrZero returns this URL's value, or the zero value of *url.URL if this URL is null.
+func (u URL) ValueOrZero() *url.URL {
+	if !u.Valid {
+		return nil
+	}
+
+	return u.Data
+}
+
+// OrElse returns this URL's value, or the result of fn if this URL is null.
+// fn is only called when this URL is null.
+func (u URL) OrElse(fn func() *url.URL) *url.URL {
+	if !u.Valid {
+		return fn()
+	}
+
+	return u.Data
+}
+
+// Get returns this URL's value and true, or the zero value of *url.URL and false if this URL is null.
+func (u URL) Get() (*url.URL, bool) {
+	return u.ValueOrZero(), u.Valid
+}
+
+// MustGet returns this URL's value, it panics if this URL is null.
+func (u URL) MustGet() *url.URL {
+	if !u.Valid {
+		panic("std: MustGet called on a null URL")
+	}
+
+	return u.Data
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this URL is null.
+func (u URL) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, u.Valid, u.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null URL, an empty element is a null URL.
+func (u *URL) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, u)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this URL is null.
+func (u URL) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, u.Valid, u.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (u *URL) UnmarshalXMLAttr(attr xml.Attr) error {
+	return u.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (u URL) MarshalBinary() ([]byte, error) {
+	return marshalBinaryText(u.Valid, u.MarshalText)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (u *URL) UnmarshalBinary(data []byte) error {
+	return unmarshalBinaryText(data, "std.URL", u)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (u URL) GobEncode() ([]byte, error) {
+	return u.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (u *URL) GobDecode(data []byte) error {
+	return u.UnmarshalBinary(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this URL is null, its text form otherwise.
+func (u URL) MarshalYAML() (interface{}, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+
+	return u.String(), nil
+}
+
+// unmarshalYAML decodes this URL with the unmarshal func of a YAML library.
+func (u *URL) unmarshalYAML(unmarshal func(interface{}) error) error {
+	return unmarshalYAMLText(unmarshal, "std.URL", u)
+}
+
+// HasScheme reports whether the scheme of this URL is one of schemes, regardless of case.
+// It is false if this URL is null.
+func (u URL) HasScheme(schemes ...string) bool {
+	if !u.Valid || u.Data == nil {
+		return false
+	}
+
+	for _, scheme := range schemes {
+		if strings.EqualFold(u.Data.Scheme, scheme) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,182 @@
+package std
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLScan(t *testing.T) {
+	testCases := []struct {
+		src      interface{}
+		expected string
+	}{
+		{"https://example.com", "https://example.com"},
+		{[]byte("HTTP://Example.COM/Path?q=A#F"), "http://example.com/Path?q=A#F"},
+		{"https://user@example.com:8080/a%20b", "https://user@example.com:8080/a%20b"},
+	}
+
+	for _, tc := range testCases {
+		var u URL
+
+		assert.NoError(t, u.Scan(tc.src), tc.src)
+		assert.True(t, u.Valid)
+
+		v, err := u.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, v)
+	}
+
+	var u URL
+
+	err := u.Scan("ftp://example.com")
+	assert.True(t, errors.Is(err, ErrURLScheme), err)
+	assert.EqualError(t, err, `std: URL scheme not allowed: "ftp"`)
+	assert.False(t, u.Valid)
+	assert.Nil(t, u.Data)
+
+	assert.EqualError(t, u.Scan("/path"), `std: "/path" is not an absolute URL`)
+	assert.EqualError(t, u.Scan("https:"), `std: "https:" is not an absolute URL`)
+	assert.Error(t, u.Scan("https://example.com/%zz"))
+	assert.EqualError(t, u.Scan(int64(1)), "null: cannot scan type int64 into null.URL: 1")
+
+	assert.NoError(t, u.Scan(nil))
+	assert.False(t, u.Valid)
+
+	v, err := u.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestScanURL(t *testing.T) {
+	var u URL
+
+	assert.NoError(t, ScanURL(&u, "ftp", "mailto").Scan("ftp://example.com"))
+	assert.True(t, u.HasScheme("ftp"))
+
+	assert.NoError(t, ScanURL(&u, "ftp", "mailto").Scan([]byte("mailto:gopher@example.com")))
+	assert.True(t, u.HasScheme("MAILTO"))
+	assert.False(t, u.HasScheme("http", "https"))
+
+	err := ScanURL(&u, "ftp").Scan("https://example.com")
+	assert.True(t, errors.Is(err, ErrURLScheme))
+	assert.False(t, u.Valid)
+
+	assert.NoError(t, ScanURL(&u).Scan("https://example.com"))
+	assert.True(t, u.Valid)
+	assert.Error(t, ScanURL(&u).Scan("ftp://example.com"))
+
+	assert.NoError(t, ScanURL(&u, "ftp").Scan(nil))
+	assert.False(t, u.Valid)
+}
+
+func TestParseURL(t *testing.T) {
+	u, err := ParseURL("https://example.com/a")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/a", u.String())
+	assert.True(t, u.HasScheme("http", "https"))
+
+	u, err = ParseURL("ftp://example.com", "ftp")
+	assert.NoError(t, err)
+	assert.True(t, u.Valid)
+
+	_, err = ParseURL("https://example.com", "ftp")
+	assert.True(t, errors.Is(err, ErrURLScheme))
+
+	u, err = ParseURL("")
+	assert.NoError(t, err)
+	assert.True(t, u.IsZero())
+	assert.Equal(t, "", u.String())
+	assert.False(t, u.HasScheme("https"))
+}
+
+func TestURLJSON(t *testing.T) {
+	var v struct {
+		Website URL
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"Website":"https://Example.com/a"}`), &v))
+	assert.Equal(t, "https://example.com/a", v.Website.String())
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Website":"https://example.com/a"}`, string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"Website":null}`), &v))
+	assert.False(t, v.Website.Valid)
+
+	data, err = json.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Website":null}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"Website":"javascript:alert(1)"}`), &v))
+	assert.False(t, v.Website.Valid)
+	assert.Error(t, json.Unmarshal([]byte(`{"Website":1}`), &v))
+}
+
+func TestURLText(t *testing.T) {
+	var u URL
+
+	assert.NoError(t, u.UnmarshalText([]byte("https://example.com")))
+	assert.True(t, u.Valid)
+
+	text, err := u.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com", string(text))
+
+	assert.NoError(t, u.UnmarshalText(nil))
+	assert.False(t, u.Valid)
+
+	text, err = u.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "", string(text))
+}
+
+func TestURLSetValid(t *testing.T) {
+	var u URL
+
+	u.SetValid(&url.URL{Scheme: "https", Host: "example.com"})
+	assert.True(t, u.Valid)
+	assert.True(t, u.Equal(URLFrom(&url.URL{Scheme: "https", Host: "example.com"})))
+	assert.False(t, u.Equal(URL{}))
+
+	u.SetValid(nil)
+	assert.False(t, u.Valid)
+	assert.True(t, u.Equal(URLFrom(nil)))
+}
+
+func TestURLAccessors(t *testing.T) {
+	a, _ := ParseURL("http://example.com/a")
+	b, _ := ParseURL("https://example.com/a")
+
+	assert.Equal(t, -1, a.Compare(b, NullsFirst))
+	assert.Equal(t, 1, b.Compare(a, NullsFirst))
+	assert.Equal(t, 0, a.Compare(a, NullsFirst))
+	assert.Equal(t, -1, URL{}.Compare(a, NullsFirst))
+	assert.Equal(t, 1, URL{}.Compare(a, NullsLast))
+
+	assert.Equal(t, a.Data, a.ValueOr(b.Data))
+	assert.Equal(t, b.Data, URL{}.ValueOr(b.Data))
+	assert.Nil(t, URL{}.ValueOrZero())
+	assert.Equal(t, b.Data, URL{}.OrElse(func() *url.URL { return b.Data }))
+
+	v, ok := a.Get()
+	assert.True(t, ok)
+	assert.Equal(t, a.Data, v)
+
+	assert.Equal(t, a.Data, a.MustGet())
+	assert.Panics(t, func() {
+		URL{}.MustGet()
+	})
+}
+
+func TestURLEncodings(t *testing.T) {
+	u, _ := ParseURL("https://example.com/a?b=c#d")
+
+	for _, v := range []URL{u, {}} {
+		testTextEncodings(t, v, func() interface{} { return &URL{} })
+	}
+}
@@ -0,0 +1,346 @@
+package std
+
+import (
+	"encoding"
+	"encoding/xml"
+)
+
+// xsiNamespace is the XML Schema instance namespace, used by the xsi:nil attribute of null elements.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// marshalXMLElement encodes a valid value as the text of the element,
+// or a null value as an empty element with the xsi:nil="true" attribute.
+func marshalXMLElement(e *xml.Encoder, start xml.StartElement, valid bool, text func() ([]byte, error)) error {
+	if !valid {
+		start.Attr = append(start.Attr,
+			xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+			xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+		)
+
+		return e.EncodeElement("", start) // nolint: wrapcheck
+	}
+
+	b, err := text()
+	if err != nil {
+		return err
+	}
+
+	return e.EncodeElement(string(b), start) // nolint: wrapcheck
+}
+
+// unmarshalXMLElement decodes the text of the element,
+// it returns false if the element is marked as null by a xsi:nil="true" attribute.
+func unmarshalXMLElement(d *xml.Decoder, start xml.StartElement) ([]byte, bool, error) {
+	var text string
+
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return nil, false, err // nolint: wrapcheck
+	}
+
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") && attr.Value == "true" {
+			return nil, false, nil
+		}
+	}
+
+	return []byte(text), true, nil
+}
+
+// unmarshalXMLText decodes the text of the element with the UnmarshalText method of u,
+// an element with a xsi:nil="true" attribute gives a blank text.
+func unmarshalXMLText(d *xml.Decoder, start xml.StartElement, u encoding.TextUnmarshaler) error {
+	text, valid, err := unmarshalXMLElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		text = nil
+	}
+
+	return u.UnmarshalText(text)
+}
+
+// marshalXMLAttr encodes a valid value as an attribute, a null value omits the attribute.
+func marshalXMLAttr(name xml.Name, valid bool, text func() ([]byte, error)) (xml.Attr, error) {
+	if !valid {
+		return xml.Attr{}, nil
+	}
+
+	b, err := text()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(b)}, nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this String is null.
+func (s String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, s.Valid, s.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null String, an empty element is an empty String.
+func (s *String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, valid, err := unmarshalXMLElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	s.Data, s.Valid = string(text), valid
+
+	return nil
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this String is null.
+func (s String) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, s.Valid, s.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (s *String) UnmarshalXMLAttr(attr xml.Attr) error {
+	s.SetValid(attr.Value)
+
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Int is null.
+func (i Int) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, i.Valid, i.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Int, an empty element is a null Int.
+func (i *Int) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, valid, err := unmarshalXMLElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*i = Int{}
+
+		return nil
+	}
+
+	return i.UnmarshalText(text)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Int is null.
+func (i Int) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, i.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Int) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Uint is null.
+func (i Uint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, i.Valid, i.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Uint, an empty element is a null Uint.
+func (i *Uint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, valid, err := unmarshalXMLElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*i = Uint{}
+
+		return nil
+	}
+
+	return i.UnmarshalText(text)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Uint is null.
+func (i Uint) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, i.Valid, i.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (i *Uint) UnmarshalXMLAttr(attr xml.Attr) error {
+	return i.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Float is null.
+func (f Float) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, f.Valid, f.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Float, an empty element is a null Float.
+func (f *Float) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, valid, err := unmarshalXMLElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*f = Float{}
+
+		return nil
+	}
+
+	return f.UnmarshalText(text)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Float is null.
+func (f Float) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, f.Valid, f.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (f *Float) UnmarshalXMLAttr(attr xml.Attr) error {
+	return f.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Bool is null.
+func (b Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, b.Valid, b.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Bool, an empty element is a null Bool.
+func (b *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, valid, err := unmarshalXMLElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*b = Bool{}
+
+		return nil
+	}
+
+	return b.UnmarshalText(text)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Bool is null.
+func (b Bool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, b.Valid, b.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (b *Bool) UnmarshalXMLAttr(attr xml.Attr) error {
+	return b.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Time is null.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Time, an empty element is a null Time.
+func (t *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, valid, err := unmarshalXMLElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*t = Time{}
+
+		return nil
+	}
+
+	return t.UnmarshalText(text)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Time is null.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this DateTime is null.
+func (t DateTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null DateTime, an empty element is a null DateTime.
+func (t *DateTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, valid, err := unmarshalXMLElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*t = DateTime{}
+
+		return nil
+	}
+
+	return t.UnmarshalText(text)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this DateTime is null.
+func (t DateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *DateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXML implements xml.Marshaler.
+// It will encode an empty element with a xsi:nil="true" attribute if this Date is null.
+func (t Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLElement(e, start, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+// An element with a xsi:nil="true" attribute is a null Date, an empty element is a null Date.
+func (t *Date) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, valid, err := unmarshalXMLElement(d, start)
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		*t = Date{}
+
+		return nil
+	}
+
+	return t.UnmarshalText(text)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+// The attribute is omitted if this Date is null.
+func (t Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, t.Valid, t.MarshalText)
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (t *Date) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.UnmarshalText([]byte(attr.Value))
+}
@@ -0,0 +1,142 @@
+package std
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type xmlElements struct {
+	XMLName  xml.Name `xml:"elements"`
+	String   String   `xml:"string"`
+	Int      Int      `xml:"int"`
+	Uint     Uint     `xml:"uint"`
+	Float    Float    `xml:"float"`
+	Bool     Bool     `xml:"bool"`
+	Time     Time     `xml:"time"`
+	DateTime DateTime `xml:"date_time"`
+	Date     Date     `xml:"date"`
+}
+
+type xmlAttrs struct {
+	XMLName  xml.Name `xml:"attrs"`
+	String   String   `xml:"string,attr"`
+	Int      Int      `xml:"int,attr"`
+	Uint     Uint     `xml:"uint,attr"`
+	Float    Float    `xml:"float,attr"`
+	Bool     Bool     `xml:"bool,attr"`
+	Time     Time     `xml:"time,attr"`
+	DateTime DateTime `xml:"date_time,attr"`
+	Date     Date     `xml:"date,attr"`
+}
+
+func TestMarshalXMLElement(t *testing.T) {
+	v := xmlElements{
+		String:   StringFrom("test"),
+		Int:      IntFrom(12345),
+		Uint:     UintFrom(12345),
+		Float:    FloatFrom(1.2345),
+		Bool:     BoolFrom(true),
+		Time:     TimeFrom(timeValue),
+		DateTime: DateTimeFrom(dateTimeValue),
+		Date:     DateFrom(dateValue),
+	}
+
+	data, err := xml.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `<elements><string>test</string><int>12345</int><uint>12345</uint><float>1.2345</float>`+
+		`<bool>true</bool><time>2012-12-21T21:21:21Z</time><date_time>2012-12-21T21:21:21+0000</date_time>`+
+		`<date>2012-12-21</date></elements>`, string(data))
+
+	var decoded xmlElements
+
+	assert.NoError(t, xml.Unmarshal(data, &decoded))
+	assert.Equal(t, v.String, decoded.String)
+	assert.Equal(t, v.Int, decoded.Int)
+	assert.Equal(t, v.Uint, decoded.Uint)
+	assert.Equal(t, v.Float, decoded.Float)
+	assert.Equal(t, v.Bool, decoded.Bool)
+	assert.True(t, v.Time.Equal(decoded.Time))
+	assert.True(t, v.DateTime.Equal(decoded.DateTime))
+	assert.True(t, v.Date.Equal(decoded.Date))
+}
+
+func TestMarshalXMLNullElement(t *testing.T) {
+	data, err := xml.Marshal(xmlElements{})
+	assert.NoError(t, err)
+
+	null := `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"`
+	assert.Equal(t, `<elements><string `+null+`></string><int `+null+`></int><uint `+null+`></uint>`+
+		`<float `+null+`></float><bool `+null+`></bool><time `+null+`></time>`+
+		`<date_time `+null+`></date_time><date `+null+`></date></elements>`, string(data))
+
+	decoded := xmlElements{
+		String: StringFrom("test"),
+		Int:    IntFrom(12345),
+		Date:   DateFrom(dateValue),
+	}
+
+	assert.NoError(t, xml.Unmarshal(data, &decoded))
+	assert.Equal(t, xmlElements{XMLName: xml.Name{Local: "elements"}}, decoded)
+}
+
+func TestUnmarshalXMLEmptyElement(t *testing.T) {
+	var decoded xmlElements
+
+	err := xml.Unmarshal([]byte(`<elements><string></string><int/><date></date></elements>`), &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, StringFrom(""), decoded.String)
+	assert.False(t, decoded.Int.Valid)
+	assert.False(t, decoded.Date.Valid)
+
+	err = xml.Unmarshal([]byte(`<elements><int>test</int></elements>`), &decoded)
+	assert.Error(t, err)
+
+	err = xml.Unmarshal([]byte(`<elements><string xsi:nil="true"/></elements>`), &decoded)
+	assert.NoError(t, err)
+	assert.False(t, decoded.String.Valid)
+}
+
+func TestMarshalXMLAttr(t *testing.T) {
+	v := xmlAttrs{
+		String:   StringFrom(""),
+		Int:      IntFrom(12345),
+		Uint:     UintFrom(12345),
+		Float:    FloatFrom(1.2345),
+		Bool:     BoolFrom(false),
+		Time:     TimeFrom(timeValue),
+		DateTime: DateTimeFrom(dateTimeValue),
+		Date:     DateFrom(dateValue),
+	}
+
+	data, err := xml.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `<attrs string="" int="12345" uint="12345" float="1.2345" bool="false" time="2012-12-21T21:21:21Z" `+
+		`date_time="2012-12-21T21:21:21+0000" date="2012-12-21"></attrs>`, string(data))
+
+	var decoded xmlAttrs
+
+	assert.NoError(t, xml.Unmarshal(data, &decoded))
+	assert.Equal(t, v.String, decoded.String)
+	assert.Equal(t, v.Int, decoded.Int)
+	assert.Equal(t, v.Uint, decoded.Uint)
+	assert.Equal(t, v.Float, decoded.Float)
+	assert.Equal(t, v.Bool, decoded.Bool)
+	assert.True(t, v.Time.Equal(decoded.Time))
+	assert.True(t, v.DateTime.Equal(decoded.DateTime))
+	assert.True(t, v.Date.Equal(decoded.Date))
+}
+
+func TestMarshalXMLNullAttr(t *testing.T) {
+	data, err := xml.Marshal(xmlAttrs{})
+	assert.NoError(t, err)
+	assert.Equal(t, `<attrs></attrs>`, string(data))
+
+	var decoded xmlAttrs
+
+	assert.NoError(t, xml.Unmarshal(data, &decoded))
+	assert.Equal(t, xmlAttrs{XMLName: xml.Name{Local: "attrs"}}, decoded)
+
+	assert.Error(t, xml.Unmarshal([]byte(`<attrs int="test"></attrs>`), &decoded))
+}
@@ -0,0 +1,388 @@
+package std
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// The YAML methods follow the yaml.v2 and yaml.v3 Marshaler interface and the
+// obsolete (but still supported by yaml.v3) Unmarshaler interface, so the package
+// does not depend on any YAML library. Built with the yamlv3 tag, UnmarshalYAML
+// takes a *yaml.Node and implements the yaml.v3 Unmarshaler interface instead.
+
+func yamlTypeErr(v interface{}, typ string) error {
+	return fmt.Errorf("yaml: cannot unmarshal %T (%v) into Go value of type %s", v, v, typ)
+}
+
+// unmarshalYAMLText decodes a YAML string with the UnmarshalText method of u, null gives a blank text.
+func unmarshalYAMLText(unmarshal func(interface{}) error, typ string, u encoding.TextUnmarshaler) error {
+	var v interface{}
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	switch x := v.(type) {
+	case nil:
+		return u.UnmarshalText(nil)
+	case string:
+		return u.UnmarshalText([]byte(x))
+	}
+
+	if err := u.UnmarshalText(nil); err != nil {
+		return err
+	}
+
+	return yamlTypeErr(v, typ)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this String is null.
+func (s String) MarshalYAML() (interface{}, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+
+	return s.Data, nil
+}
+
+// unmarshalYAML decodes this String with the unmarshal func of a YAML library.
+func (s *String) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	if v == nil {
+		s.Data, s.Valid = "", false
+
+		return nil
+	}
+
+	if err := unmarshal(&s.Data); err != nil {
+		s.Valid = false
+
+		return err
+	}
+
+	s.Valid = true
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Int is null.
+func (i Int) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+
+	return i.Data, nil
+}
+
+// unmarshalYAML decodes this Int with the unmarshal func of a YAML library.
+func (i *Int) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	i.Valid = false
+
+	switch x := v.(type) {
+	case nil:
+		i.Data = 0
+
+		return nil
+	case int:
+		i.Data = int64(x)
+	case int64:
+		i.Data = x
+	case uint64:
+		if x > math.MaxInt64 {
+			return yamlTypeErr(v, "std.Int")
+		}
+
+		i.Data = int64(x)
+	default:
+		return yamlTypeErr(v, "std.Int")
+	}
+
+	i.Valid = true
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Uint is null.
+func (i Uint) MarshalYAML() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+
+	return i.Data, nil
+}
+
+// unmarshalYAML decodes this Uint with the unmarshal func of a YAML library.
+func (i *Uint) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	i.Valid = false
+
+	switch x := v.(type) {
+	case nil:
+		i.Data = 0
+
+		return nil
+	case int:
+		if x < 0 {
+			return yamlTypeErr(v, "std.Uint")
+		}
+
+		i.Data = uint64(x)
+	case int64:
+		if x < 0 {
+			return yamlTypeErr(v, "std.Uint")
+		}
+
+		i.Data = uint64(x)
+	case uint64:
+		i.Data = x
+	default:
+		return yamlTypeErr(v, "std.Uint")
+	}
+
+	i.Valid = true
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Float is null.
+func (f Float) MarshalYAML() (interface{}, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+
+	return f.Data, nil
+}
+
+// unmarshalYAML decodes this Float with the unmarshal func of a YAML library.
+func (f *Float) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	f.Valid = false
+
+	switch x := v.(type) {
+	case nil:
+		f.Data = 0
+
+		return nil
+	case float64:
+		f.Data = x
+	case int:
+		f.Data = float64(x)
+	case int64:
+		f.Data = float64(x)
+	case uint64:
+		f.Data = float64(x)
+	default:
+		return yamlTypeErr(v, "std.Float")
+	}
+
+	f.Valid = true
+
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalYAML() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+
+	return b.Data, nil
+}
+
+// unmarshalYAML decodes this Bool with the unmarshal func of a YAML library.
+func (b *Bool) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var v interface{}
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	b.Valid = false
+
+	switch x := v.(type) {
+	case nil:
+		b.Data = false
+
+		return nil
+	case bool:
+		b.Data = x
+	default:
+		return yamlTypeErr(v, "std.Bool")
+	}
+
+	b.Valid = true
+
+	return nil
+}
+
+// unmarshalYAMLTime decodes a YAML timestamp, or a string parsed by parse.
+func unmarshalYAMLTime(unmarshal func(interface{}) error, typ string, parse func(string) (time.Time, error)) (time.Time, bool, error) {
+	var v interface{}
+
+	if err := unmarshal(&v); err != nil {
+		return time.Time{}, false, err
+	}
+
+	switch x := v.(type) {
+	case nil:
+		return time.Time{}, false, nil
+	case time.Time:
+		return x, true, nil
+	case string:
+		t, err := parse(x)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("yaml: cannot unmarshal %q into Go value of type %s: %w", x, typ, err)
+		}
+
+		return t, true, nil
+	}
+
+	return time.Time{}, false, yamlTypeErr(v, typ)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Time is null, a timestamp otherwise.
+func (t Time) MarshalYAML() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	return t.Time, nil
+}
+
+// unmarshalYAML decodes this Time with the unmarshal func of a YAML library.
+func (t *Time) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var err error
+
+	t.Time, t.Valid, err = unmarshalYAMLTime(unmarshal, "std.Time", func(s string) (time.Time, error) {
+		return time.Parse(time.RFC3339Nano, s)
+	})
+
+	return err
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this DateTime is null, a timestamp otherwise.
+func (t DateTime) MarshalYAML() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	return t.Data, nil
+}
+
+// unmarshalYAML decodes this DateTime with the unmarshal func of a YAML library.
+func (t *DateTime) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var err error
+
+	t.Data, t.Valid, err = unmarshalYAMLTime(unmarshal, "std.DateTime", func(s string) (time.Time, error) {
+		return time.Parse(dateTimeFormat, s)
+	})
+
+	return err
+}
+
+// MarshalYAML implements yaml.Marshaler.
+// It will encode null if this Date is null, a timestamp otherwise.
+func (t Date) MarshalYAML() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	return t.Data, nil
+}
+
+// unmarshalYAML decodes this Date with the unmarshal func of a YAML library.
+func (t *Date) unmarshalYAML(unmarshal func(interface{}) error) error {
+	var err error
+
+	t.Data, t.Valid, err = unmarshalYAMLTime(unmarshal, "std.Date", func(s string) (time.Time, error) {
+		return time.Parse(dateFormat, s)
+	})
+
+	return err
+}
+
+// marshalYAMLArray returns data, a slice of n elements, as a sequence,
+// nested according to dims for multi-dimensional arrays.
+func marshalYAMLArray(data interface{}, n int, dims []int, typ string) (interface{}, error) {
+	if err := checkArrayDims(dims, n, typ); err != nil {
+		return nil, err
+	}
+
+	if len(dims) <= 1 {
+		return data, nil
+	}
+
+	next := 0
+
+	return nestYAMLArray(reflect.ValueOf(data), dims, &next), nil
+}
+
+func nestYAMLArray(data reflect.Value, dims []int, next *int) []interface{} {
+	seq := make([]interface{}, dims[0])
+
+	for k := range seq {
+		if len(dims) > 1 {
+			seq[k] = nestYAMLArray(data, dims[1:], next)
+
+			continue
+		}
+
+		seq[k] = data.Index(*next).Interface()
+		*next++
+	}
+
+	return seq
+}
+
+// unmarshalYAMLCollection decodes a YAML sequence or mapping with the UnmarshalJSON method of u,
+// YAML values being a superset of JSON ones.
+func unmarshalYAMLCollection(unmarshal func(interface{}) error, typ string, u json.Unmarshaler) error {
+	var v interface{}
+
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		if err := u.UnmarshalJSON([]byte("null")); err != nil {
+			return err
+		}
+
+		return yamlTypeErr(v, typ)
+	}
+
+	return u.UnmarshalJSON(data)
+}
@@ -0,0 +1,224 @@
+package std
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// yamlDecoder is implemented by the types decoded from YAML, UnmarshalYAML depending on the yamlv3 tag.
+type yamlDecoder interface {
+	unmarshalYAML(unmarshal func(interface{}) error) error
+}
+
+// yamlUnmarshal returns an unmarshal func, as given by a YAML library, decoding v.
+func yamlUnmarshal(v interface{}) func(interface{}) error {
+	return func(out interface{}) error {
+		dst := reflect.ValueOf(out).Elem()
+
+		if v == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+
+			return nil
+		}
+
+		src := reflect.ValueOf(v)
+		if !src.Type().AssignableTo(dst.Type()) {
+			return errors.New("yaml: type mismatch")
+		}
+
+		dst.Set(src)
+
+		return nil
+	}
+}
+
+func TestStringYAML(t *testing.T) {
+	v, err := StringFrom("test").MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, "test", v)
+
+	v, err = String{}.MarshalYAML()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	var s String
+	assert.NoError(t, s.unmarshalYAML(yamlUnmarshal("test")))
+	assert.Equal(t, StringFrom("test"), s)
+
+	assert.NoError(t, s.unmarshalYAML(yamlUnmarshal(nil)))
+	assert.False(t, s.Valid)
+
+	assert.Error(t, s.unmarshalYAML(yamlUnmarshal(12345)))
+	assert.False(t, s.Valid)
+}
+
+func TestIntYAML(t *testing.T) {
+	v, err := IntFrom(12345).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12345), v)
+
+	v, err = Int{}.MarshalYAML()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	var i Int
+	assert.NoError(t, i.unmarshalYAML(yamlUnmarshal(12345)))
+	assertInt(t, i, "yaml int")
+
+	assert.NoError(t, i.unmarshalYAML(yamlUnmarshal(int64(12345))))
+	assertInt(t, i, "yaml int64")
+
+	assert.NoError(t, i.unmarshalYAML(yamlUnmarshal(uint64(12345))))
+	assertInt(t, i, "yaml uint64")
+
+	assert.NoError(t, i.unmarshalYAML(yamlUnmarshal(nil)))
+	assertNullInt(t, i, "yaml null")
+
+	assert.Error(t, i.unmarshalYAML(yamlUnmarshal(uint64(math.MaxUint64))))
+	assert.Error(t, i.unmarshalYAML(yamlUnmarshal(1.5)))
+	assertNullInt(t, i, "yaml float")
+}
+
+func TestUintYAML(t *testing.T) {
+	v, err := UintFrom(12345).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12345), v)
+
+	v, err = Uint{}.MarshalYAML()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	var i Uint
+	assert.NoError(t, i.unmarshalYAML(yamlUnmarshal(12345)))
+	assert.Equal(t, UintFrom(12345), i)
+
+	assert.NoError(t, i.unmarshalYAML(yamlUnmarshal(int64(12345))))
+	assert.Equal(t, UintFrom(12345), i)
+
+	assert.NoError(t, i.unmarshalYAML(yamlUnmarshal(uint64(math.MaxUint64))))
+	assert.Equal(t, UintFrom(math.MaxUint64), i)
+
+	assert.NoError(t, i.unmarshalYAML(yamlUnmarshal(nil)))
+	assert.False(t, i.Valid)
+
+	assert.Error(t, i.unmarshalYAML(yamlUnmarshal(-1)))
+	assert.Error(t, i.unmarshalYAML(yamlUnmarshal(int64(-1))))
+	assert.Error(t, i.unmarshalYAML(yamlUnmarshal("test")))
+	assert.False(t, i.Valid)
+}
+
+func TestFloatYAML(t *testing.T) {
+	v, err := FloatFrom(1.2345).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.2345, v)
+
+	v, err = Float{}.MarshalYAML()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	var f Float
+	assert.NoError(t, f.unmarshalYAML(yamlUnmarshal(1.2345)))
+	assertFloat(t, f, "yaml float")
+
+	for _, n := range []interface{}{12, int64(12), uint64(12)} {
+		assert.NoError(t, f.unmarshalYAML(yamlUnmarshal(n)))
+		assert.Equal(t, FloatFrom(12), f)
+	}
+
+	assert.NoError(t, f.unmarshalYAML(yamlUnmarshal(nil)))
+	assertNullFloat(t, f, "yaml null")
+
+	assert.Error(t, f.unmarshalYAML(yamlUnmarshal(true)))
+	assertNullFloat(t, f, "yaml bool")
+}
+
+func TestBoolYAML(t *testing.T) {
+	v, err := BoolFrom(true).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = Bool{}.MarshalYAML()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	var b Bool
+	assert.NoError(t, b.unmarshalYAML(yamlUnmarshal(true)))
+	assert.Equal(t, BoolFrom(true), b)
+
+	assert.NoError(t, b.unmarshalYAML(yamlUnmarshal(nil)))
+	assert.False(t, b.Valid)
+
+	assert.Error(t, b.unmarshalYAML(yamlUnmarshal(1)))
+	assert.False(t, b.Valid)
+}
+
+func TestTimeYAML(t *testing.T) {
+	v, err := TimeFrom(timeValue).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, timeValue, v)
+
+	v, err = Time{}.MarshalYAML()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	var ti Time
+	assert.NoError(t, ti.unmarshalYAML(yamlUnmarshal(timeValue)))
+	assertTime(t, ti, "yaml timestamp")
+
+	assert.NoError(t, ti.unmarshalYAML(yamlUnmarshal(timeString)))
+	assertTime(t, ti, "yaml string")
+
+	assert.NoError(t, ti.unmarshalYAML(yamlUnmarshal(nil)))
+	assertNullTime(t, ti, "yaml null")
+
+	assert.Error(t, ti.unmarshalYAML(yamlUnmarshal("test")))
+	assert.Error(t, ti.unmarshalYAML(yamlUnmarshal(12345)))
+	assertNullTime(t, ti, "yaml int")
+}
+
+func TestDateTimeYAML(t *testing.T) {
+	v, err := DateTimeFrom(dateTimeValue).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, dateTimeValue, v)
+
+	v, err = DateTime{}.MarshalYAML()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	var dt DateTime
+	assert.NoError(t, dt.unmarshalYAML(yamlUnmarshal(dateTimeString)))
+	assertDateTime(t, dt, "yaml string")
+
+	assert.NoError(t, dt.unmarshalYAML(yamlUnmarshal(nil)))
+	assertNullDateTime(t, dt, "yaml null")
+
+	assert.Error(t, dt.unmarshalYAML(yamlUnmarshal("test")))
+	assertNullDateTime(t, dt, "yaml invalid")
+}
+
+func TestDateYAML(t *testing.T) {
+	v, err := DateFrom(dateValue).MarshalYAML()
+	assert.NoError(t, err)
+	assert.Equal(t, dateValue, v)
+
+	v, err = Date{}.MarshalYAML()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	var d Date
+	assert.NoError(t, d.unmarshalYAML(yamlUnmarshal(dateValue)))
+	assert.Equal(t, DateFrom(dateValue), d)
+
+	assert.NoError(t, d.unmarshalYAML(yamlUnmarshal(dateString)))
+	assert.Equal(t, DateFrom(dateValue), d)
+
+	assert.NoError(t, d.unmarshalYAML(yamlUnmarshal(nil)))
+	assert.False(t, d.Valid)
+
+	assert.Error(t, d.unmarshalYAML(yamlUnmarshal("test")))
+	assert.False(t, d.Valid)
+}
@@ -0,0 +1,165 @@
+//go:build !yamlv3
+
+package std
+
+// yamlUnmarshaler is the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// still supported by gopkg.in/yaml.v3 as yaml.obsoleteUnmarshaler.
+type yamlUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(interface{}) error) error
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports any scalar and null input.
+func (s *String) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return s.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports integer and null input.
+func (i *Int) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return i.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports unsigned integer and null input.
+func (i *Uint) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return i.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports number and null input.
+func (f *Float) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return f.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports boolean and null input.
+func (b *Bool) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return b.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports timestamp, RFC 3339 string and null input.
+func (t *Time) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return t.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports timestamp, ISO8601 string and null input.
+func (t *DateTime) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return t.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports timestamp, ISO8601 (yyyy-mm-dd) string and null input.
+func (t *Date) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return t.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (i *Ints) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return i.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (i *NullInts) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return i.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (f *Floats) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return f.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (f *NullFloats) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return f.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (b *Bools) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return b.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (b *NullBools) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return b.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (s *Strings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return s.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (s *NullStrings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return s.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (t *Times) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return t.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports sequence and null input.
+func (t *NullTimes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return t.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports mapping and null input, the values of the mapping must be scalars or null.
+func (m *StringMap) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return m.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports mapping and null input, the values of the mapping must be scalars or null.
+func (m *JSONStringMap) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return m.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports range literal and null input.
+func (r *IntRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return r.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports range literal and null input.
+func (r *DateRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return r.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports range literal and null input.
+func (r *DateTimeRange) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return r.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports string and null input.
+func (a *HardwareAddr) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return a.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports string and null input.
+func (u *URL) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return u.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports string and null input.
+func (e *Email) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return e.unmarshalYAML(unmarshal)
+}
@@ -0,0 +1,27 @@
+//go:build go1.18 && !yamlv3
+
+package std
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports string and null input.
+func (ip *IP) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return ip.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports string and null input.
+func (p *Prefix) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return p.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports scalar and null input, the value must be allowed by the enum.
+func (e *EnumString[D]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return e.unmarshalYAML(unmarshal)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+// It supports integer and null input, the value must be allowed by the enum.
+func (e *EnumInt[D]) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return e.unmarshalYAML(unmarshal)
+}
@@ -0,0 +1,166 @@
+//go:build yamlv3
+
+package std
+
+import "gopkg.in/yaml.v3"
+
+// yamlUnmarshaler is the yaml.Unmarshaler interface of gopkg.in/yaml.v3.
+type yamlUnmarshaler interface {
+	UnmarshalYAML(node *yaml.Node) error
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports any scalar and null input.
+func (s *String) UnmarshalYAML(node *yaml.Node) error {
+	return s.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports integer and null input.
+func (i *Int) UnmarshalYAML(node *yaml.Node) error {
+	return i.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports unsigned integer and null input.
+func (i *Uint) UnmarshalYAML(node *yaml.Node) error {
+	return i.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports number and null input.
+func (f *Float) UnmarshalYAML(node *yaml.Node) error {
+	return f.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports boolean and null input.
+func (b *Bool) UnmarshalYAML(node *yaml.Node) error {
+	return b.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports timestamp, RFC 3339 string and null input.
+func (t *Time) UnmarshalYAML(node *yaml.Node) error {
+	return t.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports timestamp, ISO8601 string and null input.
+func (t *DateTime) UnmarshalYAML(node *yaml.Node) error {
+	return t.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports timestamp, ISO8601 (yyyy-mm-dd) string and null input.
+func (t *Date) UnmarshalYAML(node *yaml.Node) error {
+	return t.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (i *Ints) UnmarshalYAML(node *yaml.Node) error {
+	return i.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (i *NullInts) UnmarshalYAML(node *yaml.Node) error {
+	return i.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (f *Floats) UnmarshalYAML(node *yaml.Node) error {
+	return f.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (f *NullFloats) UnmarshalYAML(node *yaml.Node) error {
+	return f.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (b *Bools) UnmarshalYAML(node *yaml.Node) error {
+	return b.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (b *NullBools) UnmarshalYAML(node *yaml.Node) error {
+	return b.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (s *Strings) UnmarshalYAML(node *yaml.Node) error {
+	return s.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (s *NullStrings) UnmarshalYAML(node *yaml.Node) error {
+	return s.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (t *Times) UnmarshalYAML(node *yaml.Node) error {
+	return t.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports sequence and null input.
+func (t *NullTimes) UnmarshalYAML(node *yaml.Node) error {
+	return t.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports mapping and null input, the values of the mapping must be scalars or null.
+func (m *StringMap) UnmarshalYAML(node *yaml.Node) error {
+	return m.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports mapping and null input, the values of the mapping must be scalars or null.
+func (m *JSONStringMap) UnmarshalYAML(node *yaml.Node) error {
+	return m.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports range literal and null input.
+func (r *IntRange) UnmarshalYAML(node *yaml.Node) error {
+	return r.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports range literal and null input.
+func (r *DateRange) UnmarshalYAML(node *yaml.Node) error {
+	return r.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports range literal and null input.
+func (r *DateTimeRange) UnmarshalYAML(node *yaml.Node) error {
+	return r.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports string and null input.
+func (a *HardwareAddr) UnmarshalYAML(node *yaml.Node) error {
+	return a.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports string and null input.
+func (u *URL) UnmarshalYAML(node *yaml.Node) error {
+	return u.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports string and null input.
+func (e *Email) UnmarshalYAML(node *yaml.Node) error {
+	return e.unmarshalYAML(node.Decode)
+}
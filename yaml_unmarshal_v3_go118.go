@@ -0,0 +1,29 @@
+//go:build go1.18 && yamlv3
+
+package std
+
+import "gopkg.in/yaml.v3"
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports string and null input.
+func (ip *IP) UnmarshalYAML(node *yaml.Node) error {
+	return ip.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports string and null input.
+func (p *Prefix) UnmarshalYAML(node *yaml.Node) error {
+	return p.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports scalar and null input, the value must be allowed by the enum.
+func (e *EnumString[D]) UnmarshalYAML(node *yaml.Node) error {
+	return e.unmarshalYAML(node.Decode)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler of gopkg.in/yaml.v3.
+// It supports integer and null input, the value must be allowed by the enum.
+func (e *EnumInt[D]) UnmarshalYAML(node *yaml.Node) error {
+	return e.unmarshalYAML(node.Decode)
+}
@@ -0,0 +1,5 @@
+// Package yamltest tests the YAML support of the std types against gopkg.in/yaml.v3.
+//
+// It lives in its own module so that the std package does not depend on any YAML library.
+// Run with the yamlv3 tag, it tests the UnmarshalYAML methods taking a *yaml.Node.
+package yamltest
@@ -0,0 +1,11 @@
+module github.com/euskadi31/go-std/yamltest
+
+go 1.12
+
+require (
+	github.com/euskadi31/go-std v0.0.0
+	github.com/stretchr/testify v1.11.1
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+replace github.com/euskadi31/go-std => ../
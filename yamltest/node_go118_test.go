@@ -0,0 +1,13 @@
+//go:build go1.18 && yamlv3
+
+package yamltest
+
+import (
+	std "github.com/euskadi31/go-std"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ yaml.Unmarshaler = (*std.IP)(nil)
+	_ yaml.Unmarshaler = (*std.Prefix)(nil)
+)
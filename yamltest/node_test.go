@@ -0,0 +1,73 @@
+//go:build yamlv3
+
+package yamltest
+
+import (
+	"testing"
+
+	std "github.com/euskadi31/go-std"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+// With the yamlv3 tag, the std types implement the yaml.v3 Unmarshaler interface.
+var (
+	_ yaml.Unmarshaler = (*std.String)(nil)
+	_ yaml.Unmarshaler = (*std.Int)(nil)
+	_ yaml.Unmarshaler = (*std.Uint)(nil)
+	_ yaml.Unmarshaler = (*std.Float)(nil)
+	_ yaml.Unmarshaler = (*std.Bool)(nil)
+	_ yaml.Unmarshaler = (*std.Time)(nil)
+	_ yaml.Unmarshaler = (*std.DateTime)(nil)
+	_ yaml.Unmarshaler = (*std.Date)(nil)
+	_ yaml.Unmarshaler = (*std.Ints)(nil)
+	_ yaml.Unmarshaler = (*std.NullInts)(nil)
+	_ yaml.Unmarshaler = (*std.Floats)(nil)
+	_ yaml.Unmarshaler = (*std.NullFloats)(nil)
+	_ yaml.Unmarshaler = (*std.Bools)(nil)
+	_ yaml.Unmarshaler = (*std.NullBools)(nil)
+	_ yaml.Unmarshaler = (*std.Strings)(nil)
+	_ yaml.Unmarshaler = (*std.NullStrings)(nil)
+	_ yaml.Unmarshaler = (*std.Times)(nil)
+	_ yaml.Unmarshaler = (*std.NullTimes)(nil)
+	_ yaml.Unmarshaler = (*std.StringMap)(nil)
+	_ yaml.Unmarshaler = (*std.JSONStringMap)(nil)
+	_ yaml.Unmarshaler = (*std.IntRange)(nil)
+	_ yaml.Unmarshaler = (*std.DateRange)(nil)
+	_ yaml.Unmarshaler = (*std.DateTimeRange)(nil)
+	_ yaml.Unmarshaler = (*std.HardwareAddr)(nil)
+	_ yaml.Unmarshaler = (*std.URL)(nil)
+	_ yaml.Unmarshaler = (*std.Email)(nil)
+)
+
+func TestUnmarshalNode(t *testing.T) {
+	var node yaml.Node
+
+	assert.NoError(t, yaml.Unmarshal([]byte(`
+string: test
+int: -12345
+uint: 12345
+float: 1.2345
+bool: true
+time: 2012-12-21T21:21:21Z
+date_time: 2012-12-21T21:21:21Z
+date: 2012-12-21
+`), &node))
+
+	var c config
+
+	assert.NoError(t, node.Decode(&c))
+	assert.True(t, c.Time.Equal(validConfig.Time))
+	assert.True(t, c.DateTime.Equal(validConfig.DateTime))
+
+	c.Time, c.DateTime = validConfig.Time, validConfig.DateTime
+	assert.Equal(t, validConfig, c)
+
+	var i std.Int
+
+	assert.NoError(t, i.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: "42"}))
+	assert.Equal(t, std.IntFrom(42), i)
+
+	assert.Error(t, i.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "test"}))
+	assert.False(t, i.Valid)
+}
@@ -0,0 +1,194 @@
+package yamltest
+
+import (
+	"testing"
+	"time"
+
+	std "github.com/euskadi31/go-std"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type config struct {
+	String   std.String   `yaml:"string"`
+	Int      std.Int      `yaml:"int"`
+	Uint     std.Uint     `yaml:"uint"`
+	Float    std.Float    `yaml:"float"`
+	Bool     std.Bool     `yaml:"bool"`
+	Time     std.Time     `yaml:"time"`
+	DateTime std.DateTime `yaml:"date_time"`
+	Date     std.Date     `yaml:"date"`
+}
+
+var (
+	timeValue = time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)
+	dateValue = time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC)
+
+	validConfig = config{
+		String:   std.StringFrom("test"),
+		Int:      std.IntFrom(-12345),
+		Uint:     std.UintFrom(12345),
+		Float:    std.FloatFrom(1.2345),
+		Bool:     std.BoolFrom(true),
+		Time:     std.TimeFrom(timeValue),
+		DateTime: std.DateTimeFrom(timeValue),
+		Date:     std.DateFrom(dateValue),
+	}
+)
+
+func TestMarshalValid(t *testing.T) {
+	data, err := yaml.Marshal(validConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, `string: test
+int: -12345
+uint: 12345
+float: 1.2345
+bool: true
+time: 2012-12-21T21:21:21Z
+date_time: 2012-12-21T21:21:21Z
+date: 2012-12-21T00:00:00Z
+`, string(data))
+
+	var c config
+
+	assert.NoError(t, yaml.Unmarshal(data, &c))
+	assert.Equal(t, validConfig, c)
+}
+
+func TestMarshalNull(t *testing.T) {
+	data, err := yaml.Marshal(config{})
+	assert.NoError(t, err)
+	assert.Equal(t, `string: null
+int: null
+uint: null
+float: null
+bool: null
+time: null
+date_time: null
+date: null
+`, string(data))
+
+	var c config
+
+	assert.NoError(t, yaml.Unmarshal(data, &c))
+	assert.Equal(t, config{}, c)
+}
+
+func TestUnmarshal(t *testing.T) {
+	var c config
+
+	err := yaml.Unmarshal([]byte(`
+string: 12345
+int: 12345
+uint: 12345
+float: 12345
+bool: false
+time: 2012-12-21T21:21:21Z
+date_time: 2012-12-21T21:21:21+0000
+date: 2012-12-21
+`), &c)
+	assert.NoError(t, err)
+	assert.Equal(t, std.StringFrom("12345"), c.String)
+	assert.Equal(t, std.IntFrom(12345), c.Int)
+	assert.Equal(t, std.UintFrom(12345), c.Uint)
+	assert.Equal(t, std.FloatFrom(12345), c.Float)
+	assert.Equal(t, std.BoolFrom(false), c.Bool)
+	assert.True(t, c.Time.Equal(std.TimeFrom(timeValue)))
+	assert.True(t, c.DateTime.Equal(std.DateTimeFrom(timeValue)))
+	assert.Equal(t, std.DateFrom(dateValue), c.Date)
+}
+
+// yaml.v3 never calls the unmarshalers for null nodes and leaves the field unchanged,
+// so null values are only decoded as null into zero values.
+func TestUnmarshalTilde(t *testing.T) {
+	var c config
+
+	err := yaml.Unmarshal([]byte(`
+string: ~
+int: ~
+uint: ~
+float: ~
+bool: ~
+time: ~
+date_time: ~
+date: ~
+`), &c)
+	assert.NoError(t, err)
+	assert.Equal(t, config{}, c)
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	for _, doc := range []string{
+		"int: 1.5",
+		"int: test",
+		"uint: -1",
+		"float: test",
+		"bool: 1",
+		"time: test",
+		"date_time: 12345",
+		"date: test",
+	} {
+		var c config
+
+		assert.Error(t, yaml.Unmarshal([]byte(doc), &c), doc)
+	}
+}
+
+type arrays struct {
+	Strings  std.Strings  `yaml:"strings"`
+	Ints     std.Ints     `yaml:"ints"`
+	NullInts std.NullInts `yaml:"null_ints"`
+}
+
+func TestArrays(t *testing.T) {
+	v := arrays{
+		Strings:  std.StringsFrom([]string{"a", "b c"}),
+		Ints:     std.Ints{Data: []int64{1, 2, 3, 4}, Valid: true, Dims: []int{2, 2}},
+		NullInts: std.NullIntsFrom([]std.Int{std.IntFrom(1), {}}),
+	}
+
+	data, err := yaml.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `strings:
+    - a
+    - b c
+ints:
+    - - 1
+      - 2
+    - - 3
+      - 4
+null_ints:
+    - 1
+    - null
+`, string(data))
+
+	var decoded arrays
+
+	assert.NoError(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, v, decoded)
+
+	assert.Error(t, yaml.Unmarshal([]byte("ints: [a]"), &decoded))
+}
+
+func TestStringMap(t *testing.T) {
+	v := struct {
+		Map std.StringMap `yaml:"map"`
+	}{
+		Map: std.StringMapFrom(map[string]std.String{"a": std.StringFrom("1"), "b": {}}),
+	}
+
+	data, err := yaml.Marshal(v)
+	assert.NoError(t, err)
+	assert.Equal(t, `map:
+    a: "1"
+    b: null
+`, string(data))
+
+	decoded := v
+	decoded.Map = std.StringMap{}
+
+	assert.NoError(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, v, decoded)
+
+	assert.Error(t, yaml.Unmarshal([]byte("map: [a]"), &decoded))
+}